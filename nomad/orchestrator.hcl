@@ -48,6 +48,7 @@ job "orchestrator" {
         OCI_NAMESPACE                = "replace-with-namespace"
         ARTIFACTS_REGISTRY_PROVIDER  = "OCI_OCIR"
         OCI_CONTAINER_REPOSITORY_NAME = "e2b-templates"
+        CHUNK_CACHE_MAX_SIZE_MB      = "51200"
       }
 
       config {
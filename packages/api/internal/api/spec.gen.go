@@ -18,81 +18,105 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9XW/cOJJ/hdDdwx3QcTue7ODWwD44TmYvmCRjxE72gIxxYEvV3VxLlJakbDeM/u8L",
-	"fkmURErqdrttJ36aSYsfxapifbGqfBfFeVbkFKjg0fFdVGCGMxDA1L9wHAPnF/kV0A/v5A+ERsdRgcUy",
-	"mkQUZxAdt8ZMIgb/KgmDJDoWrIRJxOMlZFhOFqtCTuCCEbqI1utJhAvyO6zCS9vPm606K0maBBe1Xzdb",
-	"k+YJBJc0HzdbkWOazPLb4KL1983WFZAVKRZhaJ0Bm6y8loN5kVMOijfeHB7K/8Q5FUCF4paiSEmMBcnp",
-	"9J88p/K3er3/ZDCPjqP/mNYMN9Vf+fQ9YznTeyTAY0YKuUh0HL3FCZIgAhfRehK9OXz98HuelGIJVJhV",
-	"EehxcvM3D7/551ygeV7SRO/414ff8TSn85TECr9/2QdNz4FdA7N4XVueU0x1evb1NC/11i0wz76iOGfA",
-	"0TxnSCwBmQsSTaJ5zjIsouOIUPHLUTSJMkJJVmbR8euJ5WNCBSxAEfKUARaQnNSCS0k+lhfABNHcHZsx",
-	"HkguSAZc4KxA+Rxp6YeEXAWpSXKQA1KCBbwSJINo0r5Sk4gk3eU/JJL55gSYXF8e1N3DXbosSeJbNcP8",
-	"aog69S6fML8idPEOBCYpl/O1nGjD9RlnEICoA4GwSG1hbgloXqbpChn0Diy0duXT90idVgFndzBnnTjk",
-	"uqwJfAE4Ozn78DustqfvydkHdAWrzUlrNnir9sZp+sc8Ov7eTxMJ71cuefRyEtEyTfEsBS2YR/OKgXcM",
-	"m1xpvDRX/IJv0DVOS+gu2FkgxVx85eCB6yPmAknMILEkvELiDeaolBMCSGye+VE4O3hcHy/qgYYFDWM2",
-	"OfE9vf6GjS2VJERuiNOzBic2YXlPrwnLaQZUoGvMiESHT+R1odPytsvoeeI5shqM1DeP+OyKzAw4x4vQ",
-	"QoPYMhvZVSRmgmTqnEDiF5JvkinPGMzJbRcK/bviLUQo0jPQNTAuNbghrZbROQuxs7PPeTn37qN/v+c+",
-	"Rf8hxBILRCx2eGdJpBb0rKuu7UegC7H03Ej1ez+IFb1b1DMAN3eYeOjiw6Gk9UfCBSTnhnk7BMYpwZ6r",
-	"cCJ/riA2RqtX1KYEqND27pB81GO9qxRlZXr0yZfKRFlPIqBBDYJulkDdS4tuSJoiuC0Ig9FaJIMsZ6tP",
-	"b4eA+mTHqTkCJ1gMGmiGHp/s8LZPMoTKoDCaRFxg1qNdPbjBHJlJo3HDheSHcYc8V2M73tHQEe1oNGd5",
-	"hm6WJF4iwhuQG3k/KAIbXpfr21Xc66LNYUeHCSzD2bPLu/XJ4ZDmcfSXtu6Qckst1WM0H/2PTwd8hpte",
-	"k/m+ZmMLYWq5S71vWHSUIj/DJTcbz3GZiuh4jlMOHrcuz7B066QBWshJTUriuQCNKslxeelIiVmep4Cp",
-	"vvKVRu/1fsywe95HiEvmQeq5+h3hNEV8xQVkKM6zrKTWZ70hYtm9n84pNrsGliy9UtgizSXE679MfNdf",
-	"5Cgl1+BjTQ5xThN+0Mugh4MqyzmfYaE+Z2B3ZmHNtMbsamu6NI+luDg9++rZsMxmGunVOFS5vePstGqi",
-	"EQvEIxdOMilXmttoEaNkA3k7bivJccTnqKvfLeJyFi+BC4aFz0y05vJv1vILIaQpbdFcjXd9HELFr2+8",
-	"cNaRuyE2p9pK7SoavXkgMNEBEjhiJaWELlBO3YVHIJVX6ooJQhfDW5qB6Nzu3drHv4vAohwUYZKFz/VI",
-	"aV1qI7cLzLem9dtP8PZ1sYFTA1EL15PmhfGyd5OFAhiswa/41l5S7XZ4/CYcLyF5W5I08XCmtGjlifUo",
-	"pGLKHJGkRXEiIOOeYGqFF8wYXv2Utwl6sDp0kSq09rFv0+nwoPzp3wJ1mxuM2Ob2IFufV4drWQ7q9xaN",
-	"gEqt+j1igBOp4xKGiUS7WpZSiIX+R0mXgFOxXEWXHsLW254uMV141N/mGG9hyiwgD/kFeJn1OZV7twwf",
-	"2QCSONG3xeDkH0QsP4FgJOYvPvfT9bmzmkSj5Fq9BCOxV649Jyf+h/DHpTx64tEtoNftB68WPO5Dlgpw",
-	"SuEkpzV9y9Di30ZqRrWiVeuEDjLhjpj5SfOZiz+Hl0Km6YvsDoqT58rmL3Helzhvf5zXHPD9LcRfTEJM",
-	"VzCwRdOOGOV2Yup5wZX7lALPUmWsspIiQjlJYIgj4pvEuzHQ675H0BCg+eyfoFNTCC1KD5v9UejlEBcJ",
-	"oaggBSQSYglmwXJ52XuClefa2JbLDgZnOk+aGnOXHlgbtNIpU11iwS0RpyZKOCYyVPlOnguSAGOhT8Yl",
-	"8SSKBcD+mC88rnm+QEAFW+nYsqhyJDBNUEqovLnN86kfvevIL8jmGAWIoxYfyM2QNE4tXCPFSfu2VltN",
-	"NMCXDTx4HKfU/No5Fu/Ky03seYn1zgVtQav2diD85GiAcekFdsagbG9sIh0N31KMxBsyhWs0hKJTG4a8",
-	"46L8yiE5iwNJYyXHC0AFsBiowIuGLTFPc+ywIFUwGH18kQucegPo6ktvyDwQPMsgk6B6FzWvdTa1YPSa",
-	"m1yWzCHZ/e+Lo7kcGjRO2USkw7nnVqd3o1PQ5c0qPqXtKclVWOUR+cJQF4Azj2pUqcQee9CkJtlIjJCz",
-	"fZl6/J2N6bSX+McSxBLq6dZyMEGg1pJOwGj42SkEjfx9rGXjW6Fjs6jlqhwmgyz31JcGsy/5dMF8up8+",
-	"Hc5wjzcls6JF1w7KjJPbMkDlzxaMUs7cOn/WzB4goO9EGjYNv/Gn/d44hPxx8Hnk4+1z9bA0qDGVVG46",
-	"MpK35GQxTok6JRND2JRsjnip/Oh5meq3LyU/F+Raunt9kYctYgYDkqX2MRtnr921RxIvEk3nBb6hG4Ou",
-	"ENwnF3YSPSjKWeoz65razIBFONLjUc5QTtOVSeUh0j+crTyaxlFzXGJhWx5u46HHENrK4/ehsyySLThO",
-	"k01P3dK4ckMHdZmSP0Jg6OfeDxdyl6PbzNggSUPGuJJOvTh2xd0GkkIN9WrKyocyJtX3y07hj5IqauAm",
-	"8pKPevZ0iG9NSwWrti1vMDHvnfY9VJeqXO4srLwtJ1Tvw5Uj2CBWOC5034DxFmI7yeMrYHOSeiyMd9U3",
-	"x+4Ob7+NeFOkO8089sEX+QXFS4ivkAniIJEjUAEvsLHa6mrXb79BdlbhPO9eKgtlR7vs2OZ36OMy0lcl",
-	"QYKctC+tIaHVoGyRtwc3SH6peGvD5D2bd0nE6lxylt7LeVU4KXWG/QwwA/abFfT6cP9vs1sVV6pDqWH1",
-	"7kshCknQkyQjtLGgKtZcAk7UcFOu+X+v1MBXF82sWeOjyXXU/w2tcfbh1e8uDur552WBZ5jD6zGw2MFh",
-	"cOyII0W5sas12MAutlZx33muRD8RUpRE74/eSoI6uS/H0eHB64NDuXdeAMUFiY6jXw4ODw5VoEAsFf2m",
-	"mjyvFHk0L+fcFzLSOVEYUbhpJyxL3lNe64ckOo7Oci4cruCmmha4eJsnq53VUbbSrtdNrjXWZqMy92iH",
-	"VbKeWklfyWynChISx0dIV07xrm+3CvypHFQXovaPlYPc26osdh83f7+UJrrA0ur4HjUZQd33JnNM7xo1",
-	"7WvNJCn4Ilbv1O8I035e0cNcbjlplc27hfcBx6MeMm0W3cvTtTjgzcDTpz7P/YhkCqKHxr55FIIW5NUV",
-	"rBQ2FiACuYY4TXXIzqgI3iHc30Fo+aqvdwPHm9VKj3oMcLRd9zGgW0ntEA8xECWjkHgO9ciXz6sTWiS0",
-	"5JK2yAjB7J7PL5gdoj2ITHYp9SgiuQ1Ay1F1EPQkJfJmTOFe6emd7QsySjL384oRzJpbTup+IxuKYztx",
-	"nCRuEOe5S+KNbzcWsadAVVv7Q+Q6k5N3TK3di4eO5zJKQhwOMIoJ7vwkjCJvvM42D6rw/1WftSvvU9z6",
-	"ezQG0cbh1flJFX43w64i8pTmCYywOvQwD9CfzYfd2BrjItmqWG19eS+LQx9ob0ql7Ty3+Eh+NUykAJve",
-	"6SKLdZAyfwehzoCUwxkizGdbqrGZxDEVHh7tsLt+O04x02jCVTUgT1KMjKNx0F5URSiIV5FfbMtdutbi",
-	"zmj7AKZmu6pm3W3F5TcyDG0tBtSrmFriOaiQ8fe7UU/WL3RtPq5bXtW5524lZYsTArlU/yrBZu6IHM1J",
-	"amO5dR3bf8HB4gD9GZUc2N/wLP6zPDw8+hUXxd8Klid/Rv99gN7jeKn0PKaJbsHDUVZygWaAvn75iIDG",
-	"eQLJQTTR0TS1ax1Mq7KB+3q4Xe5Xr7RK8O6nYLrEU8x4OIYZD/eomJxo7PfL9eQe1lB90hFesc1TVhnM",
-	"rReVrsBzmfyBHOSK7Pv1jhvbdiWim4gedot/EqZqiM+pU4i2oRjV6Z92fp9M/VSNeRGt9xKt4VLPXYvZ",
-	"JnGfw/UYxe13VW1GbxTpd5KmtXQNhI8q9j536j02syLrSpGRIaSWLLsiafo8DLuH0o9Br67WjbMVUml9",
-	"Yfn0QAQ83LV628bR43UrhJ+GLYJ3fgq3ELuvwD02UsUU7+WcDmPsrHfzA3mQnsqx8fHI3UNg6qG8nYh1",
-	"noxJktmfYbYJi5uezENj//ro14GXWYbZqqrlk06CzUQytXy4re2jTW+RzeQLCl8rek0m3wjJ+1GP3Fr6",
-	"TrxJWPKQwlM8xnW/Tb7MyzSR9mAlMglFGUlTYhpyBGxDlfvVMAw7qar9bcs6pi++laMRrTJj+6AMQJWS",
-	"jDShqjuSHB4ebtpaZA8KTVF9G3WmOetFp8nbOOTBuRdyjLdW3cmg27Y/o2gXvVG2Ya+G4/PTc1hhOyr5",
-	"o1Gq4VKreG+MYaUbNe3bZ9JFhA0rQ0UIYky1jFUdpl6MiY25hMGcAV9CT5rlFz2kcdXgVgBNVMMMwU29",
-	"nG6UNZKNvlT73peVtrPCm9nJSakB9qSmmC8qQ1l3m3DxUKv5KygEwqpVWN0aTJXY3Wpt/cuvUpsPNEZt",
-	"FfqPfD9qiUaN2T15kk+Ag+Xd72Nf+X0LSacnPhJ/9gYUm/30nm7U3ojlvYW6flAZ7XQr9LP4OQi35WG7",
-	"V+EBuvD3akK3VlA5b1GkLik2zHuATnGaKrdsSbg0s5Z5grIyFaRIwZQD5tfAbhgRpjLw4uLjBAGOdasJ",
-	"VHI9HVBcMgZUuB0oTPce6/sVOZHfc5QB5iWDxtGspD4YeYkvqi6Qj69lGl0n26WK8nC14qjp4eLL1B4F",
-	"1VC3A9c2/bgNlJc70UbcsKaF1K7+s9noAnA2MsPd6+hdmA/7fLhSlT73fKPSB9rf81K79KuPjI00Ffmb",
-	"JZV+jR9FLjvUS7L6oy8O3QoEVZ093EjQVtWDl/tmE5O9cG9Wsfh6+uxSwzq6CqInxcPllIcwGL2lx6PM",
-	"xqOdwxCyG3VvCmk14jiGQlhv/sk9YO+CZRpiZnpX15CPLZMIMJMeUbHThVubvpn945S1j4/YNJos7KJY",
-	"4vFvdm8FRPhSy2kPQoaHEw7NcvKtyyA6XUGCpRA/5M2eBKMQWsBhOlIVPA+meY4a5QfQElP9V0ymd6bh",
-	"yLonNqD6WrjtKkYxnf4jFm+rfibbc+BkcLTtmuJRNEd+CaNJu3Q6FP+wlJ3WfXKCD4WVwNV4CZXFDJH5",
-	"3Pas2QuxO8/pH2gCt1VHSRsNmtnuQsHXf93QtNW2zffSni/4H/M5h8Bz+5N6a2+2dtroObRCw9OMsezq",
-	"/lwfbVJP0ltH8u3oR64k6Vy03zSwNaCzFcopoJyhLGe6CklhAm6LVLW9Nn+LJ5BVo27cds//dRP4Tnew",
-	"leohI2+kR1acloznTGKeV/otlbSeszwLIIvCrbhwO/6Mw1Y3y0cdUPVaV9cOFcBQodsV7yjDx74Z6u99",
-	"fyL/pU7o+ZZ0qH3YtRU0JUtNyyl+PJ3ighzA0ewAF0XkrHBXhwvraNldK5m1+aMKbbr/bvRgcT/Yku71",
-	"5frfAQAA//8DK9yLbYYAAA==",
+	"H4sIAAAAAAAC/+x9jW7cOJLwqxD6FpjdD227k8kENwYWh8TJ7AWTZIzYyRyQ8R1oqdrNtURqSKrtHqPf",
+	"/cA/iZIotdRut+3EWGAnblFksapY/yzdRDHLckaBShEd3kQ55jgDCVz/heMYhDhll0DfvVE/EBodRjmW",
+	"82gSUZxBdNgYM4k4/FkQDkl0KHkBk0jEc8iwelkuc/WCkJzQi2i1mkQ4J7/Csntq93jcrOcFSZPOSd3T",
+	"cXNSlkDnlPbhuBkFpsk5u+6ctHo+bl4JWZ5i2Q2tN2DczAuWFhl81LMEZ/YGjJl5pQaLnFEBmuteTKfq",
+	"PzGjEqhU/8R5npIYS8Lowb8Fo+q3ar6/cZhFh9H/O6hY+cA8FQdvOWfcrJGAiDnJ1STRYfQaJ0iBCEJG",
+	"q0n0Yvrs7td8Vcg5UGlnRWDGqcVf3P3iH5lEM1bQxKz4892veMToLCWxxu9Pu6DpCfAFcIfXleM5zVRH",
+	"x5+PWGGWboB5/BnFjINAM8aRnAOyRy+aRDPGMyyjw4hQ+ePzaBJlhJKsyKLDZxPHx4RKuABNyCMOWELy",
+	"qhKJWqZylgOXxHB3bMcEIDklGQiJsxyxGTJyFUk1C9IvqUEeSAmWsCeJPmyNIzWJSNKe/l2imG9GgKv5",
+	"1Ub9Nfypi4IkoVkzLC7XUada5QMWl4RevAGJSSqilZMTTbiUwOiAqAWBdEhtYG4OaFak6RJZ9K6ZaOXL",
+	"p6+R3i01csu9ofc68ch1VhH4FHD26vjdr7DcnL6vjt+hS1iOJ61d4LVeG6fpb7Po8Gs/TRS8n4Xi0bNJ",
+	"RIs0xecpGME8mFcsvEPY5BKW7Rk/4Su0wGkB7QlbE6RYyM8CAnC9x0IihRkk50SUSLzCAhXqhQ4k1vd8",
+	"L5zdud0QL5qBlgUtY9Y58S1dfMHWSksSohbE6XGNE+uwvKULwhnNgEq0wJwodIREXhs6I2/bjM6SwJb1",
+	"YKSfBcRnW2RmIAS+6JpoLbbsQm4WhZlOMrV2oPALyRfFlMccZuS6DYX5XfMWIhSZN9ACuFAa3JLWyGjG",
+	"u9jZW+ekmAXXMb/fcp28fxNyjiUiDjuiNSXSEwbm1cf2PdALOQ+cSP17P4glvRvUswDXV5gE6BLCoaL1",
+	"eyIkJCeWeVsExinBgaPwSv1cQmzN4aCoTQlQ+e5Ne4q2fDRjg7PkRWl69MmX0kRZTSKgnRoEXc2B+ocW",
+	"XZE0RXCdEw6DtUgGGePLD6/XAfXBjdPvSJxgudZAs/T44IY3vZ11qOwURpNISMwljMENFsi+NBg3Qip+",
+	"GLbJEz225Xet26IbjWacZehqTuI5IqIGuZX3a0VgzZ/zvcaSe320eezoMYFjOLd3dbY+eBxS34550tQd",
+	"Sm59eO0juW00P/+PkA74CFe9JvNtzcYGwvR0Z2bdbtFRSHaMC2EXnuEildHhDKcCAm4dy7By65QBmquX",
+	"6pTEMwkGVYrjWOFJiXPGUsC0KSa6PBR/Vk1Tga6InE/0AzOsoLLFZOdLZLewj059FsMUnQPiIMhfkKAi",
+	"R5JZOIGjlGRECqRm4PvjnSG44CDEMUtJvBx4mN76r6gpKiOn1yG0wxpiLci0iknJ635Emkl2j8Xw6biF",
+	"1KUgrxi/7D87EnCG7EgFOZYSx/MahiSb+H+DqL3LnMTNoJxH4YSDmkf/H5Nz4AqBcg6Eo5yThcIoThJF",
+	"cBDRJMqxlMAVcP/zFe/9Nd37+ezv9h97ZzfTyctnK/f7P/7zb0Hzh3Fp5MhAVB1XLyipD3HBA3LmRP+O",
+	"cJoisRQSMhSzLCuoC+MovmmrLO9gj9MMTlL1GiZOjviy6dlPk5BGlAylZAEhaS0gZjQR/Vw5DfGkifR9",
+	"UMImYF8dK7NVSO1p6IECZWqosW3rGp5IyIbS60u1arQqwcKc42WfVrSivs9p35775isXA+8mqxm0TVBB",
+	"yZ8FaBYjtDxxQZuF/AUhsXdC/mrMakXgBGGJMiak/0jNYiSWf8J/EFqUNdjk5QufTV6+WGvtWz/WQnpW",
+	"jmfn/wYTJfzIkgC6cJqyWJlCR8efA2grsnNzespxqFSYw3zQ8kVr8pAAHl9lTrNWy2S+Shm2lBIdJKTi",
+	"9e8O6YzHcxCSYxlygV0o4Bfn1XYhpG5Jopke78dvHBXbcFb5jnXyihoPvM2QZvEOk6YFJAjEC0oJvXD6",
+	"hA6OIYjSFOeS0Iv1S9qB6MSt3VgnvIrEslgrqhQLn5iRSk4aB74NzJe6Z99P8OY5cukmC1ED15P6gQmy",
+	"d52FOjBYgV/y7Zk9pCakEogJ4XgOyWuVYgtwpvLW1Y7NKKQzcQKRpEHxUiW01V5N4n+Hpwl6sLruIA3S",
+	"tPWASgDlD/8U6NNcY8Qmt3ey9Um5uYYS1b83aARU6b2vEQecLKNJlHBMFNr1tJRCLM0fBZ0DTuV8GZ21",
+	"9uQvezTH9CKg/sZjvIEpO4HapLJ4317nTAQtXfdEa7mS35VZjeScs+LCeAU5Z9fLifqPhFjpQTVCOMPV",
+	"DFHv1DJYCXCygMTEO9QQoItWlsahNC/OUxJHk6hcQ4czmIAkiMRPIIoMkgfkzN+vga444JMRAhYnvxM5",
+	"/wCSk1g8hUkfbpg0q0g0xjEylA2K68cUd/0mQqhKzD7whIQSvY2AawMeX3LrnJQSTuq1euyja/IvAxW+",
+	"ntFZK63QQGvqDcJZvjwVNrQFCZIsnD/TYalXJioVQIt50DguiNDWwj0m3K2P4oM+JT71vZPQ5S88aZ5O",
+	"YfhYD+mONeaTTHhKi+40LRpKVbU9Kf20ZLlcD2vQcYKI/EGgS8hlmz5EGN8jQZgmiBv/Jpq0xGfKriA5",
+	"IgkPsOa748ULxDh6d7x4iY7evfnUQCSmyDqqOsGD6RLZCfVo9WrCMkyU80YJCPVUz2CyOZLj2YzEJhdW",
+	"ZnRoglJCL/dUyClFXLm0Qi31g1Q5Mjv/qCCPfeeNBiWwTfugtTcOgqULA5K/z/+/D9c4y1PYj1mGMixV",
+	"0MG8XZwndjI2Q94os0c7YVKlrRDm5Z5K/+309L2lNOGIQ8x4IiYIrmNFaNmNK0ZBjEKMpsrmxP9Bemgx",
+	"HEiZyjNcaLFodjVB0339v4Npkwv0CmoPegnLDSM2sPIO0zXEn2wxdttG4Bd1h2hQWBDTJBTggLiQqvJN",
+	"bZkXFBEqSALrxGt8lQQXBrroK8DrArRKeBCaFwGZ/VtupkNCJoSinORaP7noi85YdmcFT0zUQE27Nnje",
+	"KqczmAslZ2q0MuX6bWLBNZFHNoszJHJfxrYC2iYBzrse2dhKWwV0gf2LNRKaNYxrUgUzxi+FQr1RO8FA",
+	"DL62+fvpupKIW0SFDCC3iQk1Ka127im09+wiEFtmFwio5EuT5ZZlAbOVXdBSSfrH4DzqCXIXADq4V0++",
+	"pnBaYSN1cA00Xhqbr5aaGIDreAiEyFL7a2tbom2djYncvGdm732pbL22B+EHz1oeVvvr3lhrSdYW4SQO",
+	"TsVJPJIpukubqpM2Mmcb54WqED+OO+qlClUPjHLgMVCJL2ond5Yy7LEg1TBY3+WUSZwGM8D6SW/OtyP7",
+	"k4Eqvk+Ck9qqJFf3O3hOCvIjXB3ZPAOjvZmruBqGWA4UEluKU5VAERoDIrLLL+gF5NP166WEXgjO1QBl",
+	"DAFZbH31YxxfguxdPzdD7gaC06H7F0Dlllcesfftrj5GWmeezLi9wPYcNU8I1I5Z/ST7opNccBMUaRfl",
+	"jMrRNp21jC0gKevkUsBCovNCLM1gIuwJnyHKJBIQUICe7FVZupBpVeXt+jRLLcdny+ICNVqMy7Y66DZu",
+	"Xv70048/9ds3DZrpdScV2Gf1DVaVet1JSB/JJskYyEPe2ou2ptjbW+B3wD5SnVk321BAI7YAzklifU8L",
+	"BCrRNc6O0CzT52J9AsEKHkPAttkk/Dk+yufBcuICSe0sO7R5ssyzmxCkIp+maTATHKgU3Lj8LhgOxKE7",
+	"MK/OBUsLCUg9bsRIFVdy8KZFpCyHHFZNaFcN+WSqprG9QXNvPgCmvS3nfIquWkIi3jjvpDnF73MwwR/7",
+	"OiJ19q1P6SXE1yO9Cxr1+9DoIc7WotROV16rs8jyd31mMft0xbPziud3f0PTck/wlnBJi7YOz2warKEr",
+	"1M8OjEIA3/xKt317DQFDOzKwGfhtxi2cr4OujB2EcnbDw3a6HnCtn6hNwdoimrfUy3KY6+j1B1mHTcXm",
+	"SBTaVJkVqV7FRHovyAJof25yA7W6RrJUFk5t71VK5J7Ei0LTSY6v6GjQNYILMQL4TfKLtp5sjTazYCmj",
+	"UY9X4XNG06WtUiMqbGz9p041JxQWNuXhQgz2vjbKqoXQWeQJlhuSzby6oUfnp+eqnjzhLFxZD1idDx9y",
+	"n6ObzFgjSU3G+JJOF4q2xd0ISaGHBjVlGTm0JtXXs1YvGvUu0gPHyEsxqFrVI76zpDWsxpS+wsSWqboy",
+	"VtM95WxrhSebckJZ1luGP2vE6k4X3bakZAOxnbD4EviMpBBKT7pnnt3dvfwm4k2T7igL2Aef1BMUzyG+",
+	"RDa3gyRDoPNgUFUp2KNd1bZ2srMOEgXX0pcHtrTKlm1+jz4+I33WEqSTk3alNVYeSL9jnh0zFiiVUtem",
+	"ht2j0bGQc8Yk6JsV+gImZQlM0BQlRJjGHwqeK8wzlKvVBqe11ieWNJwKywa7G9y6gyuknpTHZfTVu657",
+	"d4OtK+uob2JbPYi7fcOMCPPKsGPWFRFpkKCcctK47VdzkVqBDHf7lsjliZJvhlxe9ZvqnKZ+OgfMgf/i",
+	"9maO2P+66wNaNuqjpYdV0M6lzNWOXyUZobUJidruHHAC3MF8GP33nh64d1pvJ2AjBWoe/a91cxy/2/sV",
+	"lqH3T4ocn2MBz4bA4gZ3g+NGPNeHbehsNWZzk610UcKMqRkkkUqhRW+fv1Zn0Ls4cxhN95/tT9XaLAeK",
+	"cxIdRj+qMhEbrNL0OzDk2dPk0b/kTITSdeZCFUYUrpo3QtTx1bGTd4kOWgvpcYWwbQZByNcsWW6twVyj",
+	"H8WqzuXW56m1LHy+xfaBgSZyoV6CrfZwkHiearr0uhqGVivBP1CDqg59/WPVIP+0ar8xxM1fz5SjKLGy",
+	"fb9GdUY4UzPUmePgptZGdGWYJIVQmPiN/h1h2s8rZpjPLa8anUr9Xqcd7m815KAGoHaDGxzwYk2JrtnP",
+	"7YhkO0WuG/viXgiak71LWGpsXIDsuKioq8dU4NhqddEi3L9AGvlqjncNx+OaSA5KoHgGSjB/0uzTVREP",
+	"cZAFp5AENnXPhy+oExokdOQ6W02GCGZ/f2HB7BHtTmSyT6l7EclNABompIegBymRxzGFf6QPblwr5kGS",
+	"uZ9XrGA23PKqavE8Uhy7F4dJ4hpxHrskHn26VY1zGyfGQVtHrmP18paptX3x0HI2B0mI6RpGsSHG74RR",
+	"1Ik3V9U7Vfh/6ccmoBRS3OZ5NATRNuxiKo9K/I7DribyAWUJDLA6zLAA0B/tg+3YGsPyKWrNaHV2K4vD",
+	"bGhnSqXpPDf4SD21TKQBO7gxFVWrTsr8C6Qtj6Iz1kmYj67PwziJYxYPaYftNSL3OqEMJlzZQOJBipFh",
+	"NO60F3UHCyTK/AN2vTLa1uLWaHsHpmazJceq/Y2CsJFhaeswoOOHeorHoEKGn+9aM5p+oevujVavBM65",
+	"34apwQkddex/FuCqpiVDM5K6jEK5Dvo77F/soz+iQgD/Jz6P/yim0+cvcZ7/M+cs+SP6xz56qyLjSs+r",
+	"hIVuQSxQVgh9U+3zp/cIaMwSSNRVCx1N06tWwbTy1mrfxy3OdqtXGv17bqdg2sTTzDgdwozTHSomLxr7",
+	"9Ww1uYU1VO10gFdsB1ctbby8Xlvg+Ux+Rw5ySfbdese1ZdsS0b9y3O0WfydMVROfB167l5Fi1Fy9ce/3",
+	"ydQP5Zgn0Xor0drdUGnbYrZO3MdwPAZx+015C783ivSrapaBvQLwUPioZO8T72b/OCuyhGZoCKkhyy5J",
+	"mj4Ow+6u9GOnV1fpxvMlIkmLhr58uiMCTret3jZx9ETVR/G7YYvOM3+g6oH8LHCPjVQyhbpt3maMrX0u",
+	"7448yEBbg+HxyO1DYNYIf6LNVGvZUq3dGWZjWNx+rG7d2J/v/TiIIsswX5aNJpST4OrhbKMJ3NT20dhT",
+	"NHONDPqck5jlpKrGbyxZ6+WPLgHysmVsv+dSnspfTDuj24nrOzt2Gro78IHG3McbkktuGhSKsk9HcJca",
+	"ydVmdxoyjjx64CAr5r0ZufHRmATLatXplYEmGMJ81EvMWZEm5iMk1vwgFGUkTYltF9LhZ+lq3pqT1d3h",
+	"P1j62XIjTdUoomVdah+UHVDpDxDUoKq6pUyn07GNT3ZgHGqqb2IaGs56sg/VaVwXDfEP5JDIR3kmO0Mg",
+	"u3MwttHNdxP2qgURnjhMt3+AbvPpA1tAyGTS30Wipu9dZ0orwHh2vQdrKlX9MII5rul9xIgtkXYXWdmR",
+	"cTM+vVbj3dz1rw9zrm5v3+icMIRF9Xs7j53Zrhw+hU2fxvJTarpTx5NFO1bCcZhxEHPoKbf/ZIbU1ARc",
+	"S6CJbv0oBZJeA7qBbPSpXPd+ZF2jo0tRdflplCjaJ/pykekv6+OhMlH1JSqsMOA13fOvRf34crr+YlTz",
+	"nsuwOoKGWjeY3VFE8QFwsN+opq/OxH3zUgmO+icbWwGP6puM9nOM7a+bVT1e9eq6teuIrkL7gw+K296D",
+	"NQoqEAcFUdYxsKuA8VFYEfmBBDgelYgX7nZjh3xXzzcwBcyLD5AvG5/3ebjlDVYWfGuW66453GuTG2bx",
+	"E5D+F5ian06qf57X+/gCunaa3CvaIdXVXMu8++gIp6mOuc2JUD70nCUoK1JJ8tS8IXT7titOpL1LfXr6",
+	"fmKuWusJC2FeBxQXnAOVfptU80b5FcKcEap7cGeAdcc4f2vOlBmqX07Lj1LdvxlWa3fcvG2tNkdomx4+",
+	"vmyrgE47rf1JjQ06ITsoz7ZirgmQNUjd7N+b7lKW1cCrgMEo3ql9sMsKH7XmbYt5zIZ2V4fTvCPfR8Za",
+	"wEH95khlyhYHkcsNDZKsehhK2Dei/GUjPj/Mv1Gzj7Nds4nZ5+1ZxeHr4bNLBevg66I9tbA+p9yFwRjs",
+	"FDTIbHy+dRi67EbTSk5ZjTiOIZcu3PXgKv22wTI1MXNwU7V8GnqftIOZzIiSnU79VlLj7J8KpBEhzVpP",
+	"tG3cKr3/k917VbT7UKvX7oQMdycc6t2fNr4v2mri13ln9Js82ZPOKIQRcJgOVAWPg2keo0b5BrTEgd6b",
+	"OLix/QFXPbEB3YbO7y43iOk0YcXrsv3g5hw4WTvabiKkaJ6HJYwh7dz7SMM3S9mDqq1lZxVIKXANXrru",
+	"D68js/1e+o6I3aqVekcTuC773bto0LlrBtpZ2mW+utPoshwqo2IX4rfZzHxtIlBL9aAKqWoCdlytS4mG",
+	"hxljuePzo5o47uWuZWTREy2tavJyDnu2QWSjbWSgRWlDfBahY1W2rXzAqruEcdOs1qnfMvNebL2HdhF8",
+	"8XzMXfDeO+Bfnn/Lt8Bbsv8XA2wF6PkSMQqIcZQxrtWBCY/AdZ7q7ynOcCqgs4pXQm39MeWG1ad6W/2l",
+	"l7r/o1ISAfV1VHChpAUzuqv8yI0O5Xcgi8K1PPW7dQ7DVruqWG9QrW00AcqBo9x85m1LFcVV+9vpmu86",
+	"Pt3xf9TXsU3P2qFtC93okAgrH919qbBZawsdC91+HiMlHewDQ9JlD+O2Q+iT7k5aMzh67bZ0wV+1bcu0",
+	"u1zv0JB5ZIULFat5IuPgxvxDdfceFsi2KMfUnEAiBbK2QyiubbnyS7nIaPO6gm9EVNvji113SnzMfKFX",
+	"4QtHmYKntvO4ODxQDRD34fn5Ps7zyHv/pkqGVrnAm8ad5vqPOnHr/11rxes/cJ39vN9KaX+2+r8BAOKu",
+	"odP6rQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -25,6 +25,13 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for PortExposure.
+const (
+	Closed    PortExposure = "closed"
+	Protected PortExposure = "protected"
+	Public    PortExposure = "public"
+)
+
 // Defines values for SandboxState.
 const (
 	Paused  SandboxState = "paused"
@@ -148,9 +155,24 @@ type NewAccessToken struct {
 // NewSandbox defines model for NewSandbox.
 type NewSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool            `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars         `json:"envVars,omitempty"`
-	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// CpuCount CPU cores the sandbox starts with, the CPU count of the template by default. The sandbox can be resized up to the tier limits later.
+	CpuCount *int32   `json:"cpuCount,omitempty"`
+	EnvVars  *EnvVars `json:"envVars,omitempty"`
+
+	// EgressPolicy Egress network policy of the sandbox, it's kept when the sandbox is paused and resumed
+	EgressPolicy *SandboxEgressPolicy `json:"egressPolicy,omitempty"`
+
+	// MemoryMB Memory in MiB the sandbox starts with, the memory of the template by default. The sandbox can be resized up to the tier limits later.
+	MemoryMB *int32           `json:"memoryMB,omitempty"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Network Name of the team network to attach the sandbox to, the sandboxes of the team on the same network can reach each other by their private addresses
+	Network *string `json:"network,omitempty"`
+
+	// PortAccess Exposure of the sandbox ports through the proxy, it's kept when the sandbox is paused and resumed
+	PortAccess *SandboxPortAccess `json:"portAccess,omitempty"`
 
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
@@ -160,6 +182,9 @@ type NewSandbox struct {
 
 	// Timeout Time to live for the sandbox in seconds.
 	Timeout *int32 `json:"timeout,omitempty"`
+
+	// VolumeMounts Persistent volumes mounted in the sandbox
+	VolumeMounts *[]SandboxVolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // NewTeamAPIKey defines model for NewTeamAPIKey.
@@ -168,6 +193,15 @@ type NewTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// NewVolume defines model for NewVolume.
+type NewVolume struct {
+	// Name Name of the volume, unique within the team
+	Name string `json:"name"`
+
+	// SizeMB Size of the volume in MiB, at most the volume size limit of the team's tier
+	SizeMB int64 `json:"sizeMB"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// AllocatedCPU Number of allocated CPU cores
@@ -231,6 +265,9 @@ type NodeStatusChange struct {
 	Status NodeStatus `json:"status"`
 }
 
+// PortExposure Exposure of a sandbox port through the proxy, protected ports require the port access token derived from the envd access token
+type PortExposure string
+
 // ResumedSandbox defines model for ResumedSandbox.
 type ResumedSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
@@ -283,6 +320,12 @@ type Sandbox struct {
 	// EnvdVersion Version of the envd running in the sandbox
 	EnvdVersion string `json:"envdVersion"`
 
+	// Network Name of the team network the sandbox is attached to
+	Network *string `json:"network,omitempty"`
+
+	// PrivateAddress Address of the sandbox in the team network
+	PrivateAddress *string `json:"privateAddress,omitempty"`
+
 	// SandboxID Identifier of the sandbox
 	SandboxID string `json:"sandboxID"`
 
@@ -314,6 +357,12 @@ type SandboxDetail struct {
 	MemoryMB MemoryMB         `json:"memoryMB"`
 	Metadata *SandboxMetadata `json:"metadata,omitempty"`
 
+	// Network Name of the team network the sandbox is attached to
+	Network *string `json:"network,omitempty"`
+
+	// PrivateAddress Address of the sandbox in the team network
+	PrivateAddress *string `json:"privateAddress,omitempty"`
+
 	// SandboxID Identifier of the sandbox
 	SandboxID string `json:"sandboxID"`
 
@@ -327,6 +376,18 @@ type SandboxDetail struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxEgressPolicy Egress network policy of the sandbox, it's kept when the sandbox is paused and resumed
+type SandboxEgressPolicy struct {
+	// AllowedCidrs IPv4 or IPv6 CIDRs the sandbox can connect to, any allowed CIDR or domain denies all the other traffic. The private and link-local ranges can't be allowed
+	AllowedCidrs *[]string `json:"allowedCidrs,omitempty"`
+
+	// AllowedDomains Domains the sandbox can resolve and connect to, *.example.com matches the subdomains of example.com. The resolved addresses are allowed for the TTL of their records, except the private and link-local ones
+	AllowedDomains *[]string `json:"allowedDomains,omitempty"`
+
+	// DeniedCidrs IPv4 or IPv6 CIDRs the sandbox can't connect to when nothing is allowed, 0.0.0.0/0 denies all the IPv4 and IPv6 traffic
+	DeniedCidrs *[]string `json:"deniedCidrs,omitempty"`
+}
+
 // SandboxExecRequest defines model for SandboxExecRequest.
 type SandboxExecRequest struct {
 	Args *[]string `json:"args,omitempty"`
@@ -349,6 +410,15 @@ type SandboxExecResponse struct {
 	Stdout   *string `json:"stdout,omitempty"`
 }
 
+// SandboxFork defines model for SandboxFork.
+type SandboxFork struct {
+	// Count Number of forks to create
+	Count int32 `json:"count"`
+
+	// Timeout Time to live for the forks in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
 // SandboxLog Log entry with timestamp and line
 type SandboxLog struct {
 	// Line Log line content
@@ -381,13 +451,70 @@ type SandboxMetric struct {
 	// MemUsedMiB Memory used in MiB
 	MemUsedMiB int64 `json:"memUsedMiB"`
 
+	// NetNewConnections Number of connections opened by the sandbox since it was started
+	NetNewConnections *int64 `json:"netNewConnections,omitempty"`
+
+	// NetRxBytes Number of bytes received by the sandbox since it was started
+	NetRxBytes *int64 `json:"netRxBytes,omitempty"`
+
+	// NetRxPackets Number of packets received by the sandbox since it was started
+	NetRxPackets *int64 `json:"netRxPackets,omitempty"`
+
+	// NetTxBytes Number of bytes sent by the sandbox since it was started
+	NetTxBytes *int64 `json:"netTxBytes,omitempty"`
+
+	// NetTxPackets Number of packets sent by the sandbox since it was started
+	NetTxPackets *int64 `json:"netTxPackets,omitempty"`
+
 	// Timestamp Timestamp of the metric entry
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// SandboxMigration defines model for SandboxMigration.
+type SandboxMigration struct {
+	// NodeID Identifier of the node the sandbox is moved to, the least busy node is used if not set
+	NodeID *string `json:"nodeID,omitempty"`
+}
+
+// SandboxPort defines model for SandboxPort.
+type SandboxPort struct {
+	// Exposure Exposure of a sandbox port through the proxy, protected ports require the port access token derived from the envd access token
+	Exposure PortExposure `json:"exposure"`
+
+	// Port Port of the sandbox
+	Port int32 `json:"port"`
+}
+
+// SandboxPortAccess Exposure of the sandbox ports through the proxy, it's kept when the sandbox is paused and resumed
+type SandboxPortAccess struct {
+	// DefaultExposure Exposure of a sandbox port through the proxy, protected ports require the port access token derived from the envd access token
+	DefaultExposure *PortExposure `json:"defaultExposure,omitempty"`
+
+	// Ports Exposure of the listed ports, it overrides the default exposure
+	Ports *[]SandboxPort `json:"ports,omitempty"`
+}
+
+// SandboxResources defines model for SandboxResources.
+type SandboxResources struct {
+	// CpuCount CPU cores for the sandbox
+	CpuCount *CPUCount `json:"cpuCount,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB *MemoryMB `json:"memoryMB,omitempty"`
+}
+
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxVolumeMount defines model for SandboxVolumeMount.
+type SandboxVolumeMount struct {
+	// Name Name of the volume
+	Name string `json:"name"`
+
+	// Path Absolute path in the sandbox where the volume is mounted
+	Path string `json:"path"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	// ApiKey API key for the team
@@ -514,12 +641,33 @@ type TemplateUpdateRequest struct {
 	Public *bool `json:"public,omitempty"`
 }
 
+// TemplateWarmPool defines model for TemplateWarmPool.
+type TemplateWarmPool struct {
+	// Size Number of sandboxes kept booted on each node, 0 disables the warm pool
+	Size int32 `json:"size"`
+}
+
 // UpdateTeamAPIKey defines model for UpdateTeamAPIKey.
 type UpdateTeamAPIKey struct {
 	// Name New name for the API key
 	Name string `json:"name"`
 }
 
+// Volume defines model for Volume.
+type Volume struct {
+	// CreatedAt Time when the volume was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the volume, unique within the team
+	Name string `json:"name"`
+
+	// SizeMB Size of the volume in MiB
+	SizeMB int64 `json:"sizeMB"`
+
+	// VolumeID Identifier of the volume
+	VolumeID string `json:"volumeID"`
+}
+
 // AccessTokenID defines model for accessTokenID.
 type AccessTokenID = string
 
@@ -538,6 +686,9 @@ type SandboxID = string
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// VolumeName defines model for volumeName.
+type VolumeName = string
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -630,9 +781,18 @@ type PostSandboxesJSONRequestBody = NewSandbox
 // PostSandboxesSandboxIDExecJSONRequestBody defines body for PostSandboxesSandboxIDExec for application/json ContentType.
 type PostSandboxesSandboxIDExecJSONRequestBody = SandboxExecRequest
 
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = SandboxFork
+
+// PostSandboxesSandboxIDMigrateJSONRequestBody defines body for PostSandboxesSandboxIDMigrate for application/json ContentType.
+type PostSandboxesSandboxIDMigrateJSONRequestBody = SandboxMigration
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
+// PostSandboxesSandboxIDResourcesJSONRequestBody defines body for PostSandboxesSandboxIDResources for application/json ContentType.
+type PostSandboxesSandboxIDResourcesJSONRequestBody = SandboxResources
+
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
@@ -647,3 +807,9 @@ type PatchTemplatesTemplateIDJSONRequestBody = TemplateUpdateRequest
 
 // PostTemplatesTemplateIDJSONRequestBody defines body for PostTemplatesTemplateID for application/json ContentType.
 type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
+
+// PutTemplatesTemplateIDWarmPoolJSONRequestBody defines body for PutTemplatesTemplateIDWarmPool for application/json ContentType.
+type PutTemplatesTemplateIDWarmPoolJSONRequestBody = TemplateWarmPool
+
+// PostVolumesJSONRequestBody defines body for PostVolumes for application/json ContentType.
+type PostVolumesJSONRequestBody = NewVolume
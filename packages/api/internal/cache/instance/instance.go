@@ -14,6 +14,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -47,6 +48,7 @@ func NewInstanceInfo(
 	AutoPause bool,
 	EnvdAccessToken *string,
 	BaseTemplateID string,
+	VolumeIDs []string,
 ) *InstanceInfo {
 	instance := &InstanceInfo{
 		Instance:           Instance,
@@ -68,6 +70,7 @@ func NewInstanceInfo(
 		AutoPause:          atomic.Bool{},
 		Pausing:            utils.NewSetOnce[*node.NodeInfo](),
 		BaseTemplateID:     BaseTemplateID,
+		VolumeIDs:          VolumeIDs,
 		mu:                 sync.RWMutex{},
 	}
 
@@ -96,6 +99,19 @@ type InstanceInfo struct {
 	Node               *node.NodeInfo
 	AutoPause          atomic.Bool
 	Pausing            *utils.SetOnce[*node.NodeInfo]
+	VolumeIDs          []string
+	// EgressPolicy is the egress network policy of the sandbox, nil means no restrictions.
+	EgressPolicy *orchestrator.SandboxEgressPolicy
+	// PortAccess is the exposure of the sandbox ports through the proxies, nil means all the ports are public.
+	PortAccess *orchestrator.SandboxPortAccess
+	// Network is the team network the sandbox is attached to, nil means no team network.
+	Network *orchestrator.SandboxNetwork
+	// vCpuLimit and ramMBLimit are the resources the sandbox is limited to within the VM, 0 means the whole VM.
+	vCpuLimit  int64
+	ramMBLimit int64
+	// diskRateLimiter and networkRateLimiter are the I/O limits applied to the sandbox, nil means unknown.
+	diskRateLimiter    *orchestrator.RateLimiter
+	networkRateLimiter *orchestrator.RateLimiter
 	mu                 sync.RWMutex
 }
 
@@ -128,6 +144,49 @@ func (i *InstanceInfo) SetEndTime(endTime time.Time) {
 	i.endTime = endTime
 }
 
+// GetResources returns the vCPUs and memory the sandbox can use, VCpu and RamMB are the size of the VM.
+func (i *InstanceInfo) GetResources() (vCpu int64, ramMB int64) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if i.vCpuLimit == 0 || i.ramMBLimit == 0 {
+		return i.VCpu, i.RamMB
+	}
+
+	return i.vCpuLimit, i.ramMBLimit
+}
+
+// IsResized returns whether the sandbox is limited to less than the whole VM.
+func (i *InstanceInfo) IsResized() bool {
+	vCpu, ramMB := i.GetResources()
+
+	return vCpu != i.VCpu || ramMB != i.RamMB
+}
+
+func (i *InstanceInfo) SetResources(vCpu int64, ramMB int64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.vCpuLimit = vCpu
+	i.ramMBLimit = ramMB
+}
+
+// GetRateLimiters returns the I/O limits applied to the sandbox.
+func (i *InstanceInfo) GetRateLimiters() (disk *orchestrator.RateLimiter, network *orchestrator.RateLimiter) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.diskRateLimiter, i.networkRateLimiter
+}
+
+func (i *InstanceInfo) SetRateLimiters(disk *orchestrator.RateLimiter, network *orchestrator.RateLimiter) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.diskRateLimiter = disk
+	i.networkRateLimiter = network
+}
+
 func (i *InstanceInfo) SetExpired() {
 	i.SetEndTime(time.Now())
 }
@@ -135,6 +194,8 @@ func (i *InstanceInfo) SetExpired() {
 type InstanceCache struct {
 	reservations *ReservationCache
 	pausing      *smap.Map[*InstanceInfo]
+	// migrating are the sandboxes being moved to another node, the node sync doesn't remove them.
+	migrating *smap.Map[struct{}]
 
 	cache          *lifecycleCache[*InstanceInfo]
 	insertInstance func(data *InstanceInfo, created bool) error
@@ -173,6 +234,7 @@ func NewCache(
 		createdCounter: createdCounter,
 		reservations:   NewReservationCache(),
 		pausing:        smap.New[*InstanceInfo](),
+		migrating:      smap.New[struct{}](),
 	}
 
 	cache.OnEviction(func(ctx context.Context, instanceInfo *InstanceInfo) {
@@ -222,6 +284,21 @@ func (c *InstanceCache) UnmarkAsPausing(instanceInfo *InstanceInfo) {
 	})
 }
 
+// MarkAsMigrating returns false if the sandbox is already being migrated.
+func (c *InstanceCache) MarkAsMigrating(sandboxID string) bool {
+	return c.migrating.InsertIfAbsent(sandboxID, struct{}{})
+}
+
+func (c *InstanceCache) UnmarkAsMigrating(sandboxID string) {
+	c.migrating.Remove(sandboxID)
+}
+
+func (c *InstanceCache) isMigrating(sandboxID string) bool {
+	_, ok := c.migrating.Get(sandboxID)
+
+	return ok
+}
+
 func (c *InstanceCache) WaitForPause(ctx context.Context, sandboxID string) (*node.NodeInfo, error) {
 	instanceInfo, ok := c.pausing.Get(sandboxID)
 	if !ok {
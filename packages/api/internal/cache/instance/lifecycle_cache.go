@@ -82,6 +82,24 @@ func (c *lifecycleCache[T]) SetIfAbsent(key string, value T) bool {
 	return c.running.SetIfAbsent(key, value)
 }
 
+// Replace swaps the item for the value, the previous item is not evicted.
+// If the previous item is missing or already expired, the value is expired too, so it is evicted instead.
+// It returns whether the previous item was replaced, the missing items are already being evicted.
+func (c *lifecycleCache[T]) Replace(key string, value T) bool {
+	replaced := false
+	c.running.Upsert(key, value, func(exist bool, valueInMap T, newValue T) T {
+		if !exist || valueInMap.IsExpired() {
+			newValue.SetExpired()
+		}
+
+		replaced = exist
+
+		return newValue
+	})
+
+	return replaced
+}
+
 func (c *lifecycleCache[T]) Has(key string, includeExpired bool) bool {
 	if includeExpired {
 		ok := c.evicting.Has(key)
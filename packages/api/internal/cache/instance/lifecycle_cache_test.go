@@ -97,6 +97,58 @@ func TestLifecycleCacheRemove(t *testing.T) {
 	assert.Equal(t, 0, cache.Len())
 }
 
+func TestLifecycleCacheReplace(t *testing.T) {
+	cache, cancel := newCache(t)
+	defer cancel()
+
+	evicted := atomic.Int32{}
+	cache.OnEviction(func(ctx context.Context, item *testLifecycleCacheItem) {
+		evicted.Add(1)
+	})
+
+	expired := false
+	cache.SetIfAbsent("test", &testLifecycleCacheItem{
+		expired: &expired,
+	})
+
+	replacementExpired := false
+	replacement := &testLifecycleCacheItem{
+		expired: &replacementExpired,
+	}
+
+	ok := cache.Replace("test", replacement)
+	assert.True(t, ok)
+
+	time.Sleep(1 * time.Second)
+
+	item, ok := cache.Get("test")
+	assert.True(t, ok)
+	assert.Same(t, replacement, item)
+	assert.Equal(t, int32(0), evicted.Load())
+}
+
+func TestLifecycleCacheReplaceMissing(t *testing.T) {
+	cache, cancel := newCache(t)
+	defer cancel()
+
+	evicted := atomic.Int32{}
+	cache.OnEviction(func(ctx context.Context, item *testLifecycleCacheItem) {
+		evicted.Add(1)
+	})
+
+	expired := false
+	ok := cache.Replace("test", &testLifecycleCacheItem{
+		expired: &expired,
+	})
+	assert.False(t, ok)
+	assert.True(t, expired)
+
+	time.Sleep(1 * time.Second)
+
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, int32(1), evicted.Load())
+}
+
 func TestLifecycleCacheItems(t *testing.T) {
 	cache, cancel := newCache(t)
 	defer cancel()
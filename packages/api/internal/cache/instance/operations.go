@@ -120,6 +120,18 @@ func (c *InstanceCache) Delete(instanceID string, pause bool) bool {
 	return found
 }
 
+// Replace the running instance with the instance moved to another node, without running the delete hook.
+// If the sandbox is no longer running, the moved instance is removed instead.
+// It returns whether the previous instance was replaced.
+func (c *InstanceCache) Replace(instance *InstanceInfo) bool {
+	sbxlogger.I(instance).Debug("Replacing sandbox in cache",
+		zap.String("client_id", instance.Instance.ClientID),
+		zap.String("execution_id", instance.ExecutionID),
+	)
+
+	return c.cache.Replace(instance.Instance.SandboxID, instance)
+}
+
 func (c *InstanceCache) Items() []*InstanceInfo {
 	return c.cache.Items()
 }
@@ -71,6 +71,10 @@ func (c *InstanceCache) Sync(ctx context.Context, instances []*InstanceInfo, nod
 		if time.Since(item.StartTime) <= syncSandboxRemoveGracePeriod {
 			continue
 		}
+		// The migrated sandbox is removed from the node before it is running on the target node.
+		if c.isMigrating(item.Instance.SandboxID) {
+			continue
+		}
 		_, found := instanceMap[item.Instance.SandboxID]
 		if !found {
 			c.cache.Remove(item.Instance.SandboxID)
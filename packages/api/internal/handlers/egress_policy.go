@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// maxEgressPolicyEntries is the number of entries each list of the egress policy can have.
+const maxEgressPolicyEntries = 100
+
+var domainLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// getEgressPolicy validates the requested egress policy and converts it to the orchestrator one, nil means no restrictions.
+func getEgressPolicy(policy *api.SandboxEgressPolicy) (*orchestrator.SandboxEgressPolicy, *api.APIError) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	var allowedCIDRs, deniedCIDRs, allowedDomains []string
+	if policy.AllowedCidrs != nil {
+		allowedCIDRs = *policy.AllowedCidrs
+	}
+	if policy.DeniedCidrs != nil {
+		deniedCIDRs = *policy.DeniedCidrs
+	}
+	if policy.AllowedDomains != nil {
+		allowedDomains = *policy.AllowedDomains
+	}
+
+	if len(allowedCIDRs) == 0 && len(deniedCIDRs) == 0 && len(allowedDomains) == 0 {
+		return nil, nil
+	}
+
+	for name, entries := range map[string][]string{"allowedCidrs": allowedCIDRs, "deniedCidrs": deniedCIDRs, "allowedDomains": allowedDomains} {
+		if len(entries) > maxEgressPolicyEntries {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("The egress policy %s can have at most %d entries", name, maxEgressPolicyEntries),
+				Err:       fmt.Errorf("too many egress policy %s: %d", name, len(entries)),
+			}
+		}
+	}
+
+	for _, cidr := range append(append([]string{}, allowedCIDRs...), deniedCIDRs...) {
+		if !validEgressCIDR(cidr) {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid egress CIDR '%s', it must be an IPv4 or IPv6 address or CIDR", cidr),
+				Err:       fmt.Errorf("invalid egress CIDR: %s", cidr),
+			}
+		}
+	}
+
+	// The blocked ranges are dropped before the allowed CIDRs, the sandboxes can't reach the node and its network.
+	for _, cidr := range allowedCIDRs {
+		if blocked, ok := blockedEgressRange(cidr); ok {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("The allowed egress CIDR '%s' overlaps the blocked range '%s'", cidr, blocked),
+				Err:       fmt.Errorf("allowed egress CIDR %s overlaps blocked range %s", cidr, blocked),
+			}
+		}
+	}
+
+	domains := make([]string, 0, len(allowedDomains))
+	for _, domain := range allowedDomains {
+		normalized := strings.TrimSuffix(strings.ToLower(domain), ".")
+		if !validEgressDomain(normalized) {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid egress domain '%s', it must be a domain name optionally prefixed with '*.'", domain),
+				Err:       fmt.Errorf("invalid egress domain: %s", domain),
+			}
+		}
+
+		domains = append(domains, normalized)
+	}
+
+	return &orchestrator.SandboxEgressPolicy{
+		AllowedCidrs:   allowedCIDRs,
+		DeniedCidrs:    deniedCIDRs,
+		AllowedDomains: domains,
+	}, nil
+}
+
+func validEgressCIDR(cidr string) bool {
+	if strings.Contains(cidr, "/") {
+		_, err := netip.ParsePrefix(cidr)
+
+		return err == nil
+	}
+
+	addr, err := netip.ParseAddr(cidr)
+
+	// The zone of the link-local addresses has no meaning outside the sandbox.
+	return err == nil && addr.Zone() == ""
+}
+
+// blockedEgressRange returns the blocked range the valid CIDR overlaps.
+func blockedEgressRange(cidr string) (string, bool) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return "", false
+		}
+
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	// The IPv4-mapped IPv6 addresses are IPv4 addresses in the sandbox firewall.
+	if addr := prefix.Addr(); addr.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+	}
+
+	for _, blocked := range consts.BlockedEgressRanges {
+		if prefix.Overlaps(netip.MustParsePrefix(blocked)) {
+			return blocked, true
+		}
+	}
+
+	return "", false
+}
+
+// validEgressDomain checks the lowercase domain, *.example.com matches the subdomains of example.com.
+func validEgressDomain(domain string) bool {
+	domain = strings.TrimPrefix(domain, "*.")
+	if len(domain) > 253 {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if !domainLabelRegex.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
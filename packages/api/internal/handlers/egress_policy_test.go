@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestGetEgressPolicy(t *testing.T) {
+	policy, apiErr := getEgressPolicy(nil)
+	require.Nil(t, apiErr)
+	assert.Nil(t, policy)
+
+	policy, apiErr = getEgressPolicy(&api.SandboxEgressPolicy{AllowedCidrs: &[]string{}})
+	require.Nil(t, apiErr)
+	assert.Nil(t, policy)
+
+	policy, apiErr = getEgressPolicy(&api.SandboxEgressPolicy{
+		AllowedCidrs:   &[]string{"8.8.8.8", "1.1.0.0/16"},
+		DeniedCidrs:    &[]string{"0.0.0.0/0"},
+		AllowedDomains: &[]string{"PyPI.org.", "*.files.pythonhosted.org"},
+	})
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxEgressPolicy{
+		AllowedCidrs:   []string{"8.8.8.8", "1.1.0.0/16"},
+		DeniedCidrs:    []string{"0.0.0.0/0"},
+		AllowedDomains: []string{"pypi.org", "*.files.pythonhosted.org"},
+	}, policy)
+
+	policy, apiErr = getEgressPolicy(&api.SandboxEgressPolicy{
+		AllowedCidrs: &[]string{"2001:db8::1", "2001:db8:1::/48"},
+		DeniedCidrs:  &[]string{"::/0"},
+	})
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxEgressPolicy{
+		AllowedCidrs:   []string{"2001:db8::1", "2001:db8:1::/48"},
+		DeniedCidrs:    []string{"::/0"},
+		AllowedDomains: []string{},
+	}, policy)
+}
+
+func TestGetEgressPolicy_Invalid(t *testing.T) {
+	tooMany := make([]string, maxEgressPolicyEntries+1)
+	for i := range tooMany {
+		tooMany[i] = "1.1.1.1"
+	}
+
+	for name, policy := range map[string]*api.SandboxEgressPolicy{
+		"invalid cidr":  {AllowedCidrs: &[]string{"10.0.0.0/33"}},
+		"ipv6 zone":     {AllowedCidrs: &[]string{"fe80::1%eth0"}},
+		"metadata":      {AllowedCidrs: &[]string{"169.254.169.254/32"}},
+		"private":       {AllowedCidrs: &[]string{"10.0.0.0/8"}},
+		"mapped":        {AllowedCidrs: &[]string{"::ffff:192.168.1.1"}},
+		"everything":    {AllowedCidrs: &[]string{"0.0.0.0/0", "::/0"}},
+		"unique local":  {AllowedCidrs: &[]string{"fd00::/8"}},
+		"domain":        {AllowedDomains: &[]string{"localhost"}},
+		"wildcard":      {AllowedDomains: &[]string{"*.*.example.com"}},
+		"invalid label": {AllowedDomains: &[]string{"-example.com"}},
+		"too many":      {AllowedCidrs: &tooMany},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, apiErr := getEgressPolicy(policy)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+		})
+	}
+}
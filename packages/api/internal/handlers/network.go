@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	orch "github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// getNetwork validates the name of the requested team network and returns the network of the team, nil means no team network.
+// The names follow the rules of the DNS labels, the network is created when the first sandbox is attached to it.
+func getNetwork(teamID uuid.UUID, name *string) (*orchestrator.SandboxNetwork, *api.APIError) {
+	if name == nil {
+		return nil, nil
+	}
+
+	if !domainLabelRegex.MatchString(*name) {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("Invalid network name '%s', it must consist of lowercase letters, digits and hyphens", *name),
+			Err:       fmt.Errorf("invalid network name '%s'", *name),
+		}
+	}
+
+	return orch.NewSandboxNetwork(teamID, *name, ""), nil
+}
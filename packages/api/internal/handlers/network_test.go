@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestGetNetwork(t *testing.T) {
+	teamID := uuid.New()
+
+	network, apiErr := getNetwork(teamID, nil)
+	require.Nil(t, apiErr)
+	assert.Nil(t, network)
+
+	name := "backend-1"
+	network, apiErr = getNetwork(teamID, &name)
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxNetwork{NetworkId: teamID.String() + "/backend-1"}, network)
+
+	for _, invalid := range []string{"", "Backend", "-backend", "backend_1", "team/backend"} {
+		t.Run(invalid, func(t *testing.T) {
+			_, apiErr := getNetwork(teamID, &invalid)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+		})
+	}
+}
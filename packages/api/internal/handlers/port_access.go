@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	orch "github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// maxPortAccessPorts is the number of ports the port access settings can list.
+const maxPortAccessPorts = 100
+
+// getPortAccess validates the requested port access settings and converts them to the orchestrator ones, nil means all the ports are public.
+// The protected ports need the envd access token of a secure sandbox to derive the port access token from.
+func getPortAccess(access *api.SandboxPortAccess, secure bool) (*orchestrator.SandboxPortAccess, *api.APIError) {
+	if access == nil {
+		return nil, nil
+	}
+
+	defaultExposure := api.Public
+	if access.DefaultExposure != nil {
+		defaultExposure = *access.DefaultExposure
+	}
+
+	var ports []api.SandboxPort
+	if access.Ports != nil {
+		ports = *access.Ports
+	}
+
+	if defaultExposure == api.Public && len(ports) == 0 {
+		return nil, nil
+	}
+
+	if len(ports) > maxPortAccessPorts {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("The port access can list at most %d ports", maxPortAccessPorts),
+			Err:       fmt.Errorf("too many port access ports: %d", len(ports)),
+		}
+	}
+
+	exposures := make([]api.PortExposure, 0, len(ports)+1)
+	exposures = append(exposures, defaultExposure)
+
+	seen := make(map[int32]bool, len(ports))
+	result := make([]*orchestrator.SandboxPort, 0, len(ports))
+	for _, port := range ports {
+		if port.Port < 1 || port.Port > 65535 {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid port %d, it must be between 1 and 65535", port.Port),
+				Err:       fmt.Errorf("invalid port: %d", port.Port),
+			}
+		}
+
+		if int64(port.Port) == consts.DefaultEnvdServerPort {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("The exposure of the port %d can't be changed, it's used by the sandbox", port.Port),
+				Err:       fmt.Errorf("envd port in the port access: %d", port.Port),
+			}
+		}
+
+		if seen[port.Port] {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("The port %d is listed more than once", port.Port),
+				Err:       fmt.Errorf("duplicate port in the port access: %d", port.Port),
+			}
+		}
+		seen[port.Port] = true
+
+		exposures = append(exposures, port.Exposure)
+		result = append(result, &orchestrator.SandboxPort{
+			Port:     uint64(port.Port),
+			Exposure: orch.PortExposure(port.Exposure),
+		})
+	}
+
+	for _, exposure := range exposures {
+		switch exposure {
+		case api.Public, api.Closed:
+		case api.Protected:
+			if !secure {
+				return nil, &api.APIError{
+					Code:      http.StatusBadRequest,
+					ClientMsg: "Protected ports are only available for secure sandboxes",
+					Err:       errors.New("protected port exposure without secure sandbox"),
+				}
+			}
+		default:
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid port exposure '%s', it must be one of public, protected or closed", exposure),
+				Err:       fmt.Errorf("invalid port exposure: %s", exposure),
+			}
+		}
+	}
+
+	return &orchestrator.SandboxPortAccess{
+		DefaultExposure: orch.PortExposure(defaultExposure),
+		Ports:           result,
+	}, nil
+}
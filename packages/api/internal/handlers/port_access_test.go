@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestGetPortAccess(t *testing.T) {
+	access, apiErr := getPortAccess(nil, false)
+	require.Nil(t, apiErr)
+	assert.Nil(t, access)
+
+	public := api.Public
+	access, apiErr = getPortAccess(&api.SandboxPortAccess{DefaultExposure: &public}, false)
+	require.Nil(t, apiErr)
+	assert.Nil(t, access)
+
+	closed := api.Closed
+	access, apiErr = getPortAccess(&api.SandboxPortAccess{
+		DefaultExposure: &closed,
+		Ports: &[]api.SandboxPort{
+			{Port: 3000, Exposure: api.Public},
+			{Port: 8080, Exposure: api.Protected},
+		},
+	}, true)
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxPortAccess{
+		DefaultExposure: orchestrator.PortExposure_Closed,
+		Ports: []*orchestrator.SandboxPort{
+			{Port: 3000, Exposure: orchestrator.PortExposure_Public},
+			{Port: 8080, Exposure: orchestrator.PortExposure_Protected},
+		},
+	}, access)
+}
+
+func TestGetPortAccess_Invalid(t *testing.T) {
+	tooMany := make([]api.SandboxPort, maxPortAccessPorts+1)
+	for i := range tooMany {
+		tooMany[i] = api.SandboxPort{Port: int32(i + 1), Exposure: api.Closed}
+	}
+
+	protected := api.Protected
+	for name, access := range map[string]*api.SandboxPortAccess{
+		"not secure":       {DefaultExposure: &protected},
+		"invalid port":     {Ports: &[]api.SandboxPort{{Port: 0, Exposure: api.Closed}}},
+		"envd port":        {Ports: &[]api.SandboxPort{{Port: 49983, Exposure: api.Closed}}},
+		"duplicate port":   {Ports: &[]api.SandboxPort{{Port: 3000, Exposure: api.Closed}, {Port: 3000, Exposure: api.Public}}},
+		"invalid exposure": {Ports: &[]api.SandboxPort{{Port: 3000, Exposure: "hidden"}}},
+		"too many":         {Ports: &tooMany},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, apiErr := getPortAccess(access, false)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -31,6 +32,12 @@ func (a *APIStore) startSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAccessToken *string,
+	previousEnvdAccessToken *string,
+	volumes []*orchestrator.SandboxVolumeMount,
+	resources *orchestrator.SandboxResources,
+	egressPolicy *orchestrator.SandboxEgressPolicy,
+	portAccess *orchestrator.SandboxPortAccess,
+	network *orchestrator.SandboxNetwork,
 ) (*api.Sandbox, string, *api.APIError) {
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
@@ -54,6 +61,12 @@ func (a *APIStore) startSandbox(
 		baseTemplateID,
 		autoPause,
 		envdAccessToken,
+		previousEnvdAccessToken,
+		volumes,
+		resources,
+		egressPolicy,
+		portAccess,
+		network,
 	)
 	if instanceErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when creating instance", instanceErr.Err)
@@ -96,5 +109,7 @@ func (a *APIStore) startSandbox(
 		Alias:           &alias,
 		EnvdVersion:     *build.EnvdVersion,
 		EnvdAccessToken: envdAccessToken,
+		Network:         sandbox.Network,
+		PrivateAddress:  sandbox.PrivateAddress,
 	}, executionID, nil
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -138,6 +139,58 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		autoPause = *body.AutoPause
 	}
 
+	var volumeMounts []api.SandboxVolumeMount
+	if body.VolumeMounts != nil {
+		volumeMounts = *body.VolumeMounts
+	}
+
+	// Sandboxes with volumes can't be paused, the volumes are attached to a single running sandbox.
+	if autoPause && len(volumeMounts) > 0 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Sandboxes with volumes cannot be auto-paused")
+
+		return
+	}
+
+	volumes, volumesErr := a.getVolumeMounts(ctx, teamInfo.Team.ID, volumeMounts)
+	if volumesErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting volume mounts", volumesErr.Err)
+		a.sendAPIStoreError(c, volumesErr.Code, volumesErr.ClientMsg)
+
+		return
+	}
+
+	egressPolicy, egressErr := getEgressPolicy(body.EgressPolicy)
+	if egressErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when validating egress policy", egressErr.Err)
+		a.sendAPIStoreError(c, egressErr.Code, egressErr.ClientMsg)
+
+		return
+	}
+
+	portAccess, portAccessErr := getPortAccess(body.PortAccess, body.Secure != nil && *body.Secure)
+	if portAccessErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when validating port access", portAccessErr.Err)
+		a.sendAPIStoreError(c, portAccessErr.Code, portAccessErr.ClientMsg)
+
+		return
+	}
+
+	network, networkErr := getNetwork(teamInfo.Team.ID, body.Network)
+	if networkErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when validating network", networkErr.Err)
+		a.sendAPIStoreError(c, networkErr.Code, networkErr.ClientMsg)
+
+		return
+	}
+
+	resources, resourcesErr := getInitialResources(teamInfo.Tier, *build, body.CpuCount, body.MemoryMB)
+	if resourcesErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when validating resources", resourcesErr.Err)
+		a.sendAPIStoreError(c, resourcesErr.Code, resourcesErr.ClientMsg)
+
+		return
+	}
+
 	var envdAccessToken *string = nil
 	if body.Secure != nil && *body.Secure == true {
 		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
@@ -165,6 +218,12 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		env.TemplateID,
 		autoPause,
 		envdAccessToken,
+		nil,
+		volumes,
+		resources,
+		egressPolicy,
+		portAccess,
+		network,
 	)
 	if createErr != nil {
 		zap.L().Error("Failed to create sandbox", zap.Error(createErr.Err))
@@ -182,21 +241,26 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 }
 
 func (a *APIStore) registerSandboxInCatalog(ctx context.Context, teamInfo authcache.AuthTeamInfo, sbx *api.Sandbox, executionID string) error {
-	if teamInfo.Team.ClusterID == nil {
+	return a.storeSandboxInCatalog(ctx, teamInfo.Team.ClusterID, sbx, executionID, int64(teamInfo.Tier.MaxLengthHours), time.Now().UTC())
+}
+
+// storeSandboxInCatalog creates or replaces the catalog entry used by the edge to route the sandbox traffic to its node.
+func (a *APIStore) storeSandboxInCatalog(ctx context.Context, clusterID *uuid.UUID, sbx *api.Sandbox, executionID string, maxLengthHours int64, startTime time.Time) error {
+	if clusterID == nil {
 		return fmt.Errorf("team has no cluster assigned")
 	}
 
-	cluster, ok := a.clustersPool.GetClusterById(*teamInfo.Team.ClusterID)
+	cluster, ok := a.clustersPool.GetClusterById(*clusterID)
 	if !ok || cluster == nil {
-		return fmt.Errorf("cluster %s unavailable", teamInfo.Team.ClusterID.String())
+		return fmt.Errorf("cluster %s unavailable", clusterID.String())
 	}
 
 	body := edgeapi.SandboxCreateCatalogRequest{
 		ExecutionId:      executionID,
 		OrchestratorId:   sbx.ClientID,
 		SandboxId:        sbx.SandboxID,
-		SandboxMaxLength: int64(teamInfo.Tier.MaxLengthHours),
-		SandboxStartTime: time.Now().UTC(),
+		SandboxMaxLength: maxLengthHours,
+		SandboxStartTime: startTime,
 	}
 
 	resp, err := cluster.GetHttpClient().V1SandboxCatalogCreateWithResponse(ctx, edgeapi.V1SandboxCatalogCreateJSONRequestBody(body))
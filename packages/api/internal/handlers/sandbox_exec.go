@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -147,7 +148,7 @@ func (a *APIStore) PostSandboxesSandboxIDExec(c *gin.Context, sandboxID string)
 		if edgeResp.JSON404 != nil {
 			msg = edgeResp.JSON404.Message
 		}
-		telemetry.ReportError(ctx, "sandbox exec sandbox not found", fmt.Errorf(msg), telemetry.WithSandboxID(sandboxID))
+		telemetry.ReportError(ctx, "sandbox exec sandbox not found", errors.New(msg), telemetry.WithSandboxID(sandboxID))
 		a.sendAPIStoreError(c, http.StatusNotFound, msg)
 		return
 
@@ -156,7 +157,7 @@ func (a *APIStore) PostSandboxesSandboxIDExec(c *gin.Context, sandboxID string)
 		if edgeResp.JSON400 != nil {
 			msg = edgeResp.JSON400.Message
 		}
-		telemetry.ReportError(ctx, "sandbox exec bad request", fmt.Errorf(msg), telemetry.WithSandboxID(sandboxID))
+		telemetry.ReportError(ctx, "sandbox exec bad request", errors.New(msg), telemetry.WithSandboxID(sandboxID))
 		a.sendAPIStoreError(c, http.StatusBadRequest, msg)
 		return
 
@@ -165,7 +166,7 @@ func (a *APIStore) PostSandboxesSandboxIDExec(c *gin.Context, sandboxID string)
 		if edgeResp.JSON401 != nil {
 			msg = edgeResp.JSON401.Message
 		}
-		telemetry.ReportError(ctx, "sandbox exec unauthorized", fmt.Errorf(msg), telemetry.WithSandboxID(sandboxID))
+		telemetry.ReportError(ctx, "sandbox exec unauthorized", errors.New(msg), telemetry.WithSandboxID(sandboxID))
 		a.sendAPIStoreError(c, http.StatusUnauthorized, msg)
 		return
 
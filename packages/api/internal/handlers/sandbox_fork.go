@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	maxForkCount = 10
+
+	minEnvdVersionForForkRekey = "0.2.1" // Minimum version of envd that can change the access token of a forked sandbox
+)
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if body.Count < 1 || body.Count > maxForkCount {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Count must be between 1 and %d", maxForkCount))
+
+		return
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamInfo.Team.ID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error forking sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		return
+	}
+
+	if sbx.EnvdAccessToken != nil && !sharedUtils.IsGTEVersion(sbx.EnvdVersion, minEnvdVersionForForkRekey) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "current template build does not support forking secured sandboxes, you need to re-build template to allow it")
+
+		return
+	}
+
+	build, forkErr := a.orchestrator.ForkInstance(ctx, sbx)
+	if forkErr != nil {
+		telemetry.ReportCriticalError(ctx, "error forking sandbox", forkErr.Err)
+
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	alias := ""
+	if sbx.Instance.Alias != nil {
+		alias = *sbx.Instance.Alias
+	}
+
+	forks := make([]*api.Sandbox, body.Count)
+	errs := make([]*api.APIError, body.Count)
+
+	// The forks have the resources of the sandbox.
+	var resources *orchestrator.SandboxResources
+	if sbx.IsResized() {
+		vCpu, ramMB := sbx.GetResources()
+
+		resources = &orchestrator.SandboxResources{
+			Vcpu:  vCpu,
+			RamMb: ramMB,
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range forks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			forkID := InstanceIDPrefix + id.Generate()
+
+			// The forks are attached to the team network of the sandbox with their own addresses.
+			var forkNetwork *orchestrator.SandboxNetwork
+			if sbx.Network != nil {
+				forkNetwork = &orchestrator.SandboxNetwork{NetworkId: sbx.Network.GetNetworkId()}
+			}
+
+			envdAccessToken, previousEnvdAccessToken, tokenErr := forkEnvdAccessTokens(sbx, build, forkID, a.getEnvdAccessToken)
+			if tokenErr != nil {
+				errs[i] = tokenErr
+
+				return
+			}
+
+			// The forks are placed on the node of the sandbox, the snapshot is cached there.
+			fork, executionID, createErr := a.startSandbox(
+				ctx,
+				forkID,
+				timeout,
+				nil,
+				sbx.Metadata,
+				alias,
+				teamInfo,
+				build,
+				&c.Request.Header,
+				true,
+				&sbx.Instance.ClientID,
+				sbx.BaseTemplateID,
+				sbx.AutoPause.Load(),
+				envdAccessToken,
+				previousEnvdAccessToken,
+				nil,
+				resources,
+				sbx.EgressPolicy,
+				sbx.PortAccess,
+				forkNetwork,
+			)
+			if createErr != nil {
+				errs[i] = createErr
+
+				return
+			}
+
+			if err := a.registerSandboxInCatalog(ctx, teamInfo, fork, executionID); err != nil {
+				zap.L().Warn("failed to register sandbox in catalog", logger.WithSandboxID(fork.SandboxID), zap.Error(err))
+			}
+
+			forks[i] = fork
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, createErr := range errs {
+		if createErr == nil {
+			continue
+		}
+
+		// The forks are created all or nothing, the already running ones are killed.
+		for _, fork := range forks {
+			if fork != nil {
+				a.orchestrator.DeleteInstance(ctx, fork.SandboxID, false)
+			}
+		}
+
+		zap.L().Error("Failed to fork sandbox", logger.WithSandboxID(sandboxID), zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	zap.L().Info("Forked sandbox", logger.WithSandboxID(sandboxID), zap.Int32("count", body.Count))
+
+	c.JSON(http.StatusCreated, forks)
+}
+
+// forkEnvdAccessTokens returns the envd access token of the fork and the token of the forked sandbox.
+// The envd in the snapshot still has the token of the forked sandbox, it is re-keyed to the token of the fork when the fork starts.
+func forkEnvdAccessTokens(
+	sbx *instance.InstanceInfo,
+	build queries.EnvBuild,
+	forkID string,
+	generate func(envdVersion *string, sandboxID string) (string, *api.APIError),
+) (*string, *string, *api.APIError) {
+	if sbx.EnvdAccessToken == nil {
+		return nil, nil, nil
+	}
+
+	accessToken, err := generate(build.EnvdVersion, forkID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &accessToken, sbx.EnvdAccessToken, nil
+}
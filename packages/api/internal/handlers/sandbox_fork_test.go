@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func TestForkEnvdAccessTokens(t *testing.T) {
+	envdVersion := "0.2.1"
+	build := queries.EnvBuild{EnvdVersion: &envdVersion}
+
+	generate := func(_ *string, sandboxID string) (string, *api.APIError) {
+		return "token-" + sandboxID, nil
+	}
+
+	t.Run("secured parent", func(t *testing.T) {
+		parentToken := "token-parent"
+		parent := &instance.InstanceInfo{EnvdAccessToken: &parentToken}
+
+		token, previousToken, apiErr := forkEnvdAccessTokens(parent, build, "fork", generate)
+		require.Nil(t, apiErr)
+
+		// The fork gets its own token, the envd is re-keyed from the token of the parent.
+		require.NotNil(t, token)
+		assert.Equal(t, "token-fork", *token)
+		require.NotNil(t, previousToken)
+		assert.Equal(t, parentToken, *previousToken)
+	})
+
+	t.Run("unsecured parent", func(t *testing.T) {
+		token, previousToken, apiErr := forkEnvdAccessTokens(&instance.InstanceInfo{}, build, "fork", generate)
+		require.Nil(t, apiErr)
+		assert.Nil(t, token)
+		assert.Nil(t, previousToken)
+	})
+}
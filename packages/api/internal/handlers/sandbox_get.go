@@ -11,6 +11,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	orch "github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -36,6 +37,8 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			return
 		}
 
+		vCpu, ramMB := info.GetResources()
+
 		// Sandbox exists and belongs to the team - return running sandbox info
 		sandbox := api.SandboxDetail{
 			ClientID:        info.Instance.ClientID,
@@ -43,8 +46,8 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			Alias:           info.Instance.Alias,
 			SandboxID:       info.Instance.SandboxID,
 			StartedAt:       info.StartTime,
-			CpuCount:        api.CPUCount(info.VCpu),
-			MemoryMB:        api.MemoryMB(info.RamMB),
+			CpuCount:        api.CPUCount(vCpu),
+			MemoryMB:        api.MemoryMB(ramMB),
 			EndAt:           info.GetEndTime(),
 			State:           api.Running,
 			EnvdVersion:     &info.EnvdVersion,
@@ -56,6 +59,12 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			sandbox.Metadata = &meta
 		}
 
+		if info.Network != nil {
+			name := orch.NetworkName(info.Network)
+			sandbox.Network = &name
+			sandbox.PrivateAddress = &info.Network.Address
+		}
+
 		c.JSON(http.StatusOK, sandbox)
 		return
 	}
@@ -71,6 +80,12 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 	memoryMB := int32(lastSnapshot.EnvBuild.RamMb)
 	cpuCount := int32(lastSnapshot.EnvBuild.Vcpu)
 
+	// The sandbox is resumed with the resources it was limited to.
+	if lastSnapshot.Snapshot.VcpuLimit != nil && lastSnapshot.Snapshot.RamMbLimit != nil {
+		memoryMB = int32(*lastSnapshot.Snapshot.RamMbLimit)
+		cpuCount = int32(*lastSnapshot.Snapshot.VcpuLimit)
+	}
+
 	var sbxAccessToken *string = nil
 	if lastSnapshot.Snapshot.EnvSecure {
 		key, err := a.envdAccessTokenGenerator.GenerateAccessToken(lastSnapshot.Snapshot.SandboxID)
@@ -101,5 +116,10 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 		sandbox.Metadata = &metadata
 	}
 
+	// The private address is allocated again on resume, only the network is returned for the paused sandbox.
+	if network := lastSnapshot.Snapshot.Network; network != nil {
+		sandbox.Network = &network.Name
+	}
+
 	c.JSON(http.StatusOK, sandbox)
 }
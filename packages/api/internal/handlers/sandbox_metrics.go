@@ -110,12 +110,23 @@ func (a *APIStore) GetSandboxesSandboxIDMetricsFromClickhouse(
 	// XXX avoid this conversion to be more efficient
 	apiMetrics := make([]api.SandboxMetric, len(metrics))
 	for i, m := range metrics {
+		netRxBytes := int64(m.NetRxBytes)
+		netTxBytes := int64(m.NetTxBytes)
+		netRxPackets := int64(m.NetRxPackets)
+		netTxPackets := int64(m.NetTxPackets)
+		netNewConnections := int64(m.NetNewConnections)
+
 		apiMetrics[i] = api.SandboxMetric{
-			Timestamp:   m.Timestamp,
-			CpuUsedPct:  m.CPUUsedPercent,
-			CpuCount:    int32(m.CPUCount),
-			MemTotalMiB: int64(m.MemTotalMiB),
-			MemUsedMiB:  int64(m.MemUsedMiB),
+			Timestamp:         m.Timestamp,
+			CpuUsedPct:        m.CPUUsedPercent,
+			CpuCount:          int32(m.CPUCount),
+			MemTotalMiB:       int64(m.MemTotalMiB),
+			MemUsedMiB:        int64(m.MemUsedMiB),
+			NetRxBytes:        &netRxBytes,
+			NetTxBytes:        &netTxBytes,
+			NetRxPackets:      &netRxPackets,
+			NetTxPackets:      &netTxPackets,
+			NetNewConnections: &netNewConnections,
 		}
 	}
 
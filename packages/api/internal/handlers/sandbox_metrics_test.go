@@ -60,6 +60,12 @@ func TestAPIStore_getSandboxesSandboxIDMetricsClickhouse(t *testing.T) {
 							MemTotalMiB:    100,
 							CPUCount:       1,
 							Timestamp:      aTimestamp,
+
+							NetRxBytes:        2048,
+							NetTxBytes:        1024,
+							NetRxPackets:      20,
+							NetTxPackets:      10,
+							NetNewConnections: 3,
 						},
 					},
 					nil,
@@ -79,6 +85,12 @@ func TestAPIStore_getSandboxesSandboxIDMetricsClickhouse(t *testing.T) {
 					MemTotalMiB: 100,
 					MemUsedMiB:  100,
 					Timestamp:   aTimestamp,
+
+					NetRxBytes:        int64Ptr(2048),
+					NetTxBytes:        int64Ptr(1024),
+					NetRxPackets:      int64Ptr(20),
+					NetTxPackets:      int64Ptr(10),
+					NetNewConnections: int64Ptr(3),
 				},
 			},
 		},
@@ -232,3 +244,7 @@ func TestAPIStore_readMetricsBasedOnConfig(t *testing.T) {
 		})
 	}
 }
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PostSandboxesSandboxIDMigrate(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	var body api.PostSandboxesSandboxIDMigrateJSONRequestBody
+	// The body is optional, the least busy node is used by default.
+	if c.Request.ContentLength != 0 {
+		var err error
+
+		body, err = utils.ParseBody[api.PostSandboxesSandboxIDMigrateJSONRequestBody](ctx, c)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+			return
+		}
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	sourceNodeID := sbx.Instance.ClientID
+
+	migrated, migrateErr := a.orchestrator.MigrateInstance(ctx, sbx, body.NodeID)
+	if migrated != nil {
+		// The edge routes the sandbox traffic by the catalog, so it has to point to the node the sandbox runs on now.
+		team, err := a.db.Client.Team.Get(ctx, *migrated.TeamID)
+		if err == nil {
+			err = a.storeSandboxInCatalog(ctx, team.ClusterID, migrated.Instance, migrated.ExecutionID, int64(migrated.MaxInstanceLength.Hours()), migrated.StartTime)
+		}
+
+		if err != nil {
+			zap.L().Warn("failed to update sandbox in catalog", logger.WithSandboxID(sandboxID), zap.Error(err))
+		}
+	}
+
+	if migrateErr != nil {
+		telemetry.ReportCriticalError(ctx, "error migrating sandbox", migrateErr.Err)
+
+		a.sendAPIStoreError(c, migrateErr.Code, migrateErr.ClientMsg)
+
+		return
+	}
+
+	zap.L().Info("Migrated sandbox",
+		logger.WithSandboxID(sandboxID),
+		zap.String("source_node_id", sourceNodeID),
+		zap.String("target_node_id", migrated.Instance.ClientID),
+	)
+
+	c.JSON(http.StatusOK, migrated.Instance)
+}
@@ -57,6 +57,12 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 		return
 	}
 
+	if len(sbx.VolumeIDs) > 0 {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Error pausing sandbox - sandbox '%s' has volumes attached and cannot be paused", sandboxID))
+
+		return
+	}
+
 	found := a.orchestrator.DeleteInstance(ctx, sandboxID, true)
 	if !found {
 		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error pausing sandbox - sandbox '%s' was not found", sandboxID))
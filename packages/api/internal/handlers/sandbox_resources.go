@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// minSandboxMemoryMB is the smallest memory a sandbox can be resized to, the guest needs some memory for the kernel and envd.
+const minSandboxMemoryMB = 128
+
+func (a *APIStore) PostSandboxesSandboxIDResources(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	// Get team from context, use TeamContextKey
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDResourcesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if body.CpuCount == nil && body.MemoryMB == nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "At least one of cpuCount and memoryMB must be set")
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamInfo.Team.ID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error resizing sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamInfo.Team.ID.String()))
+
+		return
+	}
+
+	// The resources not set in the request are kept.
+	vCpu, ramMB := sbx.GetResources()
+	if body.CpuCount != nil {
+		vCpu = int64(*body.CpuCount)
+	}
+
+	if body.MemoryMB != nil {
+		ramMB = int64(*body.MemoryMB)
+	}
+
+	// The sandbox can be resized up to the size its VM booted with.
+	validationErr := validateResources(teamInfo.Tier, vCpu, ramMB, sbx.VCpu, sbx.RamMB)
+	if validationErr != nil {
+		a.sendAPIStoreError(c, validationErr.Code, validationErr.ClientMsg)
+
+		return
+	}
+
+	apiErr := a.orchestrator.ResizeSandbox(ctx, sbx, vCpu, ramMB)
+	if apiErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when resizing sandbox", apiErr.Err)
+
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getInitialResources returns the resources the sandbox starts with, nil if it starts with the resources of the template.
+func getInitialResources(tier *models.Tier, build queries.EnvBuild, cpuCount *int32, memoryMB *int32) (*orchestrator.SandboxResources, *api.APIError) {
+	if cpuCount == nil && memoryMB == nil {
+		return nil, nil
+	}
+
+	vCpu, ramMB := build.Vcpu, build.RamMb
+	if cpuCount != nil {
+		vCpu = int64(*cpuCount)
+	}
+
+	if memoryMB != nil {
+		ramMB = int64(*memoryMB)
+	}
+
+	vmVCpu, vmRAMMB := sandbox.VMResources(tier, build.Vcpu, build.RamMb)
+
+	err := validateResources(tier, vCpu, ramMB, vmVCpu, vmRAMMB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orchestrator.SandboxResources{
+		Vcpu:  vCpu,
+		RamMb: ramMB,
+	}, nil
+}
+
+// validateResources checks the resources are within the team's tier limits and the size of the VM.
+func validateResources(tier *models.Tier, vCpu int64, ramMB int64, vmVCpu int64, vmRAMMB int64) *api.APIError {
+	if vCpu < 1 || vCpu > tier.MaxVcpu {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("CPU count must be between 1 and %d (if you need to increase this limit, please contact support)", tier.MaxVcpu),
+			Err:       fmt.Errorf("cpu count %d is out of the tier limit %d", vCpu, tier.MaxVcpu),
+		}
+	}
+
+	if ramMB < minSandboxMemoryMB || ramMB > tier.MaxRAMMB {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("Memory must be between %d and %d MiB (if you need to increase this limit, please contact support)", minSandboxMemoryMB, tier.MaxRAMMB),
+			Err:       fmt.Errorf("memory %d MiB is out of the tier limit %d MiB", ramMB, tier.MaxRAMMB),
+		}
+	}
+
+	// The VM can't grow, the sandboxes booted before the tier limits were raised keep the size of their VMs.
+	if vCpu > vmVCpu || ramMB > vmRAMMB {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("The VM of the sandbox has %d CPUs and %d MiB of memory, the sandbox can't be resized above them", vmVCpu, vmRAMMB),
+			Err:       fmt.Errorf("resources %d CPUs and %d MiB are above the VM's %d CPUs and %d MiB", vCpu, ramMB, vmVCpu, vmRAMMB),
+		}
+	}
+
+	return nil
+}
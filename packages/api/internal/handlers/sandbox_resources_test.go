@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestGetInitialResources(t *testing.T) {
+	tier := &models.Tier{MaxVcpu: 8, MaxRAMMB: 8192}
+	build := queries.EnvBuild{Vcpu: 4, RamMb: 4096}
+
+	resources, apiErr := getInitialResources(tier, build, nil, nil)
+	require.Nil(t, apiErr)
+	assert.Nil(t, resources)
+
+	cpuCount := int32(2)
+	resources, apiErr = getInitialResources(tier, build, &cpuCount, nil)
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxResources{Vcpu: 2, RamMb: 4096}, resources)
+
+	// The VM boots with the tier's maximum resources, so the sandbox can start with more than the template.
+	memoryMB := int32(8192)
+	resources, apiErr = getInitialResources(tier, build, &cpuCount, &memoryMB)
+	require.Nil(t, apiErr)
+	assert.Equal(t, &orchestrator.SandboxResources{Vcpu: 2, RamMb: 8192}, resources)
+
+	for name, tc := range map[string]struct {
+		cpuCount int32
+		memoryMB int32
+	}{
+		"no cpu":                {cpuCount: 0, memoryMB: 1024},
+		"too little memory":     {cpuCount: 1, memoryMB: 64},
+		"cpu above the tier":    {cpuCount: 10, memoryMB: 1024},
+		"memory above the tier": {cpuCount: 1, memoryMB: 16384},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, apiErr := getInitialResources(tier, build, &tc.cpuCount, &tc.memoryMB)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+		})
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	orch "github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -144,6 +146,31 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		envdAccessToken = &accessToken
 	}
 
+	// The sandbox is limited to the resources it had when it was paused.
+	var resources *orchestrator.SandboxResources
+	if snap.VcpuLimit != nil && snap.RamMbLimit != nil {
+		resources = &orchestrator.SandboxResources{
+			Vcpu:  *snap.VcpuLimit,
+			RamMb: *snap.RamMbLimit,
+		}
+	}
+
+	// The egress policy the sandbox was paused with is applied again.
+	var egressPolicy *orchestrator.SandboxEgressPolicy
+	if policy := snap.EgressPolicy; policy != nil {
+		egressPolicy = &orchestrator.SandboxEgressPolicy{
+			AllowedCidrs:   policy.AllowedCIDRs,
+			DeniedCidrs:    policy.DeniedCIDRs,
+			AllowedDomains: policy.AllowedDomains,
+		}
+	}
+
+	// The ports are exposed the same way as before the sandbox was paused.
+	portAccess := orch.PortAccessFromSnapshot(snap.PortAccess)
+
+	// The sandbox is attached to its team network again, it keeps its address if it's still free.
+	network := orch.NetworkFromSnapshot(teamInfo.Team.ID, snap.Network)
+
 	sbx, executionID, createErr := a.startSandbox(
 		ctx,
 		snap.SandboxID,
@@ -159,6 +186,12 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		snap.BaseEnvID,
 		autoPause,
 		envdAccessToken,
+		nil,
+		nil,
+		resources,
+		egressPolicy,
+		portAccess,
+		network,
 	)
 
 	if createErr != nil {
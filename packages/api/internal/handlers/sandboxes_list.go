@@ -213,6 +213,11 @@ func snapshotsToPaginatedSandboxes(snapshots []queries.GetSnapshotsWithCursorRow
 			alias = &record.Aliases[0]
 		}
 
+		cpuCount, memoryMB := build.Vcpu, build.RamMb
+		if snapshot.VcpuLimit != nil && snapshot.RamMbLimit != nil {
+			cpuCount, memoryMB = *snapshot.VcpuLimit, *snapshot.RamMbLimit
+		}
+
 		sandbox := utils.PaginatedSandbox{
 			ListedSandbox: api.ListedSandbox{
 				ClientID:   "00000000", // for backwards compatibility we need to return a client id
@@ -220,8 +225,8 @@ func snapshotsToPaginatedSandboxes(snapshots []queries.GetSnapshotsWithCursorRow
 				TemplateID: snapshot.BaseEnvID,
 				SandboxID:  snapshot.SandboxID,
 				StartedAt:  snapshot.SandboxStartedAt.Time,
-				CpuCount:   int32(build.Vcpu),
-				MemoryMB:   int32(build.RamMb),
+				CpuCount:   int32(cpuCount),
+				MemoryMB:   int32(memoryMB),
 				EndAt:      snapshot.CreatedAt.Time,
 				State:      api.Paused,
 			},
@@ -244,6 +249,8 @@ func instanceInfoToPaginatedSandboxes(runningSandboxes []*instance.InstanceInfo)
 
 	// Add running sandboxes to results
 	for _, info := range runningSandboxes {
+		vCpu, ramMB := info.GetResources()
+
 		sandbox := utils.PaginatedSandbox{
 			ListedSandbox: api.ListedSandbox{
 				ClientID:   info.Instance.ClientID,
@@ -251,8 +258,8 @@ func instanceInfoToPaginatedSandboxes(runningSandboxes []*instance.InstanceInfo)
 				Alias:      info.Instance.Alias,
 				SandboxID:  info.Instance.SandboxID,
 				StartedAt:  info.StartTime,
-				CpuCount:   api.CPUCount(info.VCpu),
-				MemoryMB:   api.MemoryMB(info.RamMB),
+				CpuCount:   api.CPUCount(vCpu),
+				MemoryMB:   api.MemoryMB(ramMB),
 				EndAt:      info.GetEndTime(),
 				State:      api.Running,
 			},
@@ -94,7 +94,7 @@ func (a *APIStore) PatchTemplatesTemplateID(c *gin.Context, aliasOrTemplateID ap
 	if body.Public != nil {
 		// Update env
 		dbErr := a.db.UpdateEnv(ctx, template.ID, db.UpdateEnvInput{
-			Public: *body.Public,
+			Public: body.Public,
 		})
 
 		if dbErr != nil {
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// maxWarmPoolSize limits the sandboxes kept booted per template on each node, they take the node resources even when not used.
+const maxWarmPoolSize = 10
+
+// PutTemplatesTemplateIDWarmPool sets the number of sandboxes kept booted for the template, the nodes pick up the change on the next sync.
+func (a *APIStore) PutTemplatesTemplateIDWarmPool(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PutTemplatesTemplateIDWarmPoolJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+
+		return
+	}
+
+	if body.Size < 0 || body.Size > maxWarmPoolSize {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Warm pool size must be between 0 and %d", maxWarmPoolSize))
+
+		return
+	}
+
+	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid env ID: %s", aliasOrTemplateID))
+
+		telemetry.ReportCriticalError(ctx, "invalid env ID", err)
+
+		return
+	}
+
+	template, err := a.db.GetEnv(ctx, cleanedAliasOrEnvID)
+	if errors.Is(err, db.TemplateNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("the sandbox template '%s' wasn't found", cleanedAliasOrEnvID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportError(ctx, "failed to get env", err, telemetry.WithTemplateID(cleanedAliasOrEnvID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting env")
+
+		return
+	}
+
+	err = a.db.UpdateEnv(ctx, template.ID, db.UpdateEnvInput{
+		WarmPoolSize: &body.Size,
+	})
+	if err != nil {
+		telemetry.ReportError(ctx, "error when updating env warm pool", err, telemetry.WithTemplateID(template.ID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when updating env")
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "updated env warm pool", telemetry.WithTemplateID(template.ID))
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// maxVolumeMounts is the number of volume drive slots the sandboxes are created with.
+	maxVolumeMounts = 4
+	// minVolumeSizeMB is the smallest volume that fits the filesystem metadata, the largest is limited by the team's tier.
+	minVolumeSizeMB = 64
+)
+
+var volumeNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+func (a *APIStore) GetVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	volumesDB, err := a.db.Client.Volume.
+		Query().
+		Where(volume.TeamID(teamID)).
+		Order(models.Asc(volume.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting volumes")
+
+		telemetry.ReportCriticalError(ctx, "error when getting volumes", err)
+
+		return
+	}
+
+	volumes := make([]api.Volume, len(volumesDB))
+	for i, v := range volumesDB {
+		volumes[i] = volumeToAPI(v)
+	}
+
+	c.JSON(http.StatusOK, volumes)
+}
+
+func (a *APIStore) PostVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.NewVolume](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if !volumeNameRegex.MatchString(body.Name) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid volume name, it can contain only lowercase letters, numbers, dashes and underscores")
+
+		return
+	}
+
+	maxSizeMB := a.GetTeamInfo(c).Tier.MaxVolumeSizeMB
+	if body.SizeMB < minVolumeSizeMB || body.SizeMB > maxSizeMB {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid volume size, it must be between %d and %d MB", minVolumeSizeMB, maxSizeMB))
+
+		return
+	}
+
+	volumeDB, err := a.db.Client.Volume.
+		Create().
+		SetID(uuid.New()).
+		SetTeamID(teamID).
+		SetName(body.Name).
+		SetSizeMB(body.SizeMB).
+		Save(ctx)
+	if models.IsConstraintError(err) {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' already exists", body.Name))
+
+		return
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when creating volume: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when creating volume", err)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, volumeToAPI(volumeDB))
+}
+
+func (a *APIStore) DeleteVolumesVolumeName(c *gin.Context, volumeName api.VolumeName) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	volumeDB, err := a.db.Client.Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.Name(volumeName)).
+		Only(ctx)
+	if models.IsNotFound(err) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Volume '%s' not found", volumeName))
+
+		return
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when getting volume: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when getting volume", err)
+
+		return
+	}
+
+	// The data is deleted before the record is, so there is no volume data left without a record.
+	err = a.db.DeleteVolume(ctx, volumeDB.ID, func(ctx context.Context) error {
+		return a.orchestrator.DeleteVolume(ctx, volumeDB.ID.String())
+	})
+	if errors.Is(err, db.ErrVolumeAttached) {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' is attached to a sandbox", volumeName))
+
+		return
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting volume")
+
+		telemetry.ReportCriticalError(ctx, "error when deleting volume", err)
+
+		return
+	}
+
+	zap.L().Info("Deleted volume", zap.String("volume_id", volumeDB.ID.String()), zap.String("team_id", teamID.String()))
+
+	c.Status(http.StatusNoContent)
+}
+
+// getVolumeMounts resolves the requested volume mounts to the team's volumes.
+func (a *APIStore) getVolumeMounts(ctx context.Context, teamID uuid.UUID, mounts []api.SandboxVolumeMount) ([]*orchestrator.SandboxVolumeMount, *api.APIError) {
+	if len(mounts) == 0 {
+		return nil, nil
+	}
+
+	if len(mounts) > maxVolumeMounts {
+		return nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("At most %d volumes can be mounted in a sandbox", maxVolumeMounts),
+			Err:       fmt.Errorf("too many volume mounts: %d", len(mounts)),
+		}
+	}
+
+	names := make(map[string]struct{}, len(mounts))
+	paths := make(map[string]struct{}, len(mounts))
+	for _, mount := range mounts {
+		if !filepath.IsAbs(mount.Path) || filepath.Clean(mount.Path) == "/" {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Invalid mount path '%s', it must be an absolute path other than the root", mount.Path),
+				Err:       fmt.Errorf("invalid volume mount path: %s", mount.Path),
+			}
+		}
+
+		if _, ok := names[mount.Name]; ok {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Volume '%s' is mounted more than once", mount.Name),
+				Err:       fmt.Errorf("duplicate volume mount: %s", mount.Name),
+			}
+		}
+
+		if _, ok := paths[filepath.Clean(mount.Path)]; ok {
+			return nil, &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Multiple volumes are mounted at '%s'", mount.Path),
+				Err:       fmt.Errorf("duplicate volume mount path: %s", mount.Path),
+			}
+		}
+
+		names[mount.Name] = struct{}{}
+		paths[filepath.Clean(mount.Path)] = struct{}{}
+	}
+
+	volumeNames := make([]string, 0, len(names))
+	for name := range names {
+		volumeNames = append(volumeNames, name)
+	}
+
+	volumesDB, err := a.db.Client.Volume.
+		Query().
+		Where(volume.TeamID(teamID), volume.NameIn(volumeNames...)).
+		All(ctx)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting volumes",
+			Err:       fmt.Errorf("error when getting volumes: %w", err),
+		}
+	}
+
+	volumesByName := make(map[string]*models.Volume, len(volumesDB))
+	for _, v := range volumesDB {
+		volumesByName[v.Name] = v
+	}
+
+	result := make([]*orchestrator.SandboxVolumeMount, 0, len(mounts))
+	for _, mount := range mounts {
+		v, ok := volumesByName[mount.Name]
+		if !ok {
+			return nil, &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Volume '%s' not found", mount.Name),
+				Err:       fmt.Errorf("volume not found: %s", mount.Name),
+			}
+		}
+
+		result = append(result, &orchestrator.SandboxVolumeMount{
+			VolumeId: v.ID.String(),
+			SizeMb:   v.SizeMB,
+			Path:     filepath.Clean(mount.Path),
+		})
+	}
+
+	return result, nil
+}
+
+func volumeToAPI(v *models.Volume) api.Volume {
+	return api.Volume{
+		VolumeID:  v.ID.String(),
+		Name:      v.Name,
+		SizeMB:    v.SizeMB,
+		CreatedAt: v.CreatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/posthog/posthog-go"
@@ -98,17 +99,37 @@ func (o *Orchestrator) syncNodes(ctx context.Context, instanceCache *instance.In
 	}
 	wg.Wait()
 
+	// The nodes that couldn't be connected to have unknown sandboxes.
+	synced := atomic.Bool{}
+	synced.Store(true)
+	for _, n := range nodes {
+		if o.GetNode(n.ID) == nil {
+			synced.Store(false)
+		}
+	}
+
 	for _, n := range o.nodes.Items() {
 		wg.Add(1)
 		go func(n *Node) {
 			defer wg.Done()
-			o.syncNode(spanCtx, n, nodes, instanceCache)
+			if !o.syncNode(spanCtx, n, nodes, instanceCache) {
+				synced.Store(false)
+			}
 		}(n)
 	}
 	wg.Wait()
+
+	o.syncWarmPools(spanCtx)
+	o.syncNetworks(spanCtx)
+
+	// The volumes are released only when the sandboxes of all the nodes are known.
+	if synced.Load() {
+		o.syncVolumeAttachments(spanCtx)
+	}
 }
 
-func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.NodeInfo, instanceCache *instance.InstanceCache) {
+// syncNode updates the node status and its sandboxes in the cache, it returns false if the sandboxes couldn't be listed.
+func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.NodeInfo, instanceCache *instance.InstanceCache) bool {
 	ctx, childSpan := o.tracer.Start(ctx, "sync-node")
 	telemetry.SetAttributes(ctx, attribute.String("node.id", node.Info.ID))
 	defer childSpan.End()
@@ -132,7 +153,7 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 
 		o.nodes.Remove(node.Info.ID)
 
-		return
+		return true
 	}
 
 	syncRetrySuccess := false
@@ -167,16 +188,18 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 	if !syncRetrySuccess {
 		zap.L().Error("Failed to sync node after max retries, temporarily marking as unhealthy", zap.String("node_id", node.Info.ID))
 		node.setStatus(api.NodeStatusUnhealthy)
-		return
+		return false
 	}
 
 	builds, buildsErr := o.listCachedBuilds(ctx, node.Info.ID)
 	if buildsErr != nil {
 		zap.L().Error("Error listing cached builds", zap.Error(buildsErr))
-		return
+		return true
 	}
 
 	node.SyncBuilds(builds)
+
+	return true
 }
 
 func (o *Orchestrator) getDeleteInstanceFunction(
@@ -195,10 +218,24 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 		)
 
 		defer o.instanceCache.UnmarkAsPausing(info)
+		// The volumes are released after the sandbox is removed from the node, when their data is already synced.
+		defer o.releaseVolumes(ctx, info.Instance.SandboxID, info.VolumeIDs)
+
+		if info.Network != nil {
+			// The address is released once the sandbox is removed from the node, the other nodes stop routing to it afterward.
+			defer func() {
+				o.releaseNetworkAddress(info.Instance.SandboxID, info.Network)
+
+				go o.syncNetworks(parentCtx)
+			}()
+		}
 
 		duration := time.Since(info.StartTime).Seconds()
 		stopTime := time.Now()
 
+		// The sandbox is accounted by the resources it is limited to, not the size of its VM.
+		vCpu, ramMB := info.GetResources()
+
 		var ct closeType
 		if info.AutoPause.Load() {
 			ct = ClosePause
@@ -216,8 +253,8 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			info.Instance.SandboxID,
 			info.ExecutionID,
 			info.Instance.TemplateID,
-			info.VCpu,
-			info.RamMB,
+			vCpu,
+			ramMB,
 			info.TotalDiskSizeMB,
 			stopTime,
 			ct,
@@ -231,8 +268,8 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			return fmt.Errorf("node '%s' not found", info.Instance.ClientID)
 		}
 
-		node.CPUUsage.Add(-info.VCpu)
-		node.RamUsage.Add(-info.RamMB)
+		node.CPUUsage.Add(-vCpu)
+		node.RamUsage.Add(-ramMB)
 
 		o.dns.Remove(ctx, info.Instance.SandboxID, node.Info.IPAddress)
 
@@ -328,12 +365,15 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 			zap.Bool("auto_pause", info.AutoPause.Load()),
 		)
 
+		// The sandbox is accounted by the resources it is limited to, not the size of its VM.
+		vCpu, ramMB := info.GetResources()
+
 		node := o.GetNode(info.Instance.ClientID)
 		if node == nil {
 			zap.L().Error("failed to get node", zap.String("node_id", info.Instance.ClientID))
 		} else {
-			node.CPUUsage.Add(info.VCpu)
-			node.RamUsage.Add(info.RamMB)
+			node.CPUUsage.Add(vCpu)
+			node.RamUsage.Add(ramMB)
 
 			o.dns.Add(ctx, info.Instance.SandboxID, node.Info.IPAddress)
 		}
@@ -342,6 +382,21 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 			o.instanceCache.MarkAsPausing(info)
 		}
 
+		// The volumes of newly created sandboxes are already reserved, this covers the sandboxes synced from the nodes.
+		if !created {
+			err := o.reserveVolumes(ctx, info.Instance.SandboxID, info.VolumeIDs)
+			if err != nil {
+				sbxlogger.I(info).Error("Error reserving volumes of a synced sandbox", zap.Error(err))
+			}
+		}
+
+		if info.Network != nil {
+			o.networkAddresses.Insert(info.Network.GetAddress(), info.Instance.SandboxID)
+
+			// Run in separate goroutine to not block sandbox creation, the peers of the sandbox are updated on all the nodes.
+			go o.syncNetworks(parentCtx)
+		}
+
 		if created {
 			// Run in separate goroutine to not block sandbox creation
 			// Also use parentCtx to not cancel the request with this hook timeout
@@ -353,8 +408,8 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 				info.ExecutionID,
 				info.Instance.TemplateID,
 				info.BuildID.String(),
-				info.VCpu,
-				info.RamMB,
+				vCpu,
+				ramMB,
 				info.TotalDiskSizeMB,
 			)
 		}
@@ -116,6 +116,7 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 			version:        nodeVersion,
 			commit:         nodeCommit,
 			sbxsInProgress: smap.New[*sbxInProgress](),
+			warmBuilds:     smap.New[int32](),
 			createFails:    atomic.Uint64{},
 		},
 	)
@@ -11,6 +11,8 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
@@ -33,6 +35,9 @@ const (
 
 var errSandboxCreateFailed = fmt.Errorf("failed to create a new sandbox, if the problem persists, contact us")
 
+// incompatibleSnapshotMsg is returned when none of the tried nodes has a Firecracker version that can resume the snapshot.
+const incompatibleSnapshotMsg = "The sandbox snapshot is not compatible with the Firecracker versions available, it can't be resumed until a compatible version is deployed"
+
 func (o *Orchestrator) CreateSandbox(
 	ctx context.Context,
 	sandboxID,
@@ -50,6 +55,12 @@ func (o *Orchestrator) CreateSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAuthToken *string,
+	previousEnvdAuthToken *string,
+	volumes []*orchestrator.SandboxVolumeMount,
+	resources *orchestrator.SandboxResources,
+	egressPolicy *orchestrator.SandboxEgressPolicy,
+	portAccess *orchestrator.SandboxPortAccess,
+	network *orchestrator.SandboxNetwork,
 ) (*api.Sandbox, *api.APIError) {
 	childCtx, childSpan := o.tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
@@ -91,6 +102,53 @@ func (o *Orchestrator) CreateSandbox(
 	telemetry.ReportEvent(childCtx, "Reserved sandbox for team")
 	defer releaseTeamSandboxReservation()
 
+	attachedVolumeIDs := volumeIDs(volumes)
+	err = o.reserveVolumes(childCtx, sandboxID, attachedVolumeIDs)
+	if errors.Is(err, ErrVolumeInUse) {
+		telemetry.ReportError(ctx, "failed to reserve volumes", err)
+
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: "A volume is already attached to another sandbox",
+			Err:       err,
+		}
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to reserve volumes", err)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to attach the volumes",
+			Err:       err,
+		}
+	}
+
+	// The reservation is kept when the sandbox is created, it is released after the sandbox is deleted.
+	volumesAttached := false
+	defer func() {
+		if !volumesAttached {
+			o.releaseVolumes(context.WithoutCancel(ctx), sandboxID, attachedVolumeIDs)
+		}
+	}()
+
+	network, err = o.reserveNetworkAddress(sandboxID, network)
+	if err != nil {
+		telemetry.ReportError(ctx, "failed to reserve network address", err)
+
+		return nil, &api.APIError{
+			Code:      http.StatusServiceUnavailable,
+			ClientMsg: "No private address available for the sandbox in the team network",
+			Err:       err,
+		}
+	}
+
+	// The address is kept when the sandbox is created, it is released after the sandbox is deleted.
+	networkAttached := false
+	defer func() {
+		if !networkAttached {
+			o.releaseNetworkAddress(sandboxID, network)
+		}
+	}()
+
 	zap.L().Info("Parsing Firecracker version", zap.String("version", build.FirecrackerVersion))
 	features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
 	if err != nil {
@@ -106,6 +164,27 @@ func (o *Orchestrator) CreateSandbox(
 
 	telemetry.ReportEvent(childCtx, "Got FC version info")
 
+	diskRateLimiter, networkRateLimiter := tierRateLimiters(team.Tier)
+
+	// The resumed VM keeps the size it was snapshotted with.
+	vCpu, ramMB := build.Vcpu, build.RamMb
+	if !isResume {
+		// The new VM boots with the tier's maximum resources and the sandbox is limited to the template's, so it can be resized up to the tier limits.
+		vCpu, ramMB = sandbox.VMResources(team.Tier, build.Vcpu, build.RamMb)
+		if resources == nil && (vCpu != build.Vcpu || ramMB != build.RamMb) {
+			resources = &orchestrator.SandboxResources{
+				Vcpu:  build.Vcpu,
+				RamMb: build.RamMb,
+			}
+		}
+	}
+
+	// The nodes are filled by the resources the sandboxes are limited to, not the size of their VMs.
+	usedVCpu, usedRAMMB := vCpu, ramMB
+	if resources != nil {
+		usedVCpu, usedRAMMB = resources.GetVcpu(), resources.GetRamMb()
+	}
+
 	sbxRequest := &orchestrator.SandboxCreateRequest{
 		Sandbox: &orchestrator.SandboxConfig{
 			BaseTemplateId:     baseTemplateID,
@@ -123,15 +202,27 @@ func (o *Orchestrator) CreateSandbox(
 			EnvdAccessToken:    envdAuthToken,
 			MaxSandboxLength:   team.Tier.MaxLengthHours,
 			HugePages:          features.HasHugePages(),
-			RamMb:              build.RamMb,
-			Vcpu:               build.Vcpu,
+			RamMb:              ramMB,
+			Vcpu:               vCpu,
 			Snapshot:           isResume,
 			AutoPause:          &autoPause,
+			DiskRateLimiter:    diskRateLimiter,
+			NetworkRateLimiter: networkRateLimiter,
+			Volumes:            volumes,
+			Resources:          resources,
+			EgressPolicy:       egressPolicy,
+			PortAccess:         portAccess,
+			Network:            network,
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
 	}
 
+	// The envd in the snapshot of a fork has the token of the forked sandbox, it is re-keyed on resume.
+	if previousEnvdAuthToken != nil {
+		sbxRequest.Sandbox.PreviousEnvdAccessToken = previousEnvdAuthToken
+	}
+
 	var node *Node
 
 	if isResume && clientID != nil {
@@ -145,6 +236,10 @@ func (o *Orchestrator) CreateSandbox(
 
 	attempt := 1
 	nodesExcluded := make(map[string]*Node)
+	// incompatibleErr is the error of the last node that couldn't resume the snapshot with its Firecracker versions.
+	var incompatibleErr error
+	// res is the response of the node that created the sandbox.
+	var res *orchestrator.SandboxCreateResponse
 	for {
 		select {
 		case <-childCtx.Done():
@@ -157,6 +252,14 @@ func (o *Orchestrator) CreateSandbox(
 			// Continue
 		}
 
+		if attempt > maxNodeRetries && incompatibleErr != nil {
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: incompatibleSnapshotMsg,
+				Err:       incompatibleErr,
+			}
+		}
+
 		if attempt > maxNodeRetries {
 			return nil, &api.APIError{
 				Code:      http.StatusInternalServerError,
@@ -166,7 +269,15 @@ func (o *Orchestrator) CreateSandbox(
 		}
 
 		if node == nil {
-			node, err = o.getLeastBusyNode(childCtx, nodesExcluded)
+			node, err = o.getLeastBusyNode(childCtx, nodesExcluded, build.ID.String())
+			if err != nil && incompatibleErr != nil {
+				return nil, &api.APIError{
+					Code:      http.StatusInternalServerError,
+					ClientMsg: incompatibleSnapshotMsg,
+					Err:       errors.Join(incompatibleErr, err),
+				}
+			}
+
 			if err != nil {
 				telemetry.ReportError(childCtx, "failed to get least busy node", err)
 
@@ -180,11 +291,11 @@ func (o *Orchestrator) CreateSandbox(
 
 		// To creating a lot of sandboxes at once on the same node
 		node.sbxsInProgress.Insert(sandboxID, &sbxInProgress{
-			MiBMemory: build.RamMb,
-			CPUs:      build.Vcpu,
+			MiBMemory: usedRAMMB,
+			CPUs:      usedVCpu,
 		})
 
-		_, err = node.Client.Sandbox.Create(childCtx, sbxRequest)
+		res, err = node.Client.Sandbox.Create(childCtx, sbxRequest)
 		// The request is done, we will either add it to the cache or remove it from the node
 		if err == nil {
 			// The sandbox was created successfully
@@ -195,6 +306,16 @@ func (o *Orchestrator) CreateSandbox(
 
 		log.Printf("failed to create sandbox '%s' on node '%s', attempt #%d: %v", sandboxID, node.Info.ID, attempt, utils.UnwrapGRPCError(err))
 
+		if isResume && status.Code(err) == codes.FailedPrecondition {
+			// The node doesn't have a Firecracker version that can load the snapshot, the other nodes may have one.
+			incompatibleErr = utils.UnwrapGRPCError(err)
+			nodesExcluded[node.Info.ID] = node
+			node = nil
+			attempt += 1
+
+			continue
+		}
+
 		// The node is not available, try again with another node
 		node.createFails.Add(1)
 	
@@ -216,9 +337,17 @@ func (o *Orchestrator) CreateSandbox(
 		attempt += 1
 	}
 
+	volumesAttached = true
+	networkAttached = true
+
 	// The build should be cached on the node now
 	node.InsertBuild(build.ID.String())
 
+	// The node reports whether it handed out a warm sandbox, it boots a new one if the warm sandboxes don't match the request.
+	if res.GetWarm() {
+		node.useWarmSandbox(build.ID.String(), vCpu, ramMB)
+	}
+
 	// The sandbox was created successfully, the resources will be counted in cache
 	defer node.sbxsInProgress.Remove(sandboxID)
 
@@ -234,6 +363,12 @@ func (o *Orchestrator) CreateSandbox(
 		EnvdAccessToken: envdAuthToken,
 	}
 
+	if network != nil {
+		name := NetworkName(network)
+		sbx.Network = &name
+		sbx.PrivateAddress = &network.Address
+	}
+
 	// This is to compensate for the time it takes to start the instance
 	// Otherwise it could cause the instance to expire before user has a chance to use it
 	startTime = time.Now()
@@ -248,9 +383,9 @@ func (o *Orchestrator) CreateSandbox(
 		time.Duration(team.Tier.MaxLengthHours)*time.Hour,
 		startTime,
 		endTime,
-		build.Vcpu,
+		vCpu,
 		*build.TotalDiskSizeMb,
-		build.RamMb,
+		ramMB,
 		build.KernelVersion,
 		build.FirecrackerVersion,
 		*build.EnvdVersion,
@@ -258,8 +393,18 @@ func (o *Orchestrator) CreateSandbox(
 		autoPause,
 		envdAuthToken,
 		baseTemplateID,
+		attachedVolumeIDs,
 	)
 
+	if resources != nil {
+		instanceInfo.SetResources(resources.GetVcpu(), resources.GetRamMb())
+	}
+
+	instanceInfo.SetRateLimiters(diskRateLimiter, networkRateLimiter)
+	instanceInfo.EgressPolicy = egressPolicy
+	instanceInfo.PortAccess = portAccess
+	instanceInfo.Network = network
+
 	cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
 	if cacheErr != nil {
 		telemetry.ReportError(ctx, "error when adding instance to cache", cacheErr)
@@ -267,6 +412,9 @@ func (o *Orchestrator) CreateSandbox(
 		deleted := o.DeleteInstance(childCtx, sbx.SandboxID, false)
 		if !deleted {
 			telemetry.ReportEvent(ctx, "instance wasn't found in cache when deleting")
+
+			o.releaseVolumes(childCtx, sandboxID, attachedVolumeIDs)
+			o.releaseNetworkAddress(sandboxID, network)
 		}
 
 		return nil, &api.APIError{
@@ -280,7 +428,7 @@ func (o *Orchestrator) CreateSandbox(
 }
 
 // getLeastBusyNode returns the least busy node, if there are no eligible nodes, it tries until one is available or the context timeouts
-func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node, buildID string) (leastBusyNode *Node, err error) {
 	ctx, cancel := context.WithTimeout(parentCtx, leastBusyNodeTimeout)
 	defer cancel()
 
@@ -288,7 +436,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 	defer childSpan.End()
 
 	// Try to find a node without waiting
-	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, buildID)
 	if err == nil {
 		return leastBusyNode, nil
 	}
@@ -301,7 +449,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 			return nil, childCtx.Err()
 		case <-ticker.C:
 			// If no node is available, wait for a bit and try again
-			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, buildID)
 			if err == nil {
 				return leastBusyNode, nil
 			}
@@ -310,8 +458,10 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 }
 
 // findLeastBusyNode finds the least busy node that is ready and not in the excluded list
-// if no node is available, returns an error
-func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+// the nodes with a warm sandbox for the build are preferred, if no node is available, returns an error
+func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node, buildID string) (leastBusyNode *Node, err error) {
+	var leastBusyWarmNode *Node
+
 	totalNodes := 0
 	for _, node := range o.nodes.Items() {
 		totalNodes++
@@ -347,6 +497,15 @@ func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastB
 		if leastBusyNode == nil || (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load() {
 			leastBusyNode = node
 		}
+
+		if node.HasWarmSandbox(buildID) && (leastBusyWarmNode == nil || (node.CPUUsage.Load()+cpuUsage) < leastBusyWarmNode.CPUUsage.Load()) {
+			leastBusyWarmNode = node
+		}
+	}
+
+	if leastBusyWarmNode != nil {
+		zap.L().Info("Selected least busy node with a warm sandbox", zap.String("node_id", leastBusyWarmNode.Info.ID), logger.WithBuildID(buildID))
+		return leastBusyWarmNode, nil
 	}
 
 	if leastBusyNode != nil {
@@ -0,0 +1,114 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkInstance snapshots the running sandbox without stopping it and returns the build of the snapshot.
+// The snapshot is cached on the node of the sandbox, the forks should be resumed from the build on the same node.
+//
+// The build is stored with the snapshots of the sandbox the same way as the builds of pause.
+func (o *Orchestrator) ForkInstance(ctx context.Context, sbx *instance.InstanceInfo) (queries.EnvBuild, *api.APIError) {
+	ctx, span := o.tracer.Start(ctx, "fork-sandbox")
+	defer span.End()
+
+	sandboxID := sbx.Instance.SandboxID
+
+	// A volume can be attached to a single sandbox only.
+	if len(sbx.VolumeIDs) > 0 {
+		return queries.EnvBuild{}, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: "Sandbox with volumes cannot be forked",
+			Err:       fmt.Errorf("sandbox '%s' has volumes attached", sandboxID),
+		}
+	}
+
+	node := o.GetNode(sbx.Instance.ClientID)
+	if node == nil {
+		return queries.EnvBuild{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Node of the sandbox not found",
+			Err:       fmt.Errorf("node '%s' not found", sbx.Instance.ClientID),
+		}
+	}
+
+	envBuild, err := o.dbClient.NewSnapshotBuild(ctx, newSnapshotInfo(sbx), *sbx.TeamID)
+	if err != nil {
+		return queries.EnvBuild{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("error creating snapshot build: %w", err),
+		}
+	}
+
+	buildID := envBuild.ID
+	templateID := *envBuild.EnvID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("node.id", node.Info.ID),
+		telemetry.WithBuildID(buildID.String()),
+	)
+
+	resp, err := node.Client.Sandbox.Fork(ctx, &orchestrator.SandboxForkRequest{
+		SandboxId:  sandboxID,
+		TemplateId: templateID,
+		BuildId:    buildID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return queries.EnvBuild{}, &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Sandbox '%s' is not running", sandboxID),
+				Err:       fmt.Errorf("sandbox '%s' not found on node '%s': %w", sandboxID, node.Info.ID, utils.UnwrapGRPCError(err)),
+			}
+		}
+
+		return queries.EnvBuild{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to snapshot sandbox",
+			Err:       fmt.Errorf("error snapshotting sandbox on node '%s': %w", node.Info.ID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Snapshotted running sandbox")
+
+	err = o.dbClient.EnvBuildSetStatus(ctx, templateID, buildID, envbuild.StatusSuccess)
+	if err != nil {
+		return queries.EnvBuild{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("error setting snapshot build status: %w", err),
+		}
+	}
+
+	node.InsertBuild(buildID.String())
+
+	config := resp.GetSandbox()
+	envdVersion := sbx.EnvdVersion
+
+	return queries.EnvBuild{
+		ID:                 buildID,
+		Status:             string(envbuild.StatusSuccess),
+		Vcpu:               config.GetVcpu(),
+		RamMb:              config.GetRamMb(),
+		TotalDiskSizeMb:    &sbx.TotalDiskSizeMB,
+		KernelVersion:      config.GetKernelVersion(),
+		FirecrackerVersion: config.GetFirecrackerVersion(),
+		EnvID:              &templateID,
+		EnvdVersion:        &envdVersion,
+	}, nil
+}
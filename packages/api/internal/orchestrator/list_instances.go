@@ -62,34 +62,43 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 			config.ExecutionId = uuid.New().String()
 		}
 
-		sandboxesInfo = append(
-			sandboxesInfo,
-			instance.NewInstanceInfo(
-				&api.Sandbox{
-					SandboxID:  config.SandboxId,
-					TemplateID: config.TemplateId,
-					Alias:      config.Alias,
-					ClientID:   node.ID, // to prevent mismatch use the node ID which we use for the request
-				},
-				config.ExecutionId,
-				&teamID,
-				&buildID,
-				config.Metadata,
-				time.Duration(config.MaxSandboxLength)*time.Hour,
-				sbx.StartTime.AsTime(),
-				sbx.EndTime.AsTime(),
-				config.Vcpu,
-				config.TotalDiskSizeMb,
-				config.RamMb,
-				config.KernelVersion,
-				config.FirecrackerVersion,
-				config.EnvdVersion,
-				node,
-				autoPause,
-				config.EnvdAccessToken,
-				config.BaseTemplateId,
-			),
+		info := instance.NewInstanceInfo(
+			&api.Sandbox{
+				SandboxID:  config.SandboxId,
+				TemplateID: config.TemplateId,
+				Alias:      config.Alias,
+				ClientID:   node.ID, // to prevent mismatch use the node ID which we use for the request
+			},
+			config.ExecutionId,
+			&teamID,
+			&buildID,
+			config.Metadata,
+			time.Duration(config.MaxSandboxLength)*time.Hour,
+			sbx.StartTime.AsTime(),
+			sbx.EndTime.AsTime(),
+			config.Vcpu,
+			config.TotalDiskSizeMb,
+			config.RamMb,
+			config.KernelVersion,
+			config.FirecrackerVersion,
+			config.EnvdVersion,
+			node,
+			autoPause,
+			config.EnvdAccessToken,
+			config.BaseTemplateId,
+			volumeIDs(config.GetVolumes()),
 		)
+
+		if resources := config.GetResources(); resources != nil {
+			info.SetResources(resources.GetVcpu(), resources.GetRamMb())
+		}
+
+		info.SetRateLimiters(config.GetDiskRateLimiter(), config.GetNetworkRateLimiter())
+		info.EgressPolicy = config.GetEgressPolicy()
+		info.PortAccess = config.GetPortAccess()
+		info.Network = config.GetNetwork()
+
+		sandboxesInfo = append(sandboxesInfo, info)
 	}
 
 	return sandboxesInfo, nil
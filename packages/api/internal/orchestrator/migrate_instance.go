@@ -0,0 +1,302 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// MigrateInstance moves the running sandbox to the target node, or to the least busy node if the target is not set.
+// The sandbox keeps its ID, the instance cache and the DNS are switched to the new node once the sandbox runs there.
+//
+// The source node keeps the sandbox paused while the target node streams the snapshot and stops it once the sandbox runs there.
+// If the sandbox can't be started on the target node, it is resumed on the source node.
+// The resumed instance is returned together with the error in that case.
+func (o *Orchestrator) MigrateInstance(ctx context.Context, sbx *instance.InstanceInfo, targetNodeID *string) (*instance.InstanceInfo, *api.APIError) {
+	ctx, span := o.tracer.Start(ctx, "migrate-sandbox")
+	defer span.End()
+
+	sandboxID := sbx.Instance.SandboxID
+
+	// The volumes can be attached to a single node only.
+	if len(sbx.VolumeIDs) > 0 {
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: "Sandbox with volumes cannot be migrated",
+			Err:       fmt.Errorf("sandbox '%s' has volumes attached", sandboxID),
+		}
+	}
+
+	source := o.GetNode(sbx.Instance.ClientID)
+	if source == nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Node of the sandbox not found",
+			Err:       fmt.Errorf("node '%s' not found", sbx.Instance.ClientID),
+		}
+	}
+
+	target, apiErr := o.getMigrationTarget(ctx, source, targetNodeID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("source.node.id", source.Info.ID),
+		attribute.String("target.node.id", target.Info.ID),
+	)
+
+	if !o.instanceCache.MarkAsMigrating(sandboxID) {
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' is already being migrated", sandboxID),
+			Err:       fmt.Errorf("sandbox '%s' is already being migrated", sandboxID),
+		}
+	}
+	defer o.instanceCache.UnmarkAsMigrating(sandboxID)
+
+	envBuild, err := o.dbClient.NewSnapshotBuild(ctx, newSnapshotInfo(sbx), *sbx.TeamID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to migrate sandbox",
+			Err:       fmt.Errorf("error creating snapshot build: %w", err),
+		}
+	}
+
+	resp, err := source.Client.Sandbox.Migrate(ctx, &orchestrator.SandboxMigrateRequest{
+		SandboxId:  sandboxID,
+		TemplateId: *envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+	})
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to snapshot sandbox",
+			Err:       fmt.Errorf("error snapshotting sandbox on node '%s': %w", source.Info.ID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Snapshotted sandbox on the source node")
+
+	// The snapshot can be resumed as any other paused sandbox if the migration fails.
+	err = o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, envbuild.StatusSuccess)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error setting snapshot build status", err)
+	}
+
+	config := resp.GetSandbox()
+	config.TemplateId = *envBuild.EnvID
+	config.BuildId = envBuild.ID.String()
+	config.ExecutionId = uuid.New().String()
+	config.Snapshot = true
+
+	req := &orchestrator.SandboxCreateRequest{
+		Sandbox:         config,
+		StartTime:       timestamppb.New(sbx.StartTime),
+		EndTime:         timestamppb.New(sbx.GetEndTime()),
+		MigrationSource: &source.Info.OrchestratorAddress,
+	}
+
+	_, err = target.Client.Sandbox.Create(ctx, req)
+	if err != nil {
+		migrateErr := fmt.Errorf("error creating sandbox on node '%s': %w", target.Info.ID, utils.UnwrapGRPCError(err))
+
+		sbxlogger.I(sbx).Warn("Failed to migrate sandbox, resuming it on the source node",
+			zap.String("source_node_id", source.Info.ID),
+			zap.String("target_node_id", target.Info.ID),
+			zap.Error(migrateErr),
+		)
+
+		return o.resumeOnSource(ctx, sbx, source, envBuild.ID, req, migrateErr)
+	}
+
+	// The paused sandbox on the source node is stopped only after it runs on the target node.
+	_, err = source.Client.Sandbox.MigrateFinish(ctx, &orchestrator.SandboxMigrateFinishRequest{
+		SandboxId: sandboxID,
+		BuildId:   envBuild.ID.String(),
+		Migrated:  true,
+	})
+	if err != nil {
+		sbxlogger.I(sbx).Error("Failed to stop the migrated sandbox on the source node",
+			zap.String("source_node_id", source.Info.ID),
+			zap.Error(utils.UnwrapGRPCError(err)),
+		)
+	}
+
+	migrated := o.replaceMigratedInstance(ctx, sbx, source, target, envBuild.ID, config.ExecutionId)
+
+	telemetry.ReportEvent(ctx, "Migrated sandbox")
+
+	return migrated, nil
+}
+
+// resumeOnSource resumes the paused sandbox on the source node after the target node failed to start it.
+// If the source node can't resume it either, the sandbox is started there from the migration snapshot.
+func (o *Orchestrator) resumeOnSource(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	source *Node,
+	buildID uuid.UUID,
+	req *orchestrator.SandboxCreateRequest,
+	migrateErr error,
+) (*instance.InstanceInfo, *api.APIError) {
+	sandboxID := sbx.Instance.SandboxID
+
+	_, err := source.Client.Sandbox.MigrateFinish(ctx, &orchestrator.SandboxMigrateFinishRequest{
+		SandboxId: sandboxID,
+		BuildId:   buildID.String(),
+		Migrated:  false,
+	})
+	if err == nil {
+		return sbx, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to migrate sandbox, the sandbox was resumed on the original node",
+			Err:       migrateErr,
+		}
+	}
+
+	migrateErr = errors.Join(migrateErr, fmt.Errorf("error resuming paused sandbox on node '%s': %w", source.Info.ID, utils.UnwrapGRPCError(err)))
+
+	req.MigrationSource = nil
+
+	_, err = source.Client.Sandbox.Create(ctx, req)
+	if err != nil {
+		// The sandbox is not running anywhere, it can be still resumed from the snapshot.
+		o.DeleteInstance(ctx, sandboxID, false)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to migrate sandbox, the sandbox was paused",
+			Err:       errors.Join(migrateErr, fmt.Errorf("error resuming sandbox on node '%s': %w", source.Info.ID, utils.UnwrapGRPCError(err))),
+		}
+	}
+
+	resumed := o.replaceMigratedInstance(ctx, sbx, source, source, buildID, req.Sandbox.ExecutionId)
+
+	return resumed, &api.APIError{
+		Code:      http.StatusInternalServerError,
+		ClientMsg: "Failed to migrate sandbox, the sandbox was resumed on the original node",
+		Err:       migrateErr,
+	}
+}
+
+// replaceMigratedInstance replaces the instance of the sandbox with the one started from the migration snapshot on the node.
+func (o *Orchestrator) replaceMigratedInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	source *Node,
+	node *Node,
+	buildID uuid.UUID,
+	executionID string,
+) *instance.InstanceInfo {
+	sandboxID := sbx.Instance.SandboxID
+
+	node.InsertBuild(buildID.String())
+
+	instanceCopy := *sbx.Instance
+	instanceCopy.ClientID = node.Info.ID
+
+	migrated := instance.NewInstanceInfo(
+		&instanceCopy,
+		executionID,
+		sbx.TeamID,
+		&buildID,
+		sbx.Metadata,
+		sbx.MaxInstanceLength,
+		sbx.StartTime,
+		sbx.GetEndTime(),
+		sbx.VCpu,
+		sbx.TotalDiskSizeMB,
+		sbx.RamMB,
+		sbx.KernelVersion,
+		sbx.FirecrackerVersion,
+		sbx.EnvdVersion,
+		node.Info,
+		sbx.AutoPause.Load(),
+		sbx.EnvdAccessToken,
+		sbx.BaseTemplateID,
+		sbx.VolumeIDs,
+	)
+
+	migrated.SetResources(sbx.GetResources())
+	migrated.SetRateLimiters(sbx.GetRateLimiters())
+	migrated.EgressPolicy = sbx.EgressPolicy
+	migrated.PortAccess = sbx.PortAccess
+	migrated.Network = sbx.Network
+
+	// The resources are moved to the node before the instance is replaced, the delete hook releases them.
+	vCpu, ramMB := migrated.GetResources()
+	node.CPUUsage.Add(vCpu)
+	node.RamUsage.Add(ramMB)
+	o.dns.Add(ctx, sandboxID, node.Info.IPAddress)
+
+	// The delete hook of the replaced instance is not called, the sandbox is no longer on the source node.
+	if o.instanceCache.Replace(migrated) {
+		source.CPUUsage.Add(-vCpu)
+		source.RamUsage.Add(-ramMB)
+	}
+
+	// The other nodes route the team network address of the sandbox to its new node.
+	if migrated.Network != nil {
+		o.syncNetworks(ctx)
+	}
+
+	return migrated
+}
+
+func (o *Orchestrator) getMigrationTarget(ctx context.Context, source *Node, targetNodeID *string) (*Node, *api.APIError) {
+	if targetNodeID == nil {
+		target, err := o.getLeastBusyNode(ctx, map[string]*Node{source.Info.ID: source}, "")
+		if err != nil {
+			return nil, &api.APIError{
+				Code:      http.StatusConflict,
+				ClientMsg: "No node available to migrate the sandbox to",
+				Err:       fmt.Errorf("failed to get least busy node: %w", err),
+			}
+		}
+
+		return target, nil
+	}
+
+	target := o.GetNode(*targetNodeID)
+	if target == nil {
+		return nil, &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: fmt.Sprintf("Node '%s' not found", *targetNodeID),
+			Err:       fmt.Errorf("node '%s' not found", *targetNodeID),
+		}
+	}
+
+	if target.Info.ID == source.Info.ID {
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: "Sandbox is already running on the node",
+			Err:       fmt.Errorf("sandbox is already on node '%s'", *targetNodeID),
+		}
+	}
+
+	if target.Status() != api.NodeStatusReady {
+		return nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Node '%s' is not ready", *targetNodeID),
+			Err:       fmt.Errorf("node '%s' has status '%s'", *targetNodeID, target.Status()),
+		}
+	}
+
+	return target, nil
+}
@@ -0,0 +1,187 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+)
+
+// ErrNoNetworkAddress is returned when all the addresses of the team networks range are used.
+var ErrNoNetworkAddress = errors.New("no team network address available")
+
+// NewSandboxNetwork returns the team network with the name, the network ID is unique for the team.
+// The address is allocated when the sandbox is created if it's empty or already used.
+func NewSandboxNetwork(teamID uuid.UUID, name string, address string) *orchestrator.SandboxNetwork {
+	return &orchestrator.SandboxNetwork{
+		NetworkId: teamID.String() + "/" + name,
+		Address:   address,
+	}
+}
+
+// NetworkName returns the name of the team network the sandbox is attached to.
+func NetworkName(network *orchestrator.SandboxNetwork) string {
+	_, name, _ := strings.Cut(network.GetNetworkId(), "/")
+
+	return name
+}
+
+// NetworkFromSnapshot converts the team network stored with the snapshot to the orchestrator one.
+func NetworkFromSnapshot(teamID uuid.UUID, network *types.SandboxNetwork) *orchestrator.SandboxNetwork {
+	if network == nil {
+		return nil
+	}
+
+	return NewSandboxNetwork(teamID, network.Name, network.Address)
+}
+
+func snapshotNetwork(network *orchestrator.SandboxNetwork) *types.SandboxNetwork {
+	if network == nil {
+		return nil
+	}
+
+	return &types.SandboxNetwork{
+		Name:    NetworkName(network),
+		Address: network.GetAddress(),
+	}
+}
+
+// reserveNetworkAddress reserves the address of the sandbox in the team network, the address is unique in the cluster.
+// The requested address is kept if it's free, otherwise the first free address of the team networks range is used.
+func (o *Orchestrator) reserveNetworkAddress(sandboxID string, network *orchestrator.SandboxNetwork) (*orchestrator.SandboxNetwork, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	if address := network.GetAddress(); address != "" {
+		if o.networkAddresses.InsertIfAbsent(address, sandboxID) {
+			return network, nil
+		}
+
+		if holder, ok := o.networkAddresses.Get(address); ok && holder == sandboxID {
+			return network, nil
+		}
+	}
+
+	prefix, err := netip.ParsePrefix(consts.TeamNetworksCIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team networks CIDR '%s': %w", consts.TeamNetworksCIDR, err)
+	}
+
+	prefix = prefix.Masked()
+
+	// The network and broadcast addresses of the range are skipped.
+	for addr := prefix.Addr().Next(); prefix.Contains(addr.Next()); addr = addr.Next() {
+		if o.networkAddresses.InsertIfAbsent(addr.String(), sandboxID) {
+			return &orchestrator.SandboxNetwork{
+				NetworkId: network.GetNetworkId(),
+				Address:   addr.String(),
+			}, nil
+		}
+	}
+
+	return nil, ErrNoNetworkAddress
+}
+
+// releaseNetworkAddress removes the address reservation held by the sandbox.
+func (o *Orchestrator) releaseNetworkAddress(sandboxID string, network *orchestrator.SandboxNetwork) {
+	if network == nil {
+		return
+	}
+
+	o.networkAddresses.RemoveCb(network.GetAddress(), func(_ string, holder string, exists bool) bool {
+		return exists && holder == sandboxID
+	})
+}
+
+// syncNetworks configures the team networks of the nodes with the sandboxes attached to them.
+// Each node gets only the networks with a sandbox on the node, the nodes without any get an empty configuration.
+func (o *Orchestrator) syncNetworks(ctx context.Context) {
+	// The configurations are pushed one at a time, so an older membership can't override a newer one.
+	o.networksMu.Lock()
+	defer o.networksMu.Unlock()
+
+	ctx, span := o.tracer.Start(ctx, "sync-networks")
+	defer span.End()
+
+	networks := nodeTeamNetworks(o.instanceCache.Items())
+
+	var wg sync.WaitGroup
+	for _, n := range o.nodes.Items() {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+
+			_, err := n.Client.Sandbox.ConfigureNetworks(ctx, &orchestrator.NetworksConfigureRequest{
+				Networks: networks[n.Info.ID],
+			})
+
+			err = utils.UnwrapGRPCError(err)
+			if err != nil {
+				zap.L().Error("Error syncing team networks", zap.String("node_id", n.Info.ID), zap.Error(err))
+			}
+		}(n)
+	}
+	wg.Wait()
+}
+
+// nodeTeamNetworks returns the team networks with a sandbox on the node, keyed by the node ID.
+// The members of each network include the sandboxes on all the nodes with the addresses of their nodes.
+func nodeTeamNetworks(instances []*instance.InstanceInfo) map[string][]*orchestrator.TeamNetwork {
+	members := make(map[string][]*orchestrator.TeamNetworkMember)
+	nodeNetworks := make(map[string]map[string]struct{})
+
+	for _, info := range instances {
+		network := info.Network
+		if network == nil || info.Node == nil {
+			continue
+		}
+
+		networkID := network.GetNetworkId()
+		members[networkID] = append(members[networkID], &orchestrator.TeamNetworkMember{
+			Address:     network.GetAddress(),
+			NodeAddress: info.Node.IPAddress,
+		})
+
+		nodeID := info.Instance.ClientID
+		if nodeNetworks[nodeID] == nil {
+			nodeNetworks[nodeID] = make(map[string]struct{})
+		}
+
+		nodeNetworks[nodeID][networkID] = struct{}{}
+	}
+
+	for _, networkMembers := range members {
+		slices.SortFunc(networkMembers, func(a, b *orchestrator.TeamNetworkMember) int {
+			return strings.Compare(a.GetAddress(), b.GetAddress())
+		})
+	}
+
+	networks := make(map[string][]*orchestrator.TeamNetwork, len(nodeNetworks))
+	for nodeID, networkIDs := range nodeNetworks {
+		for networkID := range networkIDs {
+			networks[nodeID] = append(networks[nodeID], &orchestrator.TeamNetwork{
+				NetworkId: networkID,
+				Members:   members[networkID],
+			})
+		}
+
+		slices.SortFunc(networks[nodeID], func(a, b *orchestrator.TeamNetwork) int {
+			return strings.Compare(a.GetNetworkId(), b.GetNetworkId())
+		})
+	}
+
+	return networks
+}
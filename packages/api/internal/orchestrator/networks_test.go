@@ -0,0 +1,98 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+func TestReserveNetworkAddress(t *testing.T) {
+	o := &Orchestrator{networkAddresses: smap.New[string]()}
+
+	network, err := o.reserveNetworkAddress("sbx-1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, network)
+
+	network, err = o.reserveNetworkAddress("sbx-1", &orchestrator.SandboxNetwork{NetworkId: "team/default"})
+	require.NoError(t, err)
+	assert.Equal(t, &orchestrator.SandboxNetwork{NetworkId: "team/default", Address: "10.100.0.1"}, network)
+
+	// The requested address is kept if it's free.
+	requested, err := o.reserveNetworkAddress("sbx-2", &orchestrator.SandboxNetwork{NetworkId: "team/default", Address: "10.100.0.7"})
+	require.NoError(t, err)
+	assert.Equal(t, "10.100.0.7", requested.GetAddress())
+
+	// The address used by another sandbox is replaced.
+	taken, err := o.reserveNetworkAddress("sbx-3", &orchestrator.SandboxNetwork{NetworkId: "team/default", Address: "10.100.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "10.100.0.2", taken.GetAddress())
+
+	// Only the sandbox holding the address can release it.
+	o.releaseNetworkAddress("sbx-2", network)
+	_, ok := o.networkAddresses.Get("10.100.0.1")
+	assert.True(t, ok)
+
+	o.releaseNetworkAddress("sbx-1", network)
+	_, ok = o.networkAddresses.Get("10.100.0.1")
+	assert.False(t, ok)
+}
+
+func TestNetworkSnapshotRoundtrip(t *testing.T) {
+	teamID := uuid.New()
+	network := NewSandboxNetwork(teamID, "default", "10.100.0.1")
+
+	snapshot := snapshotNetwork(network)
+	assert.Equal(t, &types.SandboxNetwork{Name: "default", Address: "10.100.0.1"}, snapshot)
+	assert.Equal(t, network, NetworkFromSnapshot(teamID, snapshot))
+
+	assert.Nil(t, snapshotNetwork(nil))
+	assert.Nil(t, NetworkFromSnapshot(teamID, nil))
+}
+
+func TestNodeTeamNetworks(t *testing.T) {
+	nodeA := &node.NodeInfo{ID: "node-a", IPAddress: "192.168.0.1"}
+	nodeB := &node.NodeInfo{ID: "node-b", IPAddress: "192.168.0.2"}
+
+	sandbox := func(id string, n *node.NodeInfo, network *orchestrator.SandboxNetwork) *instance.InstanceInfo {
+		return &instance.InstanceInfo{
+			Instance: &api.Sandbox{SandboxID: id, ClientID: n.ID},
+			Node:     n,
+			Network:  network,
+		}
+	}
+
+	networks := nodeTeamNetworks([]*instance.InstanceInfo{
+		sandbox("sbx-1", nodeA, &orchestrator.SandboxNetwork{NetworkId: "team-a/default", Address: "10.100.0.2"}),
+		sandbox("sbx-2", nodeB, &orchestrator.SandboxNetwork{NetworkId: "team-a/default", Address: "10.100.0.1"}),
+		sandbox("sbx-3", nodeB, &orchestrator.SandboxNetwork{NetworkId: "team-b/default", Address: "10.100.0.3"}),
+		sandbox("sbx-4", nodeA, nil),
+	})
+
+	teamA := &orchestrator.TeamNetwork{
+		NetworkId: "team-a/default",
+		Members: []*orchestrator.TeamNetworkMember{
+			{Address: "10.100.0.1", NodeAddress: "192.168.0.2"},
+			{Address: "10.100.0.2", NodeAddress: "192.168.0.1"},
+		},
+	}
+
+	assert.Equal(t, map[string][]*orchestrator.TeamNetwork{
+		"node-a": {teamA},
+		"node-b": {
+			teamA,
+			{
+				NetworkId: "team-b/default",
+				Members:   []*orchestrator.TeamNetworkMember{{Address: "10.100.0.3", NodeAddress: "192.168.0.2"}},
+			},
+		},
+	}, networks)
+}
@@ -41,6 +41,12 @@ type Node struct {
 	sbxsInProgress *smap.Map[*sbxInProgress]
 
 	buildCache *ttlcache.Cache[string, interface{}]
+	// warmBuilds is the number of the warm sandboxes ready on the node for each build.
+	warmBuilds *smap.Map[int32]
+	// warmMu guards the resources of the warm sandboxes, they are counted in the usage of the node.
+	warmMu        sync.Mutex
+	warmCPUs      int64
+	warmMiBMemory int64
 
 	createFails atomic.Uint64
 }
@@ -144,8 +150,9 @@ func (o *Orchestrator) GetNodes() []*api.Node {
 			continue
 		}
 
-		n.AllocatedCPU += int32(sbx.VCpu)
-		n.AllocatedMemoryMiB += int32(sbx.RamMB)
+		vCpu, ramMB := sbx.GetResources()
+		n.AllocatedCPU += int32(vCpu)
+		n.AllocatedMemoryMiB += int32(ramMB)
 		n.SandboxCount += 1
 	}
 
@@ -185,11 +192,13 @@ func (o *Orchestrator) GetNodeDetail(nodeID string) *api.NodeDetail {
 				meta := api.SandboxMetadata(sbx.Metadata)
 				metadata = &meta
 			}
+
+			vCpu, ramMB := sbx.GetResources()
 			node.Sandboxes = append(node.Sandboxes, api.ListedSandbox{
 				Alias:      sbx.Instance.Alias,
 				ClientID:   nodeID,
-				CpuCount:   api.CPUCount(sbx.VCpu),
-				MemoryMB:   api.MemoryMB(sbx.RamMB),
+				CpuCount:   api.CPUCount(vCpu),
+				MemoryMB:   api.MemoryMB(ramMB),
 				EndAt:      sbx.GetEndTime(),
 				Metadata:   metadata,
 				SandboxID:  sbx.Instance.SandboxID,
@@ -218,6 +227,62 @@ func (n *Node) InsertBuild(buildID string) {
 	n.buildCache.Set(buildID, struct{}{}, 2*time.Minute)
 }
 
+// SyncWarmBuilds replaces the number of the warm sandboxes ready on the node for each build and the resources they hold.
+func (n *Node) SyncWarmBuilds(builds []*orchestrator.WarmPoolBuild) {
+	var cpus, ramMB int64
+
+	synced := make(map[string]struct{}, len(builds))
+	for _, build := range builds {
+		synced[build.GetBuildId()] = struct{}{}
+		n.warmBuilds.Insert(build.GetBuildId(), build.GetAvailable())
+
+		cpus += build.GetVcpu()
+		ramMB += build.GetRamMb()
+	}
+
+	n.warmMu.Lock()
+	n.CPUUsage.Add(cpus - n.warmCPUs)
+	n.RamUsage.Add(ramMB - n.warmMiBMemory)
+	n.warmCPUs, n.warmMiBMemory = cpus, ramMB
+	n.warmMu.Unlock()
+
+	for buildID := range n.warmBuilds.Items() {
+		if _, ok := synced[buildID]; !ok {
+			n.warmBuilds.Remove(buildID)
+		}
+	}
+}
+
+// HasWarmSandbox returns whether the node has a warm sandbox ready for the build.
+func (n *Node) HasWarmSandbox(buildID string) bool {
+	available, ok := n.warmBuilds.Get(buildID)
+
+	return ok && available > 0
+}
+
+// useWarmSandbox counts the warm sandbox handed out by the node until the next sync.
+// The resources of its VM are no longer counted as warm, the sandbox is counted by its own resources when it is added to the cache.
+func (n *Node) useWarmSandbox(buildID string, vCpu, ramMB int64) {
+	n.warmBuilds.Upsert(buildID, 0, func(exist bool, valueInMap int32, _ int32) int32 {
+		if exist && valueInMap > 0 {
+			return valueInMap - 1
+		}
+
+		return 0
+	})
+
+	n.warmMu.Lock()
+	defer n.warmMu.Unlock()
+
+	vCpu = min(vCpu, n.warmCPUs)
+	ramMB = min(ramMB, n.warmMiBMemory)
+
+	n.CPUUsage.Add(-vCpu)
+	n.RamUsage.Add(-ramMB)
+	n.warmCPUs -= vCpu
+	n.warmMiBMemory -= ramMB
+}
+
 func (o *Orchestrator) NodeCount() int {
 	return o.nodes.Count()
 }
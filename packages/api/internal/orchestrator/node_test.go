@@ -0,0 +1,44 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+func TestNode_WarmUsage(t *testing.T) {
+	n := &Node{warmBuilds: smap.New[int32]()}
+	n.CPUUsage.Store(4)
+	n.RamUsage.Store(1024)
+
+	n.SyncWarmBuilds([]*orchestrator.WarmPoolBuild{
+		{BuildId: "build", Available: 2, Vcpu: 16, RamMb: 8192},
+	})
+
+	assert.True(t, n.HasWarmSandbox("build"))
+	assert.Equal(t, int64(20), n.CPUUsage.Load())
+	assert.Equal(t, int64(9216), n.RamUsage.Load())
+
+	// The handed out sandbox is counted by its own resources once it is in the cache.
+	n.useWarmSandbox("build", 8, 4096)
+
+	assert.True(t, n.HasWarmSandbox("build"))
+	assert.Equal(t, int64(12), n.CPUUsage.Load())
+	assert.Equal(t, int64(5120), n.RamUsage.Load())
+
+	// The sync replaces the warm resources, the ones of the other sandboxes are kept.
+	n.SyncWarmBuilds(nil)
+
+	assert.False(t, n.HasWarmSandbox("build"))
+	assert.Equal(t, int64(4), n.CPUUsage.Load())
+	assert.Equal(t, int64(1024), n.RamUsage.Load())
+
+	// The warm resources are never counted below zero.
+	n.useWarmSandbox("build", 8, 4096)
+
+	assert.Equal(t, int64(4), n.CPUUsage.Load())
+	assert.Equal(t, int64(1024), n.RamUsage.Load())
+}
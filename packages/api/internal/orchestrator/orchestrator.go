@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	nomadapi "github.com/hashicorp/nomad/api"
@@ -43,6 +44,9 @@ type Orchestrator struct {
 	dbClient            *db.DB
 	tel                 *telemetry.Client
 	metricsRegistration metric.Registration
+	// networkAddresses maps the team network addresses to the ID of the sandbox they are allocated to.
+	networkAddresses *smap.Map[string]
+	networksMu       sync.Mutex
 }
 
 func New(
@@ -81,6 +85,8 @@ func New(
 		dns:         dnsServer,
 		dbClient:    dbClient,
 		tel:         tel,
+
+		networkAddresses: smap.New[string](),
 	}
 
 	cache := instance.NewCache(
@@ -107,6 +113,7 @@ func New(
 	} else {
 		go o.keepInSync(ctx, cache)
 		go o.reportLongRunningSandboxes(ctx)
+		go o.syncRateLimiters(ctx)
 	}
 
 	registration, err := o.setupMetrics(tel.MeterProvider)
@@ -14,6 +14,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -32,23 +33,9 @@ func (o *Orchestrator) PauseInstance(
 	ctx, span := o.tracer.Start(ctx, "pause-sandbox")
 	defer span.End()
 
-	snapshotConfig := &db.SnapshotInfo{
-		BaseTemplateID:     sbx.Instance.TemplateID,
-		SandboxID:          sbx.Instance.SandboxID,
-		SandboxStartedAt:   sbx.StartTime,
-		VCPU:               sbx.VCpu,
-		RAMMB:              sbx.RamMB,
-		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
-		Metadata:           sbx.Metadata,
-		KernelVersion:      sbx.KernelVersion,
-		FirecrackerVersion: sbx.FirecrackerVersion,
-		EnvdVersion:        sbx.Instance.EnvdVersion,
-		EnvdSecured:        sbx.EnvdAccessToken != nil,
-	}
-
 	envBuild, err := o.dbClient.NewSnapshotBuild(
 		ctx,
-		snapshotConfig,
+		newSnapshotInfo(sbx),
 		teamID,
 	)
 	if err != nil {
@@ -80,6 +67,43 @@ func (o *Orchestrator) PauseInstance(
 	return nil
 }
 
+func newSnapshotInfo(sbx *instance.InstanceInfo) *db.SnapshotInfo {
+	info := &db.SnapshotInfo{
+		BaseTemplateID:     sbx.Instance.TemplateID,
+		SandboxID:          sbx.Instance.SandboxID,
+		SandboxStartedAt:   sbx.StartTime,
+		VCPU:               sbx.VCpu,
+		RAMMB:              sbx.RamMB,
+		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+		Metadata:           sbx.Metadata,
+		KernelVersion:      sbx.KernelVersion,
+		FirecrackerVersion: sbx.FirecrackerVersion,
+		EnvdVersion:        sbx.Instance.EnvdVersion,
+		EnvdSecured:        sbx.EnvdAccessToken != nil,
+	}
+
+	// The resources are applied again when the sandbox is resumed.
+	if sbx.IsResized() {
+		vCpu, ramMB := sbx.GetResources()
+
+		info.VCPULimit = &vCpu
+		info.RAMMBLimit = &ramMB
+	}
+
+	if policy := sbx.EgressPolicy; policy != nil {
+		info.EgressPolicy = &types.SandboxEgressPolicy{
+			AllowedCIDRs:   policy.GetAllowedCidrs(),
+			DeniedCIDRs:    policy.GetDeniedCidrs(),
+			AllowedDomains: policy.GetAllowedDomains(),
+		}
+	}
+
+	info.PortAccess = snapshotPortAccess(sbx.PortAccess)
+	info.Network = snapshotNetwork(sbx.Network)
+
+	return info
+}
+
 func snapshotInstance(ctx context.Context, orch *Orchestrator, sbx *instance.InstanceInfo, templateID, buildID string) error {
 	_, childSpan := orch.tracer.Start(ctx, "snapshot-instance")
 	defer childSpan.End()
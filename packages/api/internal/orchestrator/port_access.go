@@ -0,0 +1,63 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+)
+
+var portExposures = map[orchestrator.PortExposure]api.PortExposure{
+	orchestrator.PortExposure_Public:    api.Public,
+	orchestrator.PortExposure_Protected: api.Protected,
+	orchestrator.PortExposure_Closed:    api.Closed,
+}
+
+// PortExposure converts the API port exposure to the orchestrator one, unknown values are public.
+func PortExposure(exposure api.PortExposure) orchestrator.PortExposure {
+	for orchExposure, apiExposure := range portExposures {
+		if apiExposure == exposure {
+			return orchExposure
+		}
+	}
+
+	return orchestrator.PortExposure_Public
+}
+
+// PortAccessFromSnapshot converts the port access stored with the snapshot to the orchestrator one.
+func PortAccessFromSnapshot(access *types.SandboxPortAccess) *orchestrator.SandboxPortAccess {
+	if access == nil {
+		return nil
+	}
+
+	ports := make([]*orchestrator.SandboxPort, 0, len(access.Ports))
+	for _, port := range access.Ports {
+		ports = append(ports, &orchestrator.SandboxPort{
+			Port:     port.Port,
+			Exposure: PortExposure(api.PortExposure(port.Exposure)),
+		})
+	}
+
+	return &orchestrator.SandboxPortAccess{
+		DefaultExposure: PortExposure(api.PortExposure(access.DefaultExposure)),
+		Ports:           ports,
+	}
+}
+
+func snapshotPortAccess(access *orchestrator.SandboxPortAccess) *types.SandboxPortAccess {
+	if access == nil {
+		return nil
+	}
+
+	ports := make([]types.SandboxPortAccessPort, 0, len(access.GetPorts()))
+	for _, port := range access.GetPorts() {
+		ports = append(ports, types.SandboxPortAccessPort{
+			Port:     port.GetPort(),
+			Exposure: string(portExposures[port.GetExposure()]),
+		})
+	}
+
+	return &types.SandboxPortAccess{
+		DefaultExposure: string(portExposures[access.GetDefaultExposure()]),
+		Ports:           ports,
+	}
+}
@@ -0,0 +1,34 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+)
+
+func TestPortAccessSnapshotRoundtrip(t *testing.T) {
+	access := &orchestrator.SandboxPortAccess{
+		DefaultExposure: orchestrator.PortExposure_Closed,
+		Ports: []*orchestrator.SandboxPort{
+			{Port: 3000, Exposure: orchestrator.PortExposure_Public},
+			{Port: 8080, Exposure: orchestrator.PortExposure_Protected},
+		},
+	}
+
+	snapshot := snapshotPortAccess(access)
+	assert.Equal(t, &types.SandboxPortAccess{
+		DefaultExposure: "closed",
+		Ports: []types.SandboxPortAccessPort{
+			{Port: 3000, Exposure: "public"},
+			{Port: 8080, Exposure: "protected"},
+		},
+	}, snapshot)
+
+	assert.Equal(t, access, PortAccessFromSnapshot(snapshot))
+
+	assert.Nil(t, snapshotPortAccess(nil))
+	assert.Nil(t, PortAccessFromSnapshot(nil))
+}
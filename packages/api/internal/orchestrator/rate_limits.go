@@ -0,0 +1,155 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const (
+	megabyte = 1 << 20
+
+	// rateLimitsSyncInterval is how long it takes for a tier change to apply to the running sandboxes.
+	rateLimitsSyncInterval = time.Minute
+)
+
+// tierRateLimiters returns the sandbox I/O limits of the tier.
+// The limiters are always set, so a sandbox resumed after the tier changed does not keep the limits from its snapshot.
+// Each limit allows a one second burst on top of the rate, so short spikes (like the boot) are not throttled.
+func tierRateLimiters(tier *models.Tier) (disk *orchestrator.RateLimiter, network *orchestrator.RateLimiter) {
+	disk = rateLimiter(tier.DiskBandwidthMBPerSec, megabyte, tier.DiskIops)
+	network = rateLimiter(tier.NetworkBandwidthMBPerSec, megabyte, tier.NetworkPacketsPerSec)
+
+	return disk, network
+}
+
+func rateLimiter(bandwidth *int64, bandwidthUnit int64, ops *int64) *orchestrator.RateLimiter {
+	limiter := &orchestrator.RateLimiter{}
+
+	if bandwidth != nil && *bandwidth > 0 {
+		limiter.BandwidthBytesPerSecond = *bandwidth * bandwidthUnit
+		limiter.BandwidthBurstBytes = limiter.BandwidthBytesPerSecond
+	}
+
+	if ops != nil && *ops > 0 {
+		limiter.OpsPerSecond = *ops
+		limiter.OpsBurst = *ops
+	}
+
+	return limiter
+}
+
+// syncRateLimiters applies the current tier limits to the running sandboxes, so a tier change also affects them.
+func (o *Orchestrator) syncRateLimiters(ctx context.Context) {
+	ticker := time.NewTicker(rateLimitsSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping rate limits sync due to context cancellation")
+			return
+		case <-ticker.C:
+			o.updateRateLimiters(ctx)
+		}
+	}
+}
+
+func (o *Orchestrator) updateRateLimiters(ctx context.Context) {
+	ctx, span := o.tracer.Start(ctx, "update-rate-limiters")
+	defer span.End()
+
+	instances := o.instanceCache.Items()
+
+	teamIDs := make([]uuid.UUID, 0, len(instances))
+	for _, info := range instances {
+		if info.TeamID != nil && !slices.Contains(teamIDs, *info.TeamID) {
+			teamIDs = append(teamIDs, *info.TeamID)
+		}
+	}
+
+	if len(teamIDs) == 0 {
+		return
+	}
+
+	tiers, err := o.dbClient.GetTeamTiers(ctx, teamIDs)
+	if err != nil {
+		zap.L().Error("Error getting team tiers for the rate limits sync", zap.Error(err))
+
+		return
+	}
+
+	for _, info := range outdatedRateLimiters(instances, tiers) {
+		disk, network := tierRateLimiters(tiers[*info.TeamID])
+
+		err := o.updateSandboxRateLimiters(ctx, info, disk, network)
+		if err != nil {
+			zap.L().Error("Error updating sandbox rate limits", logger.WithSandboxID(info.Instance.SandboxID), zap.Error(err))
+		}
+	}
+}
+
+// outdatedRateLimiters returns the sandboxes whose limits differ from the limits of their team's tier.
+// The sandboxes of the teams without a tier are skipped.
+func outdatedRateLimiters(instances []*instance.InstanceInfo, tiers map[uuid.UUID]*models.Tier) []*instance.InstanceInfo {
+	var outdated []*instance.InstanceInfo
+
+	for _, info := range instances {
+		if info.TeamID == nil {
+			continue
+		}
+
+		tier := tiers[*info.TeamID]
+		if tier == nil {
+			continue
+		}
+
+		disk, network := tierRateLimiters(tier)
+		currentDisk, currentNetwork := info.GetRateLimiters()
+
+		if !proto.Equal(disk, currentDisk) || !proto.Equal(network, currentNetwork) {
+			outdated = append(outdated, info)
+		}
+	}
+
+	return outdated
+}
+
+// updateSandboxRateLimiters changes the I/O limits of the running sandbox.
+func (o *Orchestrator) updateSandboxRateLimiters(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	disk *orchestrator.RateLimiter,
+	network *orchestrator.RateLimiter,
+) error {
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err)
+	}
+
+	_, err = client.Sandbox.Update(ctx, &orchestrator.SandboxUpdateRequest{
+		SandboxId:          sbx.Instance.SandboxID,
+		DiskRateLimiter:    disk,
+		NetworkRateLimiter: network,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to update rate limiters of sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	sbx.SetRateLimiters(disk, network)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestTierRateLimiters(t *testing.T) {
+	bandwidth := int64(50)
+	iops := int64(2000)
+
+	disk, network := tierRateLimiters(&models.Tier{
+		DiskBandwidthMBPerSec: &bandwidth,
+		DiskIops:              &iops,
+	})
+
+	assert.Equal(t, int64(50<<20), disk.GetBandwidthBytesPerSecond())
+	assert.Equal(t, int64(50<<20), disk.GetBandwidthBurstBytes())
+	assert.Equal(t, int64(2000), disk.GetOpsPerSecond())
+
+	// Unlimited tiers still send the limiters, so the limits stored in a snapshot are removed on resume.
+	assert.NotNil(t, network)
+	assert.Zero(t, network.GetBandwidthBytesPerSecond())
+	assert.Zero(t, network.GetOpsPerSecond())
+}
+
+func TestOutdatedRateLimiters(t *testing.T) {
+	bandwidth := int64(50)
+	tier := &models.Tier{DiskBandwidthMBPerSec: &bandwidth}
+	disk, network := tierRateLimiters(tier)
+
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+
+	newInstance := func(teamID uuid.UUID) *instance.InstanceInfo {
+		return instance.NewInstanceInfo(&api.Sandbox{SandboxID: uuid.NewString()}, "", &teamID, nil, nil, 0, time.Now(), time.Now(), 0, 0, 0, "", "", "", nil, false, nil, "", nil)
+	}
+
+	current := newInstance(teamID)
+	current.SetRateLimiters(disk, network)
+
+	// The limits of the previous tier.
+	previous := newInstance(teamID)
+	previous.SetRateLimiters(&orchestrator.RateLimiter{}, network)
+
+	// The limits of the sandboxes from the orchestrators that don't report them are unknown.
+	unknown := newInstance(teamID)
+
+	// The tier of the team wasn't found.
+	otherTeam := newInstance(otherTeamID)
+
+	outdated := outdatedRateLimiters(
+		[]*instance.InstanceInfo{current, previous, unknown, otherTeam},
+		map[uuid.UUID]*models.Tier{teamID: tier},
+	)
+
+	assert.Equal(t, []*instance.InstanceInfo{previous, unknown}, outdated)
+}
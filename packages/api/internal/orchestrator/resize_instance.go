@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ResizeSandbox changes the vCPUs and memory the running sandbox can use, the sandbox can't grow above the size of its VM.
+// The new sandboxes boot with the tier's maximum resources, so they can be resized up to the tier limits.
+// The resources are kept when the sandbox is paused and resumed.
+func (o *Orchestrator) ResizeSandbox(ctx context.Context, sbx *instance.InstanceInfo, vCpu int64, ramMB int64) *api.APIError {
+	ctx, childSpan := o.tracer.Start(ctx, "resize-sandbox")
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to resize sandbox",
+			Err:       fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err),
+		}
+	}
+
+	_, err = client.Sandbox.Update(ctx, &orchestrator.SandboxUpdateRequest{
+		SandboxId: sbx.Instance.SandboxID,
+		Resources: &orchestrator.SandboxResources{
+			Vcpu:  vCpu,
+			RamMb: ramMB,
+		},
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: status.Convert(err).Message(),
+				Err:       utils.UnwrapGRPCError(err),
+			}
+		case codes.NotFound:
+			return &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Sandbox '%s' is not running", sbx.Instance.SandboxID),
+				Err:       utils.UnwrapGRPCError(err),
+			}
+		default:
+			return &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Failed to resize sandbox",
+				Err:       fmt.Errorf("failed to resize sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err)),
+			}
+		}
+	}
+
+	oldVCpu, oldRAMMB := sbx.GetResources()
+	sbx.SetResources(vCpu, ramMB)
+
+	// The node is filled by the resources the sandbox is limited to, the delete hook releases the new ones.
+	if node := o.GetNode(sbx.Instance.ClientID); node != nil {
+		node.CPUUsage.Add(vCpu - oldVCpu)
+		node.RamUsage.Add(ramMB - oldRAMMB)
+	}
+
+	telemetry.ReportEvent(ctx, "Resized sandbox")
+
+	return nil
+}
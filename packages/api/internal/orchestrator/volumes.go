@@ -0,0 +1,145 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// volumeAttachmentGracePeriod is how long a volume stays attached to a sandbox that is not running.
+// It covers the sandboxes that are being created and the sandboxes not yet synced from the nodes.
+const volumeAttachmentGracePeriod = 10 * time.Minute
+
+// ErrVolumeInUse is returned when the volume is already attached to another sandbox.
+var ErrVolumeInUse = db.ErrVolumeAttached
+
+// reserveVolumes marks the volumes as attached to the sandbox, a volume can be attached to only one sandbox at a time.
+// The volumes stay reserved until the sandbox is removed from the node, so the volume data is synced before it can be attached again.
+// The attachments are stored in the database, so they are shared by all the API instances.
+func (o *Orchestrator) reserveVolumes(ctx context.Context, sandboxID string, volumeIDs []string) error {
+	ids, err := parseVolumeIDs(volumeIDs)
+	if err != nil {
+		return err
+	}
+
+	return o.dbClient.AttachVolumes(ctx, sandboxID, ids)
+}
+
+// releaseVolumes removes the volume reservations held by the sandbox.
+func (o *Orchestrator) releaseVolumes(ctx context.Context, sandboxID string, volumeIDs []string) {
+	ids, err := parseVolumeIDs(volumeIDs)
+	if err == nil {
+		err = o.dbClient.DetachVolumes(ctx, sandboxID, ids)
+	}
+
+	if err != nil {
+		zap.L().Error("Error releasing sandbox volumes", logger.WithSandboxID(sandboxID), zap.Error(err))
+	}
+}
+
+// syncVolumeAttachments releases the volumes attached to the sandboxes that are no longer running,
+// for example when the API instance that created the sandbox crashed before the sandbox was added to the cache.
+func (o *Orchestrator) syncVolumeAttachments(ctx context.Context) {
+	volumes, err := o.dbClient.GetVolumesAttachedBefore(ctx, time.Now().Add(-volumeAttachmentGracePeriod))
+	if err != nil {
+		zap.L().Error("Error getting attached volumes", zap.Error(err))
+
+		return
+	}
+
+	for _, v := range staleVolumeAttachments(volumes, o.instanceCache.Exists) {
+		zap.L().Warn("Releasing volume attached to a sandbox that is not running",
+			zap.String("volume_id", v.ID.String()),
+			logger.WithSandboxID(*v.AttachedSandboxID),
+		)
+
+		err = o.dbClient.DetachVolumes(ctx, *v.AttachedSandboxID, []uuid.UUID{v.ID})
+		if err != nil {
+			zap.L().Error("Error releasing volume", zap.String("volume_id", v.ID.String()), zap.Error(err))
+		}
+	}
+}
+
+// staleVolumeAttachments returns the attached volumes whose sandbox is not running.
+func staleVolumeAttachments(volumes []*models.Volume, running func(sandboxID string) bool) []*models.Volume {
+	var stale []*models.Volume
+	for _, v := range volumes {
+		if v.AttachedSandboxID != nil && !running(*v.AttachedSandboxID) {
+			stale = append(stale, v)
+		}
+	}
+
+	return stale
+}
+
+// DeleteVolume removes the volume data from the storage.
+func (o *Orchestrator) DeleteVolume(ctx context.Context, volumeID string) error {
+	childCtx, childSpan := o.tracer.Start(ctx, "delete-volume")
+	defer childSpan.End()
+
+	telemetry.SetAttributes(childCtx, attribute.String("volume.id", volumeID))
+
+	// The volumes are stored in the shared storage, so any node can delete them.
+	var node *Node
+	for _, n := range o.nodes.Items() {
+		if n != nil && n.Status() == api.NodeStatusReady {
+			node = n
+
+			break
+		}
+	}
+
+	if node == nil {
+		return errors.New("no node available to delete the volume")
+	}
+
+	client, err := o.GetClient(node.Info.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get GRPC client: %w", err)
+	}
+
+	_, err = client.Sandbox.DeleteVolume(childCtx, &orchestrator.VolumeDeleteRequest{VolumeId: volumeID})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume: %w", err)
+	}
+
+	return nil
+}
+
+func volumeIDs(volumes []*orchestrator.SandboxVolumeMount) []string {
+	ids := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		ids = append(ids, volume.GetVolumeId())
+	}
+
+	return ids
+}
+
+func parseVolumeIDs(volumeIDs []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(volumeIDs))
+	for _, volumeID := range volumeIDs {
+		id, err := uuid.Parse(volumeID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid volume ID '%s': %w", volumeID, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,26 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestStaleVolumeAttachments(t *testing.T) {
+	running := "sbx-running"
+	stopped := "sbx-stopped"
+
+	attachedToRunning := &models.Volume{ID: uuid.New(), AttachedSandboxID: &running}
+	attachedToStopped := &models.Volume{ID: uuid.New(), AttachedSandboxID: &stopped}
+	detached := &models.Volume{ID: uuid.New()}
+
+	stale := staleVolumeAttachments(
+		[]*models.Volume{attachedToRunning, attachedToStopped, detached},
+		func(sandboxID string) bool { return sandboxID == running },
+	)
+
+	assert.Equal(t, []*models.Volume{attachedToStopped}, stale)
+}
@@ -0,0 +1,107 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// syncWarmPools configures the warm pools of the nodes with the templates that have the warm pool enabled.
+// The nodes that are not ready get an empty warm pool, so they release the warm sandboxes.
+func (o *Orchestrator) syncWarmPools(ctx context.Context) {
+	ctx, span := o.tracer.Start(ctx, "sync-warm-pools")
+	defer span.End()
+
+	envs, err := o.dbClient.GetWarmPoolEnvs(ctx)
+	if err != nil {
+		zap.L().Error("Error getting warm pool templates", zap.Error(err))
+
+		return
+	}
+
+	templates := warmPoolTemplates(envs)
+
+	var wg sync.WaitGroup
+	for _, n := range o.nodes.Items() {
+		nodeTemplates := templates
+		if n.Status() != api.NodeStatusReady {
+			nodeTemplates = nil
+		}
+
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+
+			err := o.syncWarmPool(ctx, n, nodeTemplates)
+			if err != nil {
+				zap.L().Error("Error syncing warm pool", zap.String("node_id", n.Info.ID), zap.Error(err))
+			}
+		}(n)
+	}
+	wg.Wait()
+}
+
+func (o *Orchestrator) syncWarmPool(ctx context.Context, node *Node, templates []*orchestrator.WarmPoolTemplate) error {
+	res, err := node.Client.Sandbox.ConfigureWarmPool(ctx, &orchestrator.WarmPoolConfigureRequest{
+		Templates: templates,
+	})
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to configure warm pool: %w", err)
+	}
+
+	node.SyncWarmBuilds(res.GetBuilds())
+
+	return nil
+}
+
+// warmPoolTemplates returns the configs the warm sandboxes are booted with, they match the configs of the new sandboxes of the template.
+func warmPoolTemplates(envs []*db.WarmPoolTemplate) []*orchestrator.WarmPoolTemplate {
+	templates := make([]*orchestrator.WarmPoolTemplate, 0, len(envs))
+	for _, env := range envs {
+		build := env.Build
+
+		if build.EnvdVersion == nil {
+			zap.L().Warn("Skipping warm pool of the template without envd version", logger.WithTemplateID(env.TemplateID), logger.WithBuildID(build.ID.String()))
+
+			continue
+		}
+
+		features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
+		if err != nil {
+			zap.L().Warn("Skipping warm pool of the template with invalid Firecracker version", logger.WithTemplateID(env.TemplateID), zap.Error(err))
+
+			continue
+		}
+
+		// The warm sandboxes boot with the same VM size as the new sandboxes of the template's team.
+		vCpu, ramMB := sandbox.VMResources(env.Tier, build.Vcpu, build.RAMMB)
+
+		templates = append(templates, &orchestrator.WarmPoolTemplate{
+			Sandbox: &orchestrator.SandboxConfig{
+				BaseTemplateId:     env.TemplateID,
+				TemplateId:         env.TemplateID,
+				BuildId:            build.ID.String(),
+				KernelVersion:      build.KernelVersion,
+				FirecrackerVersion: build.FirecrackerVersion,
+				EnvdVersion:        *build.EnvdVersion,
+				HugePages:          features.HasHugePages(),
+				RamMb:              ramMB,
+				Vcpu:               vCpu,
+			},
+			Size: env.Size,
+		})
+	}
+
+	return templates
+}
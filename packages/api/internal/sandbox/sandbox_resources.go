@@ -0,0 +1,11 @@
+package sandbox
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// VMResources returns the size of the VM a new sandbox boots with.
+// The VM boots with the maximum resources of the team's tier and the sandbox is limited to less, so it can be resized up to the tier limits.
+func VMResources(tier *models.Tier, vCpu int64, ramMB int64) (int64, int64) {
+	return max(vCpu, tier.MaxVcpu), max(ramMB, tier.MaxRAMMB)
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS "disk_bandwidth_mb_per_sec" bigint NULL,
+    ADD COLUMN IF NOT EXISTS "disk_iops" bigint NULL,
+    ADD COLUMN IF NOT EXISTS "network_bandwidth_mb_per_sec" bigint NULL,
+    ADD COLUMN IF NOT EXISTS "network_packets_per_sec" bigint NULL;
+
+COMMENT ON COLUMN "public"."tiers"."disk_bandwidth_mb_per_sec" IS 'The disk bandwidth limit of a sandbox, unlimited when not set';
+COMMENT ON COLUMN "public"."tiers"."disk_iops" IS 'The disk operations per second limit of a sandbox, unlimited when not set';
+COMMENT ON COLUMN "public"."tiers"."network_bandwidth_mb_per_sec" IS 'The network bandwidth limit of a sandbox in each direction, unlimited when not set';
+COMMENT ON COLUMN "public"."tiers"."network_packets_per_sec" IS 'The network packets per second limit of a sandbox in each direction, unlimited when not set';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS "disk_bandwidth_mb_per_sec",
+    DROP COLUMN IF EXISTS "disk_iops",
+    DROP COLUMN IF EXISTS "network_bandwidth_mb_per_sec",
+    DROP COLUMN IF EXISTS "network_packets_per_sec";
+-- +goose StatementEnd
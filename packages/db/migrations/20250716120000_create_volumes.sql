@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Create "volumes" table
+CREATE TABLE IF NOT EXISTS "public"."volumes" (
+    id uuid not null default gen_random_uuid(),
+    created_at timestamp with time zone not null default CURRENT_TIMESTAMP,
+    team_id uuid not null,
+    name text not null,
+    size_mb bigint not null,
+    constraint volumes_pkey primary key (id),
+    constraint volumes_teams_volumes foreign key (team_id) references "public"."teams" (id) on delete cascade,
+    constraint volumes_team_id_name_key unique (team_id, name)
+);
+ALTER TABLE "public"."volumes" ENABLE ROW LEVEL SECURITY;
+
+COMMENT ON TABLE "public"."volumes" IS 'Persistent data volumes of a team, the data is kept in the storage bucket and survives the sandboxes using it';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."volumes";
+-- +goose StatementEnd
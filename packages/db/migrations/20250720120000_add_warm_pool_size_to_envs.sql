@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."envs"
+    ADD COLUMN IF NOT EXISTS "warm_pool_size" integer NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "public"."envs"."warm_pool_size" IS 'Number of sandboxes kept booted on each node to be handed out on create';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."envs"
+    DROP COLUMN IF EXISTS "warm_pool_size";
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS "vcpu_limit" bigint NULL,
+    ADD COLUMN IF NOT EXISTS "ram_mb_limit" bigint NULL;
+
+COMMENT ON COLUMN "public"."snapshots"."vcpu_limit" IS 'Number of vCPUs the sandbox was limited to when paused, NULL means all the vCPUs of the build';
+COMMENT ON COLUMN "public"."snapshots"."ram_mb_limit" IS 'Memory in MiB the sandbox was limited to when paused, NULL means all the memory of the build';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS "ram_mb_limit",
+    DROP COLUMN IF EXISTS "vcpu_limit";
+-- +goose StatementEnd
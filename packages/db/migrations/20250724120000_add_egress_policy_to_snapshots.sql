@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS "egress_policy" jsonb NULL;
+
+COMMENT ON COLUMN "public"."snapshots"."egress_policy" IS 'Egress policy of the sandbox, NULL means no restrictions';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS "egress_policy";
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS "port_access" jsonb NULL;
+
+COMMENT ON COLUMN "public"."snapshots"."port_access" IS 'Exposure of the sandbox ports through the proxies, NULL means all the ports are public';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS "port_access";
+-- +goose StatementEnd
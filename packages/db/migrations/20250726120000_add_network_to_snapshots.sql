@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS "network" jsonb NULL;
+
+COMMENT ON COLUMN "public"."snapshots"."network" IS 'Team network the sandbox is attached to, NULL means no team network';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS "network";
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."volumes"
+    ADD COLUMN IF NOT EXISTS "attached_sandbox_id" text NULL,
+    ADD COLUMN IF NOT EXISTS "attached_at" timestamp with time zone NULL;
+
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS "max_volume_size_mb" bigint NOT NULL DEFAULT 10240;
+
+COMMENT ON COLUMN "public"."tiers"."max_volume_size_mb" IS 'The maximum size of a volume created by the team';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS "max_volume_size_mb";
+
+ALTER TABLE "public"."volumes"
+    DROP COLUMN IF EXISTS "attached_sandbox_id",
+    DROP COLUMN IF EXISTS "attached_at";
+-- +goose StatementEnd
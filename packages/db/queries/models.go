@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/db/types"
+	schematypes "github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -47,6 +48,8 @@ type Env struct {
 	TeamID        uuid.UUID
 	CreatedBy     *uuid.UUID
 	ClusterID     *uuid.UUID
+	// Number of sandboxes kept booted on each node to be handed out on create
+	WarmPoolSize int32
 }
 
 type EnvAlias struct {
@@ -84,6 +87,16 @@ type Snapshot struct {
 	BaseEnvID        string
 	SandboxStartedAt pgtype.Timestamptz
 	EnvSecure        bool
+	// Number of vCPUs the sandbox was limited to when paused, NULL means all the vCPUs of the build
+	VcpuLimit *int64
+	// Memory in MiB the sandbox was limited to when paused, NULL means all the memory of the build
+	RamMbLimit *int64
+	// Egress policy of the sandbox, NULL means no restrictions
+	EgressPolicy *schematypes.SandboxEgressPolicy
+	// Exposure of the sandbox ports through the proxies, NULL means all the ports are public
+	PortAccess *schematypes.SandboxPortAccess
+	// Team network the sandbox is attached to, NULL means no team network
+	Network *schematypes.SandboxNetwork
 }
 
 type Team struct {
@@ -125,6 +138,16 @@ type Tier struct {
 	MaxLengthHours      int64
 	MaxVcpu             int64
 	MaxRamMb            int64
+	// The disk bandwidth limit of a sandbox, unlimited when not set
+	DiskBandwidthMbPerSec *int64
+	// The disk operations per second limit of a sandbox, unlimited when not set
+	DiskIops *int64
+	// The network bandwidth limit of a sandbox in each direction, unlimited when not set
+	NetworkBandwidthMbPerSec *int64
+	// The network packets per second limit of a sandbox in each direction, unlimited when not set
+	NetworkPacketsPerSec *int64
+	// The maximum size of a volume created by the team
+	MaxVolumeSizeMb int64
 }
 
 type UsersTeam struct {
@@ -49,6 +49,18 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// IPv6Network IPv6 configuration of the sandbox network interface
+type IPv6Network struct {
+	// Address IPv6 address of the sandbox with the prefix length
+	Address string `json:"address"`
+
+	// Gateway IPv6 address of the default gateway
+	Gateway string `json:"gateway"`
+
+	// Interface Network interface of the sandbox
+	Interface string `json:"interface"`
+}
+
 // Metrics Resource usage metrics
 type Metrics struct {
 	// CpuUsedPct CPU usage percentage
@@ -58,6 +70,15 @@ type Metrics struct {
 	MemBytes *int `json:"mem_bytes,omitempty"`
 }
 
+// VolumeMount Volume attached to the sandbox as a block device
+type VolumeMount struct {
+	// Device Block device of the volume
+	Device string `json:"device"`
+
+	// Path Absolute path where the volume is mounted
+	Path string `json:"path"`
+}
+
 // FilePath defines model for FilePath.
 type FilePath = string
 
@@ -130,6 +151,12 @@ type PostInitJSONBody struct {
 
 	// EnvVars Environment variables to set
 	EnvVars *EnvVars `json:"envVars,omitempty"`
+
+	// Ipv6 IPv6 configuration of the sandbox network interface
+	Ipv6 *IPv6Network `json:"ipv6,omitempty"`
+
+	// VolumeMounts Volumes to mount in the sandbox
+	VolumeMounts *[]VolumeMount `json:"volumeMounts,omitempty"`
 }
 
 // PostFilesMultipartRequestBody defines body for PostFiles for multipart/form-data ContentType.
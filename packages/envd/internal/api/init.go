@@ -37,8 +37,9 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if initRequest.AccessToken != nil {
-			if a.accessToken != nil && *initRequest.AccessToken != *a.accessToken {
-				logger.Error().Msg("Access token is already set and cannot be changed")
+			// The token can be changed only with the current one, the forks of a sandbox are re-keyed to their own tokens.
+			if a.accessToken != nil && *initRequest.AccessToken != *a.accessToken && r.Header.Get(accessTokenHeader) != *a.accessToken {
+				logger.Error().Msg("Access token is already set and cannot be changed without the current one")
 				w.WriteHeader(http.StatusConflict)
 				return
 			}
@@ -46,6 +47,30 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 			logger.Debug().Msg("Setting access token")
 			a.accessToken = initRequest.AccessToken
 		}
+
+		if initRequest.VolumeMounts != nil {
+			for _, volume := range *initRequest.VolumeMounts {
+				logger.Debug().Msgf("Mounting volume %s at %s", volume.Device, volume.Path)
+
+				err = host.MountVolume(volume.Device, volume.Path)
+				if err != nil {
+					logger.Error().Msgf("Failed to mount volume: %v", err)
+					jsonError(w, http.StatusInternalServerError, err)
+
+					return
+				}
+			}
+		}
+
+		if initRequest.Ipv6 != nil {
+			logger.Debug().Msgf("Configuring IPv6 address %s of %s", initRequest.Ipv6.Address, initRequest.Ipv6.Interface)
+
+			err = host.ConfigureIPv6(initRequest.Ipv6.Interface, initRequest.Ipv6.Address, initRequest.Ipv6.Gateway)
+			if err != nil {
+				// The sandbox is still reachable over IPv4, so the init doesn't fail.
+				logger.Error().Msgf("Failed to configure IPv6: %v", err)
+			}
+		}
 	}
 
 	logger.Debug().Msg("Syncing host")
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+)
+
+func postInit(t *testing.T, api *API, body string, accessToken string) int {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/init", strings.NewReader(body))
+	if accessToken != "" {
+		req.Header.Set(accessTokenHeader, accessToken)
+	}
+
+	w := httptest.NewRecorder()
+	api.WithAuthorization(http.HandlerFunc(api.PostInit)).ServeHTTP(w, req)
+
+	return w.Code
+}
+
+func TestPostInit_ChangesAccessTokenWithTheCurrentOne(t *testing.T) {
+	logger := zerolog.Nop()
+	api := New(&logger, utils.NewMap[string, string]())
+
+	assert.Equal(t, http.StatusNoContent, postInit(t, api, `{"accessToken": "parent"}`, ""))
+	require.NotNil(t, api.accessToken)
+	assert.Equal(t, "parent", *api.accessToken)
+
+	// The same token can be set again, e.g. when the sandbox is resumed.
+	assert.Equal(t, http.StatusNoContent, postInit(t, api, `{"accessToken": "parent"}`, "parent"))
+
+	// The token can't be changed without the current one.
+	assert.Equal(t, http.StatusUnauthorized, postInit(t, api, `{"accessToken": "fork"}`, "fork"))
+	assert.Equal(t, "parent", *api.accessToken)
+
+	assert.Equal(t, http.StatusNoContent, postInit(t, api, `{"accessToken": "fork"}`, "parent"))
+	assert.Equal(t, "fork", *api.accessToken)
+
+	assert.Equal(t, http.StatusUnauthorized, postInit(t, api, `{"accessToken": "fork"}`, "parent"))
+}
@@ -0,0 +1,53 @@
+//go:build linux
+// +build linux
+
+package host
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// ConfigureIPv6 sets the IPv6 address and the default IPv6 route of the interface.
+// The existing address and route are replaced, so the configuration can be applied again after the sandbox is resumed.
+func ConfigureIPv6(iface, address, gateway string) error {
+	link, err := netlink.LinkByName(iface)
+	if err != nil {
+		return fmt.Errorf("failed to get interface %s: %w", iface, err)
+	}
+
+	addr, err := netlink.ParseAddr(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse IPv6 address %s: %w", address, err)
+	}
+
+	if addr.IP.To4() != nil {
+		return fmt.Errorf("address %s is not an IPv6 address", address)
+	}
+
+	gw := net.ParseIP(gateway)
+	if gw == nil || gw.To4() != nil {
+		return fmt.Errorf("gateway %s is not an IPv6 address", gateway)
+	}
+
+	// The duplicate address detection is skipped, the address is usable right away.
+	addr.Flags = unix.IFA_F_NODAD
+
+	err = netlink.AddrReplace(link, addr)
+	if err != nil {
+		return fmt.Errorf("failed to set IPv6 address %s of %s: %w", address, iface, err)
+	}
+
+	err = netlink.RouteReplace(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Gw:        gw,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set IPv6 default route via %s: %w", gateway, err)
+	}
+
+	return nil
+}
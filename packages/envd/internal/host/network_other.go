@@ -0,0 +1,10 @@
+//go:build !linux
+// +build !linux
+
+package host
+
+import "errors"
+
+func ConfigureIPv6(iface, address, gateway string) error {
+	return errors.New("IPv6 configuration is supported only on linux")
+}
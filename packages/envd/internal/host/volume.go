@@ -0,0 +1,48 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+const mountTimeout = 10 * time.Second
+
+// MountVolume mounts the volume's ext4 block device at the path, the directory is created if it doesn't exist.
+// A volume already mounted at the path is left as it is, so the init can be retried.
+func MountVolume(device, path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), mountTimeout)
+	defer cancel()
+
+	err := os.MkdirAll(path, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create mount point %s: %w", path, err)
+	}
+
+	if exec.CommandContext(ctx, "mountpoint", "-q", path).Run() == nil {
+		return nil
+	}
+
+	// Discard the blocks of deleted files, so they are not kept in the volume cache and uploaded.
+	output, err := exec.CommandContext(ctx, "mount", "-t", "ext4", "-o", "discard", device, path).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to mount %s at %s: %w\nCommand output: %s", device, path, err, string(output))
+	}
+
+	// A new volume contains only the lost+found directory, it is made writable for all users in the sandbox.
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("failed to read volume %s: %w", path, err)
+	}
+
+	if len(entries) == 1 && entries[0].Name() == "lost+found" {
+		err = os.Chmod(path, 0o777)
+		if err != nil {
+			return fmt.Errorf("failed to change volume %s permissions: %w", path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+//go:build linux
+// +build linux
+
+package vsock
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+type listener struct {
+	file *os.File
+	addr *Addr
+}
+
+// Listen binds a vsock stream socket on the port for all CIDs.
+// It fails when the VM does not have a vsock device.
+func Listen(port uint32) (net.Listener, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error creating vsock socket: %w", err)
+	}
+
+	err = unix.Bind(fd, &unix.SockaddrVM{CID: unix.VMADDR_CID_ANY, Port: port})
+	if err != nil {
+		unix.Close(fd)
+
+		return nil, fmt.Errorf("error binding vsock socket on port %d: %w", port, err)
+	}
+
+	err = unix.Listen(fd, unix.SOMAXCONN)
+	if err != nil {
+		unix.Close(fd)
+
+		return nil, fmt.Errorf("error listening on vsock port %d: %w", port, err)
+	}
+
+	cid, err := unix.IoctlGetUint32(fd, unix.IOCTL_VM_SOCKETS_GET_LOCAL_CID)
+	if err != nil {
+		cid = unix.VMADDR_CID_ANY
+	}
+
+	return &listener{
+		// The non-blocking descriptor is registered in the runtime poller, so Accept does not block a thread.
+		file: os.NewFile(uintptr(fd), "vsock-listener"),
+		addr: &Addr{CID: cid, Port: port},
+	}, nil
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	raw, err := l.file.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var nfd int
+	var sa unix.Sockaddr
+	var acceptErr error
+
+	err = raw.Read(func(fd uintptr) bool {
+		nfd, sa, acceptErr = unix.Accept4(int(fd), unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC)
+
+		return acceptErr != unix.EAGAIN
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if acceptErr != nil {
+		return nil, fmt.Errorf("error accepting vsock connection: %w", acceptErr)
+	}
+
+	remote := &Addr{}
+	if vm, ok := sa.(*unix.SockaddrVM); ok {
+		remote.CID = vm.CID
+		remote.Port = vm.Port
+	}
+
+	return &conn{
+		File:   os.NewFile(uintptr(nfd), "vsock-conn"),
+		local:  l.addr,
+		remote: remote,
+	}, nil
+}
+
+func (l *listener) Close() error {
+	return l.file.Close()
+}
+
+func (l *listener) Addr() net.Addr {
+	return l.addr
+}
+
+type conn struct {
+	*os.File
+
+	local  *Addr
+	remote *Addr
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return c.remote
+}
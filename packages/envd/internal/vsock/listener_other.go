@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package vsock
+
+import (
+	"errors"
+	"net"
+)
+
+func Listen(port uint32) (net.Listener, error) {
+	return nil, errors.New("vsock is supported only on linux")
+}
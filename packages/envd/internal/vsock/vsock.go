@@ -0,0 +1,19 @@
+// Package vsock provides a listener for the virtio vsock device, so the host can reach envd
+// through the Firecracker vsock unix socket without relying on the sandbox network.
+package vsock
+
+import "fmt"
+
+// Addr is an address of a vsock socket.
+type Addr struct {
+	CID  uint32
+	Port uint32
+}
+
+func (a *Addr) Network() string {
+	return "vsock"
+}
+
+func (a *Addr) String() string {
+	return fmt.Sprintf("vm(%d):%d", a.CID, a.Port)
+}
@@ -21,6 +21,7 @@ import (
 	processRpc "github.com/e2b-dev/infra/packages/envd/internal/services/process"
 	processSpec "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
+	"github.com/e2b-dev/infra/packages/envd/internal/vsock"
 )
 
 const (
@@ -32,7 +33,7 @@ const (
 )
 
 var (
-	Version = "0.2.0"
+	Version = "0.2.1"
 
 	commitSHA string
 
@@ -183,6 +184,21 @@ func main() {
 
 	log.Printf("envd listening on %s", listener.Addr().String())
 
+	// The orchestrator reaches envd through the vsock device when the sandbox has one,
+	// this works even when the sandbox network is not available.
+	vsockListener, err := vsock.Listen(uint32(port))
+	if err != nil {
+		log.Printf("vsock listener not available: %v", err)
+	} else {
+		log.Printf("envd listening on %s", vsockListener.Addr().String())
+
+		go func() {
+			if err := s.Serve(vsockListener); err != nil && err != http.ErrServerClosed {
+				log.Printf("error serving on vsock: %v", err)
+			}
+		}()
+	}
+
 	// TODO: Not used anymore in template build, replaced by direct envd command call.
 	if startCmdFlag != "" {
 		tag := "startCmd"
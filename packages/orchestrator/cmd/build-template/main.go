@@ -162,6 +162,11 @@ func buildTemplate(parentCtx context.Context, kernelVersion, fcVersion, template
 		return fmt.Errorf("error building template: %w", err)
 	}
 
+	err = storage.WaitForPendingWrites(ctx, persistence)
+	if err != nil {
+		return fmt.Errorf("error waiting for template replication: %w", err)
+	}
+
 	fmt.Println("Build finished, closing...")
 	return nil
 }
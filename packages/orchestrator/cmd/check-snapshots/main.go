@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/verify"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// check-snapshots prints the JSON report of the stored snapshots the proposed Firecracker version can't load and exits with 1 if there are any.
+func main() {
+	firecrackerVersion := flag.String("firecracker", "", "proposed Firecracker version from the Firecracker versions directory")
+	snapshotVersion := flag.String("snapshot-version", "", "snapshot version supported by the proposed Firecracker, used instead of running the binary")
+	prefix := flag.String("prefix", "", "prefix of the checked build ids")
+
+	flag.Parse()
+
+	ctx := context.Background()
+
+	var supported *semver.Version
+	var err error
+
+	switch {
+	case *snapshotVersion != "":
+		supported, err = semver.NewVersion(*snapshotVersion)
+	case *firecrackerVersion != "":
+		supported, err = fc.SnapshotVersion(ctx, *firecrackerVersion)
+	default:
+		log.Fatalf("either -firecracker or -snapshot-version must be set")
+	}
+
+	if err != nil {
+		log.Fatalf("failed to get snapshot version: %s", err)
+	}
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	report, err := verify.Snapshots(ctx, persistence, *prefix, supported)
+	if err != nil {
+		log.Fatalf("failed to check snapshots: %s", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		log.Fatalf("failed to write report: %s", err)
+	}
+
+	if !report.OK {
+		os.Exit(1)
+	}
+}
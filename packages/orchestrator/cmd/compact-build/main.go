@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/compact"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func main() {
+	buildId := flag.String("build", "", "build id")
+	kind := flag.String("kind", "", "'memfile', 'rootfs' or empty for both")
+	cachePath := flag.String("cache", os.TempDir(), "directory for the temporary files")
+
+	flag.Parse()
+
+	var kinds []build.DiffType
+
+	switch *kind {
+	case "memfile":
+		kinds = []build.DiffType{build.Memfile}
+	case "rootfs":
+		kinds = []build.DiffType{build.Rootfs}
+	case "":
+		kinds = []build.DiffType{build.Memfile, build.Rootfs}
+	default:
+		log.Fatalf("invalid kind: %s", *kind)
+	}
+
+	ctx := context.Background()
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	tracer := otel.Tracer("compact-build")
+
+	for _, k := range kinds {
+		h, err := compact.Compact(ctx, tracer, persistence, *buildId, k, *cachePath)
+		if err != nil {
+			log.Fatalf("failed to compact %s: %s", k, err)
+		}
+
+		if h == nil {
+			fmt.Printf("%s/%s is already self-contained\n", *buildId, k)
+
+			continue
+		}
+
+		fmt.Printf("\nCOMPACTED %s/%s (%d maps)\n", *buildId, k, len(h.Mapping))
+		fmt.Printf("========\n")
+
+		for _, mapping := range h.Mapping {
+			fmt.Println(mapping.Format(h.Metadata.BlockSize))
+		}
+	}
+
+	err = storage.WaitForPendingWrites(ctx, persistence)
+	if err != nil {
+		log.Fatalf("failed to wait for replication: %s", err)
+	}
+}
@@ -62,6 +62,20 @@ func main() {
 	fmt.Printf("Block size         %d B\n", h.Metadata.BlockSize)
 	fmt.Printf("Blocks             %d\n", (h.Metadata.Size+h.Metadata.BlockSize-1)/h.Metadata.BlockSize)
 
+	if h.Storage != nil {
+		var compressedSize uint64
+		for _, frame := range h.Storage.Frames {
+			compressedSize += frame.CompressedLength
+		}
+
+		fmt.Printf("\nSTORAGE\n")
+		fmt.Printf("=======\n")
+		fmt.Printf("Compression        %s\n", h.Storage.Compression)
+		fmt.Printf("Frames             %d\n", len(h.Storage.Frames))
+		fmt.Printf("Data size          %d B (%d MiB)\n", h.Storage.Size(), h.Storage.Size()/1024/1024)
+		fmt.Printf("Stored size        %d B (%d MiB)\n", compressedSize, compressedSize/1024/1024)
+	}
+
 	totalSize := int64(unsafe.Sizeof(header.BuildMap{})) * int64(len(h.Mapping)) / 1024
 	var sizeMessage string
 
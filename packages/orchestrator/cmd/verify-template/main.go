@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/verify"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// verify-template prints the JSON report of the build's integrity and exits with 1 if any issue is found.
+func main() {
+	buildId := flag.String("build", "", "build id")
+	kind := flag.String("kind", "", "'memfile', 'rootfs' or empty for both")
+	checksum := flag.Bool("checksum", false, "read the data and verify it against the stored checksums")
+
+	flag.Parse()
+
+	var kinds []build.DiffType
+
+	switch *kind {
+	case "memfile":
+		kinds = []build.DiffType{build.Memfile}
+	case "rootfs":
+		kinds = []build.DiffType{build.Rootfs}
+	case "":
+		kinds = []build.DiffType{build.Memfile, build.Rootfs}
+	default:
+		log.Fatalf("invalid kind: %s", *kind)
+	}
+
+	ctx := context.Background()
+
+	persistence, err := storage.GetTemplateStorageProvider(ctx)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	report, err := verify.Verify(ctx, persistence, *buildId, kinds, *checksum)
+	if err != nil {
+		log.Fatalf("failed to verify build: %s", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		log.Fatalf("failed to write report: %s", err)
+	}
+
+	if !report.OK {
+		os.Exit(1)
+	}
+}
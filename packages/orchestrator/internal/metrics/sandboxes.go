@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,7 +18,10 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -29,6 +33,7 @@ const (
 	minEnvdVersionForMetrics = "0.1.5"
 	timeoutGetMetrics        = 100 * time.Millisecond
 	metricsParallelismFactor = 5 // Used to calculate number of concurrently sandbox metrics requests
+	timeoutInsertMetrics     = 5 * time.Second
 
 	shiftFromMiBToBytes = 20 // Shift to convert MiB to bytes
 )
@@ -44,14 +49,23 @@ type SandboxObserver struct {
 
 	sandboxes *smap.Map[*sandbox.Sandbox]
 
+	// clickhouseStore is nil when the metrics are not written to ClickHouse.
+	clickhouseStore chdb.Store
+
 	meter       metric.Meter
 	cpuTotal    metric.Int64ObservableGauge
 	cpuUsed     metric.Float64ObservableGauge
 	memoryTotal metric.Int64ObservableGauge
 	memoryUsed  metric.Int64ObservableGauge
+
+	networkRxBytes        metric.Int64ObservableGauge
+	networkTxBytes        metric.Int64ObservableGauge
+	networkRxPackets      metric.Int64ObservableGauge
+	networkTxPackets      metric.Int64ObservableGauge
+	networkNewConnections metric.Int64ObservableGauge
 }
 
-func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandboxMetricsExportPeriod time.Duration, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxObserver, error) {
+func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandboxMetricsExportPeriod time.Duration, sandboxes *smap.Map[*sandbox.Sandbox], clickhouseStore chdb.Store) (*SandboxObserver, error) {
 	deltaTemporality := otlpmetricgrpc.WithTemporalitySelector(func(kind sdkmetric.InstrumentKind) metricdata.Temporality {
 		// Use delta temporality for gauges and cumulative for all other instrument kinds.
 		// This is used to prevent reporting sandbox metrics indefinitely.
@@ -92,6 +106,31 @@ func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandbox
 		return nil, fmt.Errorf("failed to create memory used gauge: %w", err)
 	}
 
+	networkRxBytes, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkRxBytesGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network received bytes gauge: %w", err)
+	}
+
+	networkTxBytes, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkTxBytesGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network sent bytes gauge: %w", err)
+	}
+
+	networkRxPackets, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkRxPacketsGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network received packets gauge: %w", err)
+	}
+
+	networkTxPackets, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkTxPacketsGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network sent packets gauge: %w", err)
+	}
+
+	networkNewConnections, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkNewConnectionsGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network new connections gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
 		exportInterval: sandboxMetricsExportPeriod,
 		meterExporter:  externalMeterExporter,
@@ -101,6 +140,14 @@ func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandbox
 		cpuUsed:        cpuUsed,
 		memoryTotal:    memoryTotal,
 		memoryUsed:     memoryUsed,
+
+		networkRxBytes:        networkRxBytes,
+		networkTxBytes:        networkTxBytes,
+		networkRxPackets:      networkRxPackets,
+		networkTxPackets:      networkTxPackets,
+		networkNewConnections: networkNewConnections,
+
+		clickhouseStore: clickhouseStore,
 	}
 
 	registration, err := so.startObserving()
@@ -119,21 +166,40 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 		func(ctx context.Context, o metric.Observer) error {
 			sbxCount := so.sandboxes.Count()
 
+			// The rows of all the sandboxes are written to ClickHouse in one batch per observation.
+			var rowsMu sync.Mutex
+			rows := make([]chmodels.Metrics, 0, sbxCount)
+			addRow := func(row chmodels.Metrics) {
+				rowsMu.Lock()
+				defer rowsMu.Unlock()
+
+				rows = append(rows, row)
+			}
+
 			wg := errgroup.Group{}
 			// Run concurrently to prevent blocking if there are many sandboxes other callbacks
 			limit := math.Ceil(float64(sbxCount) / metricsParallelismFactor)
 			wg.SetLimit(int(limit))
 
 			for _, sbx := range so.sandboxes.Items() {
-				if !utils.IsGTEVersion(sbx.Config.EnvdVersion, minEnvdVersionForMetrics) {
-					continue
-				}
-
 				if !sbx.Checks.UseClickhouseMetrics {
 					continue
 				}
 
 				wg.Go(func() error {
+					attributes := metric.WithAttributes(attribute.String("sandbox_id", sbx.Config.SandboxId), attribute.String("team_id", sbx.Config.TeamId))
+
+					// The network traffic is counted on the host, so it doesn't depend on the envd version.
+					netStats := so.observeNetwork(o, sbx, attributes)
+					row := metricsRow(sbx, netStats)
+
+					// The older envd doesn't report the CPU and memory metrics, the row has only the network traffic.
+					if !utils.IsGTEVersion(sbx.Config.EnvdVersion, minEnvdVersionForMetrics) {
+						addRow(row)
+
+						return nil
+					}
+
 					// Make sure the sandbox doesn't change while we are getting metrics (the slot could be assigned to another sandbox)
 					sbxMetrics, err := sbx.Checks.GetMetrics(timeoutGetMetrics)
 					if err != nil {
@@ -145,13 +211,18 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						return err
 					}
 
-					attributes := metric.WithAttributes(attribute.String("sandbox_id", sbx.Config.SandboxId), attribute.String("team_id", sbx.Config.TeamId))
 					o.ObserveInt64(so.cpuTotal, sbxMetrics.CPUCount, attributes)
 					o.ObserveFloat64(so.cpuUsed, sbxMetrics.CPUUsedPercent, attributes)
 					// Save as bytes for the future, so we can return more accurate values
 					o.ObserveInt64(so.memoryTotal, sbxMetrics.MemTotalMiB<<shiftFromMiBToBytes, attributes)
 					o.ObserveInt64(so.memoryUsed, sbxMetrics.MemUsedMiB<<shiftFromMiBToBytes, attributes)
 
+					row.CPUCount = uint32(sbxMetrics.CPUCount)
+					row.CPUUsedPercent = float32(sbxMetrics.CPUUsedPercent)
+					row.MemTotalMiB = uint64(sbxMetrics.MemTotalMiB)
+					row.MemUsedMiB = uint64(sbxMetrics.MemUsedMiB)
+					addRow(row)
+
 					// Log warnings if memory or CPU usage exceeds thresholds
 					// Round percentage to 2 decimal places
 					memUsedPct := float32(math.Floor(float64(sbxMetrics.MemUsedMiB)/float64(sbxMetrics.MemTotalMiB)*10000) / 100)
@@ -178,8 +249,11 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 				zap.L().Warn("error during observing sandbox metrics", zap.Error(err))
 			}
 
+			so.insertMetrics(ctx, rows)
+
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed)
+		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed,
+		so.networkRxBytes, so.networkTxBytes, so.networkRxPackets, so.networkTxPackets, so.networkNewConnections)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +261,58 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 	return unregister, nil
 }
 
+// observeNetwork observes the network traffic of the sandbox since it was started, the traffic before its pauses included.
+// The returned stats are zero when the traffic couldn't be read.
+func (so *SandboxObserver) observeNetwork(o metric.Observer, sbx *sandbox.Sandbox, attributes metric.ObserveOption) network.Stats {
+	if sbx.Slot == nil {
+		return network.Stats{}
+	}
+
+	stats, err := sbx.NetworkStats()
+	if err != nil {
+		sbxlogger.I(sbx).Debug("failed to get sandbox network stats", zap.Error(err))
+
+		return network.Stats{}
+	}
+
+	o.ObserveInt64(so.networkRxBytes, int64(stats.RxBytes), attributes)
+	o.ObserveInt64(so.networkTxBytes, int64(stats.TxBytes), attributes)
+	o.ObserveInt64(so.networkRxPackets, int64(stats.RxPackets), attributes)
+	o.ObserveInt64(so.networkTxPackets, int64(stats.TxPackets), attributes)
+	o.ObserveInt64(so.networkNewConnections, int64(stats.NewConnections), attributes)
+
+	return stats
+}
+
+// metricsRow returns the ClickHouse row of the sandbox with its network traffic, the CPU and memory metrics are set from envd.
+func metricsRow(sbx *sandbox.Sandbox, netStats network.Stats) chmodels.Metrics {
+	return chmodels.Metrics{
+		Timestamp:         time.Now().UTC(),
+		SandboxID:         sbx.Config.SandboxId,
+		TeamID:            sbx.Config.TeamId,
+		NetRxBytes:        netStats.RxBytes,
+		NetTxBytes:        netStats.TxBytes,
+		NetRxPackets:      netStats.RxPackets,
+		NetTxPackets:      netStats.TxPackets,
+		NetNewConnections: netStats.NewConnections,
+	}
+}
+
+// insertMetrics writes the metrics of the observed sandboxes to ClickHouse, the API reads the sandbox metrics from there.
+func (so *SandboxObserver) insertMetrics(ctx context.Context, rows []chmodels.Metrics) {
+	if so.clickhouseStore == nil || len(rows) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeoutInsertMetrics)
+	defer cancel()
+
+	err := so.clickhouseStore.InsertMetrics(ctx, rows...)
+	if err != nil {
+		zap.L().Warn("failed to insert sandbox metrics to ClickHouse", zap.Int("sandboxes", len(rows)), zap.Error(err))
+	}
+}
+
 func (so *SandboxObserver) Close(ctx context.Context) error {
 	if so.meterExporter == nil {
 		return nil
@@ -204,5 +330,11 @@ func (so *SandboxObserver) Close(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("failed to shutdown sandbox observer meter provider: %w", err))
 	}
 
+	if so.clickhouseStore != nil {
+		if err := so.clickhouseStore.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close sandbox observer ClickHouse store: %w", err))
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -0,0 +1,296 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// fetchConcurrency is the number of parallel reads of a single file.
+	fetchConcurrency = 8
+
+	uploadPollInterval = time.Second
+)
+
+// Fetch streams the snapshot files of the build from the source node.
+// The snapfile is written to the template cache files, the diffs to the build cache.
+func Fetch(ctx context.Context, source string, files *storage.TemplateCacheFiles) (*sandbox.Snapshot, error) {
+	conn, err := grpc.NewClient(source, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the source node: %w", err)
+	}
+	defer conn.Close()
+
+	r := &reader{
+		client:  orchestrator.NewSandboxServiceClient(conn),
+		buildID: files.BuildId,
+	}
+
+	buildID, err := uuid.Parse(files.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	snapshot := &sandbox.Snapshot{
+		MemfileDiff: &build.NoDiff{},
+		RootfsDiff:  &build.NoDiff{},
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		f, err := os.Create(files.CacheSnapfilePath())
+		if err != nil {
+			return fmt.Errorf("failed to create snapfile: %w", err)
+		}
+		defer f.Close()
+
+		err = r.readTo(ctx, storage.SnapfileName, f)
+		if err != nil {
+			return errors.Join(err, os.Remove(f.Name()))
+		}
+
+		snapshot.Snapfile = template.NewLocalFileLink(f.Name())
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		h, diff, err := r.readDiff(ctx, build.Memfile, buildID)
+		if err != nil {
+			return err
+		}
+
+		snapshot.MemfileDiffHeader, snapshot.MemfileDiff = h, diff
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		h, diff, err := r.readDiff(ctx, build.Rootfs, buildID)
+		if err != nil {
+			return err
+		}
+
+		snapshot.RootfsDiffHeader, snapshot.RootfsDiff = h, diff
+
+		return nil
+	})
+
+	err = eg.Wait()
+	if err != nil {
+		errs := []error{err, snapshot.MemfileDiff.Close(), snapshot.RootfsDiff.Close()}
+		if snapshot.Snapfile != nil {
+			errs = append(errs, snapshot.Snapfile.Close())
+		}
+
+		return nil, errors.Join(errs...)
+	}
+
+	return snapshot, nil
+}
+
+// WaitForUpload waits until the snapshot files of the build are uploaded to the storage by the source node.
+func WaitForUpload(ctx context.Context, persistence storage.StorageProvider, buildID string) error {
+	ticker := time.NewTicker(uploadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		err := checkUploaded(ctx, persistence, buildID)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("snapshot was not uploaded: %w", errors.Join(err, context.Cause(ctx)))
+		case <-ticker.C:
+		}
+	}
+}
+
+func checkUploaded(ctx context.Context, persistence storage.StorageProvider, buildID string) error {
+	files := storage.NewTemplateFiles("", buildID, "", "")
+
+	err := checkObject(ctx, persistence, files.StorageSnapfilePath())
+	if err != nil {
+		return err
+	}
+
+	for _, paths := range [][2]string{
+		{files.StorageMemfileHeaderPath(), files.StorageMemfilePath()},
+		{files.StorageRootfsHeaderPath(), files.StorageRootfsPath()},
+	} {
+		object, err := persistence.OpenObject(ctx, paths[0])
+		if err != nil {
+			return err
+		}
+
+		h, err := header.Deserialize(object)
+		if err != nil {
+			return fmt.Errorf("failed to read header %s: %w", paths[0], err)
+		}
+
+		// The data object is uploaded only when the build has its own diff.
+		if referencesBuild(h, h.Metadata.BuildId) {
+			err = checkObject(ctx, persistence, paths[1])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkObject(ctx context.Context, persistence storage.StorageProvider, path string) error {
+	object, err := persistence.OpenObject(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	_, err = object.Size()
+	if err != nil {
+		return fmt.Errorf("failed to get size of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func referencesBuild(h *header.Header, buildID uuid.UUID) bool {
+	for _, m := range h.Mapping {
+		if m.BuildId == buildID {
+			return true
+		}
+	}
+
+	return false
+}
+
+type reader struct {
+	client  orchestrator.SandboxServiceClient
+	buildID string
+}
+
+func (r *reader) read(ctx context.Context, name string, offset int64) (*orchestrator.SnapshotFileResponse, error) {
+	resp, err := r.client.ReadSnapshotFile(ctx, &orchestrator.SnapshotFileRequest{
+		BuildId: r.buildID,
+		Name:    name,
+		Offset:  offset,
+		Length:  maxReadLength,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %d: %w", name, offset, err)
+	}
+
+	return resp, nil
+}
+
+// readTo copies the whole file to the writer, the chunks after the first one are read in parallel.
+func (r *reader) readTo(ctx context.Context, name string, w io.WriterAt) error {
+	first, err := r.read(ctx, name, 0)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteAt(first.GetData(), 0)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(fetchConcurrency)
+
+	for offset := int64(len(first.GetData())); offset < first.GetSize(); offset += maxReadLength {
+		eg.Go(func() error {
+			resp, err := r.read(ctx, name, offset)
+			if err != nil {
+				return err
+			}
+
+			if int64(len(resp.GetData())) != min(maxReadLength, first.GetSize()-offset) {
+				return fmt.Errorf("short read of %s at %d: %d bytes", name, offset, len(resp.GetData()))
+			}
+
+			_, err = w.WriteAt(resp.GetData(), offset)
+			if err != nil {
+				return fmt.Errorf("failed to write %s: %w", name, err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// readDiff reads the header and the data of the build's own diff.
+func (r *reader) readDiff(ctx context.Context, diffType build.DiffType, buildID uuid.UUID) (*header.Header, build.Diff, error) {
+	serialized := &memoryFile{}
+
+	err := r.readTo(ctx, string(diffType)+storage.HeaderSuffix, serialized)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h, err := header.Deserialize(bytes.NewBuffer(serialized.data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize %s header: %w", diffType, err)
+	}
+
+	if !referencesBuild(h, buildID) {
+		return h, &build.NoDiff{}, nil
+	}
+
+	f, err := build.NewLocalDiffFile(build.DefaultCachePath, r.buildID, diffType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s diff file: %w", diffType, err)
+	}
+
+	err = r.readTo(ctx, string(diffType), f)
+	if err != nil {
+		return nil, nil, errors.Join(err, f.Close(), os.Remove(f.Name()))
+	}
+
+	diff, err := f.CloseToDiff(int64(h.Metadata.BlockSize))
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("failed to create %s diff: %w", diffType, err), os.Remove(f.Name()))
+	}
+
+	return h, diff, nil
+}
+
+// memoryFile collects the small files in memory.
+type memoryFile struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+func (m *memoryFile) WriteAt(p []byte, off int64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if end := off + int64(len(p)); end > int64(len(m.data)) {
+		m.data = append(m.data, make([]byte, end-int64(len(m.data)))...)
+	}
+
+	return copy(m.data[off:], p), nil
+}
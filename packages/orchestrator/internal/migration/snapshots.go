@@ -0,0 +1,133 @@
+package migration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// maxReadLength limits the data returned by a single read, so the responses fit into the default gRPC message size.
+const maxReadLength = 2 << 20 // 2 MiB
+
+var ErrNotFound = errors.New("snapshot file not found")
+
+// snapshotFile is either a file on the disk or a file kept in memory.
+type snapshotFile struct {
+	path string
+	data []byte
+}
+
+// Snapshots are the snapshots of the migrated sandboxes, they are served to the target nodes until the migration is finished.
+type Snapshots struct {
+	builds *smap.Map[map[string]snapshotFile]
+}
+
+func NewSnapshots() *Snapshots {
+	return &Snapshots{builds: smap.New[map[string]snapshotFile]()}
+}
+
+// Add starts serving the files of the snapshot under the names they have in the storage.
+func (s *Snapshots) Add(buildID string, snapshot *sandbox.Snapshot) error {
+	files := map[string]snapshotFile{
+		storage.SnapfileName: {path: snapshot.Snapfile.Path()},
+	}
+
+	for _, d := range []struct {
+		name   string
+		header *header.Header
+		diff   build.Diff
+	}{
+		{name: storage.MemfileName, header: snapshot.MemfileDiffHeader, diff: snapshot.MemfileDiff},
+		{name: storage.RootfsName, header: snapshot.RootfsDiffHeader, diff: snapshot.RootfsDiff},
+	} {
+		if d.header != nil {
+			serialized, err := header.Serialize(d.header)
+			if err != nil {
+				return fmt.Errorf("failed to serialize %s header: %w", d.name, err)
+			}
+
+			data, err := io.ReadAll(serialized)
+			if err != nil {
+				return fmt.Errorf("failed to read %s header: %w", d.name, err)
+			}
+
+			files[d.name+storage.HeaderSuffix] = snapshotFile{data: data}
+		}
+
+		if _, ok := d.diff.(*build.NoDiff); ok {
+			continue
+		}
+
+		path, err := d.diff.CachePath()
+		if err != nil {
+			return fmt.Errorf("failed to get %s diff path: %w", d.name, err)
+		}
+
+		files[d.name] = snapshotFile{path: path}
+	}
+
+	s.builds.Insert(buildID, files)
+
+	return nil
+}
+
+// Remove stops serving the snapshot, the target nodes read it from the storage afterwards.
+func (s *Snapshots) Remove(buildID string) {
+	s.builds.Remove(buildID)
+}
+
+// Read returns the data of the snapshot file at the offset and the size of the whole file.
+func (s *Snapshots) Read(buildID, name string, offset, length int64) ([]byte, int64, error) {
+	files, ok := s.builds.Get(buildID)
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: build %s is not served", ErrNotFound, buildID)
+	}
+
+	file, ok := files[name]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %s/%s", ErrNotFound, buildID, name)
+	}
+
+	var src io.ReaderAt
+	var size int64
+
+	if file.path == "" {
+		src = bytes.NewReader(file.data)
+		size = int64(len(file.data))
+	} else {
+		f, err := os.Open(file.path)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to open %s/%s: %w", buildID, name, err)
+		}
+		defer f.Close()
+
+		stat, err := f.Stat()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to stat %s/%s: %w", buildID, name, err)
+		}
+
+		src = f
+		size = stat.Size()
+	}
+
+	if offset < 0 || length < 0 || offset > size {
+		return nil, 0, fmt.Errorf("invalid range %d+%d of the file %s/%s of size %d", offset, length, buildID, name, size)
+	}
+
+	data := make([]byte, min(length, maxReadLength, size-offset))
+
+	n, err := src.ReadAt(data, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, 0, fmt.Errorf("failed to read %s/%s: %w", buildID, name, err)
+	}
+
+	return data[:n], size, nil
+}
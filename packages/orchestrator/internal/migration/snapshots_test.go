@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestSnapshotsRead(t *testing.T) {
+	buildID := uuid.New()
+
+	snapfilePath := filepath.Join(t.TempDir(), storage.SnapfileName)
+	require.NoError(t, os.WriteFile(snapfilePath, []byte("snapfile-content"), 0o644))
+
+	memfileHeader := header.NewHeader(header.NewTemplateMetadata(buildID, 4096, 8192), nil)
+	rootfsHeader := header.NewHeader(header.NewTemplateMetadata(buildID, 4096, 4096), nil)
+
+	snapshots := NewSnapshots()
+	require.NoError(t, snapshots.Add(buildID.String(), &sandbox.Snapshot{
+		MemfileDiff:       &build.NoDiff{},
+		MemfileDiffHeader: memfileHeader,
+		RootfsDiff:        &build.NoDiff{},
+		RootfsDiffHeader:  rootfsHeader,
+		Snapfile:          template.NewLocalFileLink(snapfilePath),
+	}))
+
+	data, size, err := snapshots.Read(buildID.String(), storage.SnapfileName, 9, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), size)
+	assert.Equal(t, []byte("content"), data)
+
+	data, _, err = snapshots.Read(buildID.String(), storage.MemfileName+storage.HeaderSuffix, 0, maxReadLength)
+	require.NoError(t, err)
+
+	serialized, err := header.Serialize(memfileHeader)
+	require.NoError(t, err)
+
+	expected, err := io.ReadAll(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	h, err := header.Deserialize(bytes.NewBuffer(data))
+	require.NoError(t, err)
+	assert.True(t, referencesBuild(h, buildID))
+
+	// The builds without their own diff don't serve the data file.
+	_, _, err = snapshots.Read(buildID.String(), storage.MemfileName, 0, maxReadLength)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, _, err = snapshots.Read(buildID.String(), storage.SnapfileName, 17, 1)
+	require.Error(t, err)
+
+	snapshots.Remove(buildID.String())
+
+	_, _, err = snapshots.Read(buildID.String(), storage.SnapfileName, 0, maxReadLength)
+	require.ErrorIs(t, err, ErrNotFound)
+}
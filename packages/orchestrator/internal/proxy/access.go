@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
+)
+
+// portExposure returns the exposure of the sandbox port.
+// The envd port is always public as it's protected by the envd access token itself.
+func portExposure(config *orchestrator.SandboxConfig, port uint64) pool.PortExposure {
+	access := config.GetPortAccess()
+	if access == nil || port == uint64(consts.DefaultEnvdServerPort) {
+		return pool.PortPublic
+	}
+
+	exposure := access.GetDefaultExposure()
+	for _, p := range access.GetPorts() {
+		if p.GetPort() == port {
+			exposure = p.GetExposure()
+
+			break
+		}
+	}
+
+	switch exposure {
+	case orchestrator.PortExposure_Protected:
+		// Without the envd access token there is nothing to derive the port token from.
+		if config.GetEnvdAccessToken() == "" {
+			return pool.PortClosed
+		}
+
+		return pool.PortProtected
+	case orchestrator.PortExposure_Closed:
+		return pool.PortClosed
+	default:
+		return pool.PortPublic
+	}
+}
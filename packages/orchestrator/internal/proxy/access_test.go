@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
+)
+
+func TestPortExposure(t *testing.T) {
+	token := "token"
+	config := &orchestrator.SandboxConfig{
+		EnvdAccessToken: &token,
+		PortAccess: &orchestrator.SandboxPortAccess{
+			DefaultExposure: orchestrator.PortExposure_Closed,
+			Ports: []*orchestrator.SandboxPort{
+				{Port: 3000, Exposure: orchestrator.PortExposure_Public},
+				{Port: 8080, Exposure: orchestrator.PortExposure_Protected},
+			},
+		},
+	}
+
+	assert.Equal(t, pool.PortPublic, portExposure(config, 3000))
+	assert.Equal(t, pool.PortProtected, portExposure(config, 8080))
+	assert.Equal(t, pool.PortClosed, portExposure(config, 9000))
+	assert.Equal(t, pool.PortPublic, portExposure(config, uint64(consts.DefaultEnvdServerPort)))
+
+	// Protected ports can't be reached without the envd access token.
+	config.EnvdAccessToken = nil
+	assert.Equal(t, pool.PortClosed, portExposure(config, 8080))
+
+	// All the ports are public without the port access settings.
+	assert.Equal(t, pool.PortPublic, portExposure(&orchestrator.SandboxConfig{}, 9000))
+}
@@ -49,6 +49,13 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				Host:   fmt.Sprintf("%s:%d", sbx.Slot.HostIPString(), port),
 			}
 
+			exposure := portExposure(sbx.Config, port)
+
+			var accessToken string
+			if exposure == pool.PortProtected {
+				accessToken = reverse_proxy.PortAccessToken(sbx.Config.GetEnvdAccessToken(), port)
+			}
+
 			return &pool.Destination{
 				Url:                                url,
 				SandboxId:                          sbx.Config.SandboxId,
@@ -58,6 +65,8 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				// We need to include id unique to sandbox to prevent reuse of connection to the same IP:port pair by different sandboxes reusing the network slot.
 				// We are not using sandbox id to prevent removing connections based on sandbox id (pause/resume race condition).
 				ConnectionKey: sbx.Config.ExecutionId,
+				Exposure:      exposure,
+				AccessToken:   accessToken,
 				RequestLogger: zap.L().With(
 					zap.String("host", r.Host),
 					logger.WithSandboxID(sbx.Config.SandboxId),
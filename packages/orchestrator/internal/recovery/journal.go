@@ -0,0 +1,122 @@
+package recovery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+const entrySuffix = ".json"
+
+// Entry describes the host resources of a running sandbox that are left behind when the orchestrator crashes.
+type Entry struct {
+	SandboxID   string `json:"sandboxID"`
+	TemplateID  string `json:"templateID"`
+	TeamID      string `json:"teamID"`
+	ExecutionID string `json:"executionID"`
+
+	SlotKey string `json:"slotKey"`
+	SlotIdx int    `json:"slotIdx"`
+
+	// Files are the sandbox files and directories on the host.
+	Files []string `json:"files"`
+}
+
+func (e Entry) LoggerMetadata() sbxlogger.SandboxMetadata {
+	return sbxlogger.SandboxMetadata{
+		SandboxID:  e.SandboxID,
+		TemplateID: e.TemplateID,
+		TeamID:     e.TeamID,
+	}
+}
+
+// Journal persists the entries of the running sandboxes, one file per sandbox execution.
+type Journal struct {
+	dir string
+}
+
+func NewJournal(dir string) (*Journal, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create journal directory: %w", err)
+	}
+
+	return &Journal{dir: dir}, nil
+}
+
+// Record writes the entry, it replaces the previous entry of the same execution.
+func (j *Journal) Record(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry: %w", err)
+	}
+
+	// The entry is renamed to its path, so a crash never leaves a partially written entry.
+	tmpPath := j.path(entry.ExecutionID) + ".tmp"
+
+	err = os.WriteFile(tmpPath, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write journal entry: %w", err)
+	}
+
+	err = os.Rename(tmpPath, j.path(entry.ExecutionID))
+	if err != nil {
+		return fmt.Errorf("failed to rename journal entry: %w", err)
+	}
+
+	return nil
+}
+
+// Remove deletes the entry of the execution, it is a no-op if there is no entry.
+func (j *Journal) Remove(executionID string) error {
+	err := os.Remove(j.path(executionID))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove journal entry: %w", err)
+	}
+
+	return nil
+}
+
+// Entries returns all recorded entries, the entries that can't be read are skipped and reported in the error.
+func (j *Journal) Entries() ([]Entry, error) {
+	files, err := os.ReadDir(j.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal directory: %w", err)
+	}
+
+	var entries []Entry
+	var errs []error
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entrySuffix) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(j.dir, file.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read journal entry %s: %w", file.Name(), err))
+
+			continue
+		}
+
+		var entry Entry
+		err = json.Unmarshal(data, &entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse journal entry %s: %w", file.Name(), err))
+
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, errors.Join(errs...)
+}
+
+func (j *Journal) path(executionID string) string {
+	return filepath.Join(j.dir, executionID+entrySuffix)
+}
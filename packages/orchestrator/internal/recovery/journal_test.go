@@ -0,0 +1,43 @@
+package recovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	dir := t.TempDir()
+
+	journal, err := NewJournal(dir)
+	require.NoError(t, err)
+
+	entry := Entry{
+		SandboxID:   "sbx-1",
+		TemplateID:  "template-1",
+		TeamID:      "team-1",
+		ExecutionID: "execution-1",
+		SlotKey:     "slot-key",
+		SlotIdx:     3,
+		Files:       []string{"/tmp/rootfs.cow"},
+	}
+	require.NoError(t, journal.Record(entry))
+
+	// Entries that can't be parsed are skipped and reported.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o644))
+
+	entries, err := journal.Entries()
+	require.Error(t, err)
+	assert.Equal(t, []Entry{entry}, entries)
+
+	require.NoError(t, journal.Remove(entry.ExecutionID))
+	require.NoError(t, journal.Remove(entry.ExecutionID))
+	require.NoError(t, os.Remove(filepath.Join(dir, "broken.json")))
+
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,236 @@
+package recovery
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// orphanedFilePatterns are the sandbox cache files that are removed if no process uses them.
+var orphanedFilePatterns = []string{"*.cow", "*.cache"}
+
+// Report describes the resources cleaned up by the recovery.
+type Report struct {
+	// Sandboxes are the sandboxes that were running when the orchestrator crashed, they were killed.
+	Sandboxes []Entry
+
+	Slots   int
+	Devices int
+	Files   int
+}
+
+// Recover cleans up the host resources left behind by a crashed orchestrator.
+// It must be called before the network and device pools are created.
+//
+// The sandboxes that were running are not re-adopted, their memory (UFFD) and rootfs (NBD) were served
+// by the crashed process, so the VMs can't make any progress anymore.
+// Their Firecracker processes are killed and the sandboxes are reported as killed.
+func Recover(ctx context.Context, tracer trace.Tracer, journal *Journal, clientID string) (*Report, error) {
+	ctx, span := tracer.Start(ctx, "recover")
+	defer span.End()
+
+	report := &Report{}
+	var errs []error
+
+	entries, err := journal.Entries()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	slots := make([]*network.Slot, 0, len(entries))
+	for _, entry := range entries {
+		slot, err := network.NewSlot(entry.SlotKey, entry.SlotIdx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid slot of sandbox %s: %w", entry.SandboxID, err))
+
+			continue
+		}
+
+		slots = append(slots, slot)
+	}
+
+	// The Firecracker processes are killed together with the networks they are running in.
+	report.Slots, err = network.CleanupOrphanedSlots(ctx, tracer, clientID, slots)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to cleanup network slots: %w", err))
+	}
+
+	report.Devices, err = nbd.DisconnectOrphaned(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to disconnect NBD devices: %w", err))
+	}
+
+	for _, entry := range entries {
+		for _, path := range entry.Files {
+			// The volume caches with data that was not uploaded yet are kept for SyncVolumeCaches.
+			if hasUnsyncedVolumeData(path) {
+				continue
+			}
+
+			err = os.RemoveAll(path)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to remove '%s': %w", path, err))
+			}
+		}
+
+		err = journal.Remove(entry.ExecutionID)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		sbxlogger.E(entry).Info("Sandbox killed, the orchestrator crashed")
+		report.Sandboxes = append(report.Sandboxes, entry)
+	}
+
+	// Files of the sandboxes that crashed before they were recorded in the journal.
+	report.Files, err = removeOrphanedFiles(storage.SandboxCacheDir())
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to remove orphaned files: %w", err))
+	}
+
+	zap.L().Info("Recovered from the orchestrator crash",
+		zap.Int("sandboxes", len(report.Sandboxes)),
+		zap.Int("slots", report.Slots),
+		zap.Int("devices", report.Devices),
+		zap.Int("files", report.Files),
+	)
+
+	return report, errors.Join(errs...)
+}
+
+// removeOrphanedFiles removes the sandbox cache files in the directory that are not opened or mapped by any process.
+func removeOrphanedFiles(dir string) (int, error) {
+	var candidates []string
+	for _, pattern := range orphanedFilePatterns {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return 0, fmt.Errorf("failed to list files: %w", err)
+		}
+
+		candidates = append(candidates, matches...)
+	}
+
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	used, err := usedFiles()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	var errs []error
+	for _, path := range candidates {
+		if _, ok := used[path]; ok {
+			continue
+		}
+
+		if hasUnsyncedVolumeData(path) {
+			continue
+		}
+
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("failed to remove '%s': %w", path, err))
+
+			continue
+		}
+
+		removed++
+	}
+
+	return removed, errors.Join(errs...)
+}
+
+// SyncVolumeCaches uploads the volume data left in the caches in the directory by the previous run of the orchestrator,
+// when it crashed or the volume couldn't be synced after its sandbox stopped. It returns the number of the uploaded caches.
+// The caches that can't be uploaded are kept for the next start.
+func SyncVolumeCaches(ctx context.Context, volumeStorage storage.StorageProvider, dir string) (int, error) {
+	states, err := filepath.Glob(filepath.Join(dir, "*"+block.VolumeStateSuffix))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list volume states: %w", err)
+	}
+
+	synced := 0
+	var errs []error
+	for _, state := range states {
+		cachePath := strings.TrimSuffix(state, block.VolumeStateSuffix)
+
+		err = block.SyncCachedVolume(ctx, volumeStorage, cachePath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync volume cache '%s': %w", cachePath, err))
+
+			continue
+		}
+
+		synced++
+	}
+
+	return synced, errors.Join(errs...)
+}
+
+// hasUnsyncedVolumeData returns true if the path is a volume cache with chunks that were not uploaded yet.
+func hasUnsyncedVolumeData(path string) bool {
+	_, err := os.Stat(path + block.VolumeStateSuffix)
+
+	return err == nil
+}
+
+// usedFiles returns the paths of the files opened or memory mapped by the running processes.
+func usedFiles() (map[string]struct{}, error) {
+	processes, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read processes: %w", err)
+	}
+
+	used := make(map[string]struct{})
+	for _, process := range processes {
+		if _, err := strconv.Atoi(process.Name()); err != nil {
+			continue
+		}
+
+		procDir := filepath.Join("/proc", process.Name())
+
+		// The processes can exit at any time, so the errors are ignored.
+		fds, _ := os.ReadDir(filepath.Join(procDir, "fd"))
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(procDir, "fd", fd.Name()))
+			if err == nil {
+				used[target] = struct{}{}
+			}
+		}
+
+		maps, err := os.Open(filepath.Join(procDir, "maps"))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(maps)
+		for scanner.Scan() {
+			// address perms offset dev inode pathname
+			fields := strings.Fields(scanner.Text())
+			if len(fields) >= 6 {
+				used[fields[5]] = struct{}{}
+			}
+		}
+
+		maps.Close()
+	}
+
+	return used, nil
+}
@@ -0,0 +1,32 @@
+package recovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+)
+
+func TestRemoveOrphanedFiles_KeepsUnsyncedVolumeCaches(t *testing.T) {
+	dir := t.TempDir()
+
+	orphaned := filepath.Join(dir, "rootfs.cow")
+	synced := filepath.Join(dir, "volume-synced.cache")
+	unsynced := filepath.Join(dir, "volume-unsynced.cache")
+
+	for _, path := range []string{orphaned, synced, unsynced, unsynced + block.VolumeStateSuffix} {
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+	}
+
+	removed, err := removeOrphanedFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	assert.NoFileExists(t, orphaned)
+	assert.NoFileExists(t, synced)
+	assert.FileExists(t, unsynced)
+}
@@ -0,0 +1,92 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+const (
+	balloonReclaimInterval = 30 * time.Second
+	balloonRequestTimeout  = 2 * time.Second
+)
+
+var ErrBalloonStopped = errors.New("balloon reclaimer stopped")
+
+// BalloonReclaimer periodically resizes the sandbox's balloon based on the guest memory statistics,
+// so the memory the guest does not use is returned to the host.
+type BalloonReclaimer struct {
+	sandbox *Sandbox
+
+	ctx       context.Context
+	cancelCtx context.CancelCauseFunc
+}
+
+func NewBalloonReclaimer(sandbox *Sandbox) *BalloonReclaimer {
+	// Create background context, the reclaimer runs for the whole life of the sandbox.
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	return &BalloonReclaimer{
+		sandbox:   sandbox,
+		ctx:       ctx,
+		cancelCtx: cancel,
+	}
+}
+
+func (b *BalloonReclaimer) Start() {
+	ticker := time.NewTicker(balloonReclaimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !b.reclaim() {
+				return
+			}
+		case <-b.ctx.Done():
+			return
+		}
+	}
+}
+
+func (b *BalloonReclaimer) Stop() {
+	b.cancelCtx(ErrBalloonStopped)
+}
+
+// reclaim resizes the balloon once, it returns false when the sandbox does not have a balloon device.
+func (b *BalloonReclaimer) reclaim() bool {
+	ctx, cancel := context.WithTimeout(b.ctx, balloonRequestTimeout)
+	defer cancel()
+
+	stats, err := b.sandbox.process.BalloonStats(ctx)
+	if err != nil {
+		if b.ctx.Err() == nil {
+			// Sandboxes created from templates built before the balloon was introduced don't have the device.
+			sbxlogger.I(b.sandbox).Debug("balloon stats not available, stopping the balloon reclaimer", zap.Error(err))
+		}
+
+		return false
+	}
+
+	b.sandbox.resourcesMu.Lock()
+	defer b.sandbox.resourcesMu.Unlock()
+
+	_, limitMb := b.sandbox.limits()
+
+	target, ok := fc.BalloonTarget(b.sandbox.config().RamMb, limitMb, stats)
+	if !ok {
+		return true
+	}
+
+	err = b.sandbox.process.SetBalloon(ctx, target)
+	if err != nil && b.ctx.Err() == nil {
+		sbxlogger.I(b.sandbox).Warn("failed to resize the balloon", zap.Int64("target_mib", target), zap.Error(err))
+	}
+
+	return true
+}
@@ -149,6 +149,36 @@ func (m *Cache) WriteAt(b []byte, off int64) (int, error) {
 	return m.WriteAtWithoutLock(b, off)
 }
 
+// Trim discards the blocks fully covered by the range, partially covered blocks are left untouched.
+// Discarded blocks read as zeroes and are marked as cached, so they are exported as empty blocks in the diff.
+func (m *Cache) Trim(off, length int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.isClosed() {
+		return NewErrCacheClosed(m.filePath)
+	}
+
+	start := header.BlockOffset(header.TotalBlocks(off, m.blockSize), m.blockSize)
+	end := header.BlockOffset(header.BlockIdx(min(off+length, m.size), m.blockSize), m.blockSize)
+
+	if start >= end {
+		return nil
+	}
+
+	// Release the backing pages of the range, so the discarded data does not occupy space on disk.
+	err := unix.Madvise((*m.mmap)[start:end], unix.MADV_REMOVE)
+	if err != nil {
+		zap.L().Debug("error releasing trimmed range, zeroing it instead", zap.Int64("offset", start), zap.Int64("length", end-start), zap.Error(err))
+
+		clear((*m.mmap)[start:end])
+	}
+
+	m.setIsCached(start, end-start)
+
+	return nil
+}
+
 func (m *Cache) Close() (e error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -0,0 +1,54 @@
+package block
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestCache_TrimExportsEmptyBlocks(t *testing.T) {
+	blockSize := int64(header.RootfsBlockSize)
+
+	cache, err := NewCache(4*blockSize, blockSize, filepath.Join(t.TempDir(), "cache"), false)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	_, err = cache.WriteAt(bytes.Repeat([]byte{1}, int(3*blockSize)), 0)
+	require.NoError(t, err)
+
+	// Only block 1 is fully covered by the trimmed range.
+	err = cache.Trim(blockSize-1, blockSize+2)
+	require.NoError(t, err)
+
+	// Block 3 was never written, but it is now known to be empty.
+	err = cache.Trim(3*blockSize, blockSize)
+	require.NoError(t, err)
+
+	trimmed := make([]byte, blockSize)
+	_, err = cache.ReadAt(trimmed, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, blockSize), trimmed)
+
+	var diff bytes.Buffer
+	m, err := cache.ExportToDiff(&diff)
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint{0, 2}, setBits(m.Dirty))
+	assert.Equal(t, []uint{1, 3}, setBits(m.Empty))
+	assert.Equal(t, bytes.Repeat([]byte{1}, int(2*blockSize)), diff.Bytes())
+}
+
+func setBits(b *bitset.BitSet) []uint {
+	var bits []uint
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		bits = append(bits, i)
+	}
+
+	return bits
+}
@@ -134,6 +134,10 @@ func (c *Chunker) fetchToCache(off, length int64) error {
 				b := make([]byte, ChunkSize)
 
 				_, err := c.base.ReadAt(b, fetchOff)
+				if errors.Is(err, header.ErrChecksumMismatch) {
+					zap.L().Error("chunk failed checksum verification", zap.Int64("offset", fetchOff), zap.Error(err))
+				}
+
 				if err != nil && !errors.Is(err, io.EOF) {
 					return fmt.Errorf("failed to read chunk from base %d: %w", fetchOff, err)
 				}
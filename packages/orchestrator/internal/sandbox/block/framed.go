@@ -0,0 +1,69 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// FramedReader reads the uncompressed data of a framed data object.
+// Every frame is decompressed and its checksum verified when it is fetched.
+type FramedReader struct {
+	base    io.ReaderAt
+	storage *header.StorageMetadata
+	name    string
+}
+
+func NewFramedReader(base io.ReaderAt, storage *header.StorageMetadata, name string) *FramedReader {
+	return &FramedReader{
+		base:    base,
+		storage: storage,
+		name:    name,
+	}
+}
+
+func (r *FramedReader) Size() int64 {
+	return r.storage.Size()
+}
+
+func (r *FramedReader) ReadAt(p []byte, off int64) (int, error) {
+	var n int
+
+	for n < len(p) {
+		if off+int64(n) >= r.Size() {
+			return n, io.EOF
+		}
+
+		data, frame, err := r.readFrame(off + int64(n))
+		if err != nil {
+			return n, err
+		}
+
+		n += copy(p[n:], data[off+int64(n)-int64(frame.Offset):])
+	}
+
+	return n, nil
+}
+
+func (r *FramedReader) readFrame(off int64) ([]byte, *header.StorageMap, error) {
+	frame, err := r.storage.Frame(off)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find frame in %s: %w", r.name, err)
+	}
+
+	compressed := make([]byte, frame.CompressedLength)
+
+	_, err = r.base.ReadAt(compressed, int64(frame.CompressedOffset))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("failed to read frame at %d from %s: %w", frame.Offset, r.name, err)
+	}
+
+	data, err := header.DecompressFrame(r.storage.Compression, frame, compressed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("corrupted data in %s: %w", r.name, err)
+	}
+
+	return data, frame, nil
+}
@@ -3,6 +3,7 @@ package block
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -53,6 +54,15 @@ func (o *Overlay) EjectCache() (*Cache, error) {
 	return o.cache, nil
 }
 
+// ExportToDiff exports the blocks written to the cache, the cache stays in use.
+func (o *Overlay) ExportToDiff(out io.Writer) (*header.DiffMetadata, error) {
+	if o.cacheEjected.Load() {
+		return nil, fmt.Errorf("cache already ejected")
+	}
+
+	return o.cache.ExportToDiff(out)
+}
+
 // This method will not be very optimal if the length is not the same as the block size, because we cannot be just exposing the cache slice,
 // but creating and copying the bytes from the cache and device to the new slice.
 //
@@ -65,6 +75,11 @@ func (o *Overlay) WriteAt(p []byte, off int64) (int, error) {
 	return o.cache.WriteAt(p, off)
 }
 
+// Trim discards the range, the discarded blocks read as zeroes instead of falling through to the device.
+func (o *Overlay) Trim(off, length int64) error {
+	return o.cache.Trim(off, length)
+}
+
 func (o *Overlay) Size() (int64, error) {
 	return o.cache.Size()
 }
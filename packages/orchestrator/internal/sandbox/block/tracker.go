@@ -15,6 +15,7 @@ type TrackedSliceDevice struct {
 	blockSize int64
 
 	nilTracking atomic.Bool
+	tracking    atomic.Bool
 	dirty       *bitset.BitSet
 	dirtyMu     sync.Mutex
 	empty       []byte
@@ -43,6 +44,29 @@ func (t *TrackedSliceDevice) Disable() error {
 	return nil
 }
 
+// Track starts tracking the requested blocks like Disable, but the blocks are still served with their data.
+// It is used to snapshot a VM that keeps running after the snapshot.
+func (t *TrackedSliceDevice) Track() error {
+	size, err := t.data.Size()
+	if err != nil {
+		return fmt.Errorf("failed to get device size: %w", err)
+	}
+
+	t.dirtyMu.Lock()
+	t.dirty = bitset.New(uint(header.TotalBlocks(size, t.blockSize)))
+	t.dirty.FlipRange(0, t.dirty.Len())
+	t.dirtyMu.Unlock()
+
+	t.tracking.Store(true)
+
+	return nil
+}
+
+// Untrack stops the tracking started by Track, the dirty blocks stay available.
+func (t *TrackedSliceDevice) Untrack() {
+	t.tracking.Store(false)
+}
+
 func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 	if t.nilTracking.Load() {
 		t.dirtyMu.Lock()
@@ -52,6 +76,12 @@ func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 		return t.empty, nil
 	}
 
+	if t.tracking.Load() {
+		t.dirtyMu.Lock()
+		t.dirty.Clear(uint(header.BlockIdx(off, t.blockSize)))
+		t.dirtyMu.Unlock()
+	}
+
 	return t.data.Slice(off, length)
 }
 
@@ -63,3 +93,15 @@ func (t *TrackedSliceDevice) Dirty() *bitset.BitSet {
 
 	return t.dirty.Clone()
 }
+
+// Empty returns a zeroed block for a page the guest released (e.g. by inflating the balloon).
+// When the tracking is disabled the page is marked as not dirty, so the released pages are not included in the snapshot diff.
+func (t *TrackedSliceDevice) Empty(off int64) []byte {
+	if t.nilTracking.Load() || t.tracking.Load() {
+		t.dirtyMu.Lock()
+		t.dirty.Clear(uint(header.BlockIdx(off, t.blockSize)))
+		t.dirtyMu.Unlock()
+	}
+
+	return t.empty
+}
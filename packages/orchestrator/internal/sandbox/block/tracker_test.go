@@ -0,0 +1,70 @@
+package block
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+type memoryDevice struct {
+	data      []byte
+	blockSize int64
+}
+
+func (m *memoryDevice) ReadAt(p []byte, off int64) (int, error) {
+	return copy(p, m.data[off:]), nil
+}
+
+func (m *memoryDevice) Close() error {
+	return nil
+}
+
+func (m *memoryDevice) Slice(off, length int64) ([]byte, error) {
+	return m.data[off : off+length], nil
+}
+
+func (m *memoryDevice) Size() (int64, error) {
+	return int64(len(m.data)), nil
+}
+
+func (m *memoryDevice) BlockSize() int64 {
+	return m.blockSize
+}
+
+func (m *memoryDevice) Header() *header.Header {
+	return nil
+}
+
+func TestTrackedSliceDevice_TrackServesData(t *testing.T) {
+	blockSize := int64(header.PageSize)
+
+	device := &memoryDevice{
+		data:      bytes.Repeat([]byte{1}, int(4*blockSize)),
+		blockSize: blockSize,
+	}
+
+	tracked, err := NewTrackedSliceDevice(blockSize, device)
+	require.NoError(t, err)
+
+	err = tracked.Track()
+	require.NoError(t, err)
+
+	data, err := tracked.Slice(2*blockSize, blockSize)
+	require.NoError(t, err)
+	assert.Equal(t, device.data[:blockSize], data)
+
+	tracked.Untrack()
+
+	// Blocks requested after the tracking stopped stay dirty.
+	_, err = tracked.Slice(3*blockSize, blockSize)
+	require.NoError(t, err)
+
+	dirty := tracked.Dirty()
+	assert.Equal(t, uint(3), dirty.Count())
+	assert.False(t, dirty.Test(2))
+	assert.True(t, dirty.Test(3))
+}
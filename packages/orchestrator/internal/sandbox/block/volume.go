@@ -0,0 +1,464 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	// volumeSyncConcurrency limits the number of chunks uploaded in parallel when syncing the volume.
+	volumeSyncConcurrency = 8
+
+	// VolumeStateSuffix is the suffix of the file next to the volume cache that lists the chunks that were not synced yet.
+	// The cache is kept while the file exists, so the chunks can be uploaded after a crash by SyncCachedVolume.
+	VolumeStateSuffix = ".state"
+)
+
+// volumeState is the content of the volume state file.
+type volumeState struct {
+	VolumeID  string  `json:"volumeID"`
+	Size      int64   `json:"size"`
+	BlockSize int64   `json:"blockSize"`
+	Dirty     []int64 `json:"dirty"`
+}
+
+// Volume is a persistent device stored as ChunkSize objects in the storage.
+// Chunks are fetched to the local cache on first access and the written chunks are uploaded back by Sync.
+// Chunks that were never written (or contain only zeroes) don't have an object in the storage.
+type Volume struct {
+	ctx context.Context
+
+	storage  storage.StorageProvider
+	volumeID string
+
+	size      int64
+	blockSize int64
+
+	cache     *Cache
+	cachePath string
+
+	// stored contains the indexes of chunks that have an object in the storage when the volume was opened.
+	stored   map[int64]struct{}
+	fetchers *utils.WaitMap
+
+	// syncMu serializes the syncs, the chunks being uploaded are kept in syncing until the upload finishes.
+	syncMu  sync.Mutex
+	dirtyMu sync.Mutex
+	dirty   map[int64]struct{}
+	syncing map[int64]struct{}
+
+	// objects contains the indexes of chunks that currently have an object in the storage.
+	objectsMu sync.Mutex
+	objects   map[int64]struct{}
+}
+
+func NewVolume(
+	ctx context.Context,
+	s storage.StorageProvider,
+	volumeID string,
+	size,
+	blockSize int64,
+	cachePath string,
+) (*Volume, error) {
+	objects, err := s.ListObjectsWithPrefix(ctx, storage.VolumePrefix(volumeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume chunks: %w", err)
+	}
+
+	stored := make(map[int64]struct{}, len(objects))
+	current := make(map[int64]struct{}, len(objects))
+	for _, object := range objects {
+		idx, err := strconv.ParseInt(path.Base(object), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid volume chunk object %s: %w", object, err)
+		}
+
+		stored[idx] = struct{}{}
+		current[idx] = struct{}{}
+	}
+
+	// The cache is the source of truth for the fetched chunks, the fetchers track which chunks are in the cache.
+	cache, err := NewCache(size, blockSize, cachePath, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume cache: %w", err)
+	}
+
+	return &Volume{
+		ctx:       ctx,
+		storage:   s,
+		volumeID:  volumeID,
+		size:      size,
+		blockSize: blockSize,
+		cache:     cache,
+		cachePath: cachePath,
+		stored:    stored,
+		fetchers:  utils.NewWaitMap(),
+		dirty:     make(map[int64]struct{}),
+		syncing:   make(map[int64]struct{}),
+		objects:   current,
+	}, nil
+}
+
+// IsEmpty returns true if the volume didn't have any data stored when it was opened.
+func (v *Volume) IsEmpty() bool {
+	return len(v.stored) == 0
+}
+
+func (v *Volume) ReadAt(b []byte, off int64) (int, error) {
+	slice, err := v.Slice(off, int64(len(b)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to slice volume at %d-%d: %w", off, off+int64(len(b)), err)
+	}
+
+	return copy(b, slice), nil
+}
+
+func (v *Volume) WriteAt(b []byte, off int64) (int, error) {
+	length := int64(len(b))
+
+	err := v.fetchToCache(off, length)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := v.cache.WriteAt(b, off)
+	if err != nil {
+		return n, fmt.Errorf("failed to write to volume cache at %d-%d: %w", off, off+length, err)
+	}
+
+	err = v.markDirty(off, length)
+	if err != nil {
+		return n, err
+	}
+
+	return n, nil
+}
+
+// Trim discards the blocks fully covered by the range, see Cache.Trim.
+func (v *Volume) Trim(off, length int64) error {
+	err := v.fetchToCache(off, length)
+	if err != nil {
+		return err
+	}
+
+	err = v.cache.Trim(off, length)
+	if err != nil {
+		return fmt.Errorf("failed to trim volume cache at %d-%d: %w", off, off+length, err)
+	}
+
+	return v.markDirty(off, length)
+}
+
+func (v *Volume) Slice(off, length int64) ([]byte, error) {
+	err := v.fetchToCache(off, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.cache.Slice(off, length)
+}
+
+func (v *Volume) Size() (int64, error) {
+	return v.size, nil
+}
+
+func (v *Volume) BlockSize() int64 {
+	return v.blockSize
+}
+
+// Header returns nil, the volume is not backed by a build.
+func (v *Volume) Header() *header.Header {
+	return nil
+}
+
+// Sync uploads the chunks written since the last sync to the storage.
+func (v *Volume) Sync(ctx context.Context) error {
+	v.syncMu.Lock()
+	defer v.syncMu.Unlock()
+
+	v.dirtyMu.Lock()
+	chunks := v.dirty
+	v.dirty = make(map[int64]struct{})
+	v.syncing = chunks
+	v.dirtyMu.Unlock()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(volumeSyncConcurrency)
+
+	for idx := range chunks {
+		eg.Go(func() error {
+			return v.uploadChunk(ctx, idx)
+		})
+	}
+
+	err := eg.Wait()
+
+	v.dirtyMu.Lock()
+	defer v.dirtyMu.Unlock()
+
+	v.syncing = make(map[int64]struct{})
+
+	if err != nil {
+		// Keep the chunks dirty, so they are uploaded on the next sync.
+		for idx := range chunks {
+			v.dirty[idx] = struct{}{}
+		}
+
+		return fmt.Errorf("failed to sync volume %s: %w", v.volumeID, err)
+	}
+
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	return v.writeState()
+}
+
+// Close closes the volume without syncing it.
+// The local cache is removed only if all the written chunks were synced, otherwise it is kept, so the data is not lost.
+func (v *Volume) Close() error {
+	err := v.cache.Close()
+
+	v.dirtyMu.Lock()
+	synced := len(v.dirty) == 0
+	v.dirtyMu.Unlock()
+
+	if !synced {
+		return errors.Join(err, fmt.Errorf("volume %s has chunks that were not synced, keeping the cache %s", v.volumeID, v.cachePath))
+	}
+
+	return errors.Join(err, os.RemoveAll(v.cachePath), os.RemoveAll(v.cachePath+VolumeStateSuffix))
+}
+
+// SyncCachedVolume uploads the chunks that were not synced from the volume cache left behind by a stopped or crashed orchestrator.
+// The cache is removed once all the chunks are uploaded, a cache without the state file has nothing to upload.
+func SyncCachedVolume(ctx context.Context, s storage.StorageProvider, cachePath string) error {
+	data, err := os.ReadFile(cachePath + VolumeStateSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return os.RemoveAll(cachePath)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read volume state: %w", err)
+	}
+
+	var state volumeState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return fmt.Errorf("failed to parse volume state: %w", err)
+	}
+
+	v, err := NewVolume(ctx, s, state.VolumeID, state.Size, state.BlockSize, cachePath)
+	if err != nil {
+		return fmt.Errorf("failed to open cached volume %s: %w", state.VolumeID, err)
+	}
+
+	for _, idx := range state.Dirty {
+		v.dirty[idx] = struct{}{}
+	}
+
+	return errors.Join(v.Sync(ctx), v.Close())
+}
+
+func (v *Volume) uploadChunk(ctx context.Context, idx int64) error {
+	off := header.BlockOffset(idx, ChunkSize)
+
+	data, err := v.cache.Slice(off, min(ChunkSize, v.size-off))
+	if err != nil {
+		return fmt.Errorf("failed to read chunk %d from cache: %w", idx, err)
+	}
+
+	object, err := v.storage.OpenObject(ctx, storage.VolumeChunkPath(v.volumeID, idx))
+	if err != nil {
+		return fmt.Errorf("failed to open chunk %d object: %w", idx, err)
+	}
+
+	if isZero(data) {
+		if !v.hasObject(idx) {
+			return nil
+		}
+
+		err = object.Delete()
+		if err != nil && !errors.Is(err, storage.ErrorObjectNotExist) {
+			return fmt.Errorf("failed to delete empty chunk %d: %w", idx, err)
+		}
+
+		v.setHasObject(idx, false)
+
+		return nil
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to upload chunk %d: %w", idx, err)
+	}
+
+	v.setHasObject(idx, true)
+
+	return nil
+}
+
+func (v *Volume) hasObject(idx int64) bool {
+	v.objectsMu.Lock()
+	defer v.objectsMu.Unlock()
+
+	_, ok := v.objects[idx]
+
+	return ok
+}
+
+func (v *Volume) setHasObject(idx int64, ok bool) {
+	v.objectsMu.Lock()
+	defer v.objectsMu.Unlock()
+
+	if ok {
+		v.objects[idx] = struct{}{}
+	} else {
+		delete(v.objects, idx)
+	}
+}
+
+// markDirty marks the chunks covering the range to be uploaded by the next sync.
+// The state file is updated before the write is acknowledged, so the chunk is not lost if the orchestrator crashes.
+func (v *Volume) markDirty(off, length int64) error {
+	v.dirtyMu.Lock()
+	defer v.dirtyMu.Unlock()
+
+	added := false
+	for idx := header.BlockIdx(off, ChunkSize); idx*ChunkSize < off+length; idx++ {
+		if _, ok := v.dirty[idx]; !ok {
+			v.dirty[idx] = struct{}{}
+			added = true
+		}
+	}
+
+	if !added {
+		return nil
+	}
+
+	return v.writeState()
+}
+
+// writeState writes the chunks that are dirty or being synced to the state file, dirtyMu must be held.
+func (v *Volume) writeState() error {
+	state := volumeState{
+		VolumeID:  v.volumeID,
+		Size:      v.size,
+		BlockSize: v.blockSize,
+		Dirty:     make([]int64, 0, len(v.dirty)+len(v.syncing)),
+	}
+
+	for idx := range v.dirty {
+		state.Dirty = append(state.Dirty, idx)
+	}
+
+	for idx := range v.syncing {
+		if _, ok := v.dirty[idx]; !ok {
+			state.Dirty = append(state.Dirty, idx)
+		}
+	}
+
+	slices.Sort(state.Dirty)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal volume state: %w", err)
+	}
+
+	// The state is renamed to its path, so a crash never leaves a partially written state.
+	path := v.cachePath + VolumeStateSuffix
+
+	err = os.WriteFile(path+".tmp", data, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write volume state: %w", err)
+	}
+
+	err = os.Rename(path+".tmp", path)
+	if err != nil {
+		return fmt.Errorf("failed to rename volume state: %w", err)
+	}
+
+	return nil
+}
+
+// fetchToCache ensures that the chunks covering the range are in the cache.
+func (v *Volume) fetchToCache(off, length int64) error {
+	if off < 0 || off+length > v.size {
+		return fmt.Errorf("range %d-%d is out of the volume bounds (%d)", off, off+length, v.size)
+	}
+
+	var eg errgroup.Group
+
+	for idx := header.BlockIdx(off, ChunkSize); idx*ChunkSize < off+length; idx++ {
+		if _, ok := v.stored[idx]; !ok {
+			// The chunk was never written, the cache is already zeroed.
+			continue
+		}
+
+		eg.Go(func() error {
+			return v.fetchers.Wait(idx, func() error {
+				return v.fetchChunk(idx)
+			})
+		})
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		return fmt.Errorf("failed to ensure volume data at %d-%d: %w", off, off+length, err)
+	}
+
+	return nil
+}
+
+func (v *Volume) fetchChunk(idx int64) error {
+	select {
+	case <-v.ctx.Done():
+		return fmt.Errorf("error fetching chunk %d: %w", idx, v.ctx.Err())
+	default:
+	}
+
+	object, err := v.storage.OpenObject(v.ctx, storage.VolumeChunkPath(v.volumeID, idx))
+	if err != nil {
+		return fmt.Errorf("failed to open chunk %d object: %w", idx, err)
+	}
+
+	off := header.BlockOffset(idx, ChunkSize)
+	b := make([]byte, min(ChunkSize, v.size-off))
+
+	_, err = object.ReadAt(b, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read chunk %d: %w", idx, err)
+	}
+
+	_, err = v.cache.WriteAtWithoutLock(b, off)
+	if err != nil {
+		return fmt.Errorf("failed to write chunk %d to cache: %w", idx, err)
+	}
+
+	return nil
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,149 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestVolume_SyncPersistsWrittenChunks(t *testing.T) {
+	ctx := context.Background()
+	blockSize := int64(header.RootfsBlockSize)
+	size := int64(3 * ChunkSize)
+
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	volume, err := NewVolume(ctx, s, "volume-id", size, blockSize, filepath.Join(t.TempDir(), "first"))
+	require.NoError(t, err)
+	assert.True(t, volume.IsEmpty())
+
+	data := bytes.Repeat([]byte{1}, int(blockSize))
+	_, err = volume.WriteAt(data, ChunkSize+blockSize)
+	require.NoError(t, err)
+
+	// Writing zeroes to a chunk that is not stored does not create an object.
+	_, err = volume.WriteAt(make([]byte, blockSize), 0)
+	require.NoError(t, err)
+
+	require.NoError(t, volume.Sync(ctx))
+	require.NoError(t, volume.Close())
+
+	objects, err := s.ListObjectsWithPrefix(ctx, storage.VolumePrefix("volume-id"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{storage.VolumeChunkPath("volume-id", 1)}, objects)
+
+	reopened, err := NewVolume(ctx, s, "volume-id", size, blockSize, filepath.Join(t.TempDir(), "second"))
+	require.NoError(t, err)
+	defer reopened.Close()
+	assert.False(t, reopened.IsEmpty())
+
+	read := make([]byte, 2*blockSize)
+	_, err = reopened.ReadAt(read, ChunkSize)
+	require.NoError(t, err)
+	assert.Equal(t, append(make([]byte, blockSize), data...), read)
+
+	// Trimming the whole chunk removes its object on the next sync.
+	require.NoError(t, reopened.Trim(ChunkSize, ChunkSize))
+	require.NoError(t, reopened.Sync(ctx))
+
+	objects, err = s.ListObjectsWithPrefix(ctx, storage.VolumePrefix("volume-id"))
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestVolume_OutOfBounds(t *testing.T) {
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	volume, err := NewVolume(context.Background(), s, "volume-id", ChunkSize, header.RootfsBlockSize, filepath.Join(t.TempDir(), "cache"))
+	require.NoError(t, err)
+	defer volume.Close()
+
+	_, err = volume.WriteAt(make([]byte, header.RootfsBlockSize), ChunkSize)
+	require.Error(t, err)
+}
+
+// unavailableStorage fails to open any object, like a storage that can't be reached.
+type unavailableStorage struct {
+	storage.StorageProvider
+}
+
+func (s unavailableStorage) OpenObject(context.Context, string) (storage.StorageObjectProvider, error) {
+	return nil, errors.New("storage unavailable")
+}
+
+func TestVolume_CloseKeepsCacheOfUnsyncedChunks(t *testing.T) {
+	ctx := context.Background()
+	blockSize := int64(header.RootfsBlockSize)
+
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	cachePath := filepath.Join(t.TempDir(), "cache")
+
+	volume, err := NewVolume(ctx, unavailableStorage{s}, "volume-id", ChunkSize, blockSize, cachePath)
+	require.NoError(t, err)
+
+	_, err = volume.WriteAt(bytes.Repeat([]byte{1}, int(blockSize)), 0)
+	require.NoError(t, err)
+
+	require.Error(t, volume.Sync(ctx))
+	require.Error(t, volume.Close())
+	assert.FileExists(t, cachePath)
+
+	// The cache is removed once all the chunks are synced.
+	synced, err := NewVolume(ctx, s, "volume-id", ChunkSize, blockSize, filepath.Join(t.TempDir(), "cache"))
+	require.NoError(t, err)
+
+	_, err = synced.WriteAt(bytes.Repeat([]byte{1}, int(blockSize)), 0)
+	require.NoError(t, err)
+
+	require.NoError(t, synced.Sync(ctx))
+	require.NoError(t, synced.Close())
+	assert.NoFileExists(t, synced.cachePath)
+}
+
+func TestVolume_SyncCachedVolumeUploadsUnsyncedChunks(t *testing.T) {
+	ctx := context.Background()
+	blockSize := int64(header.RootfsBlockSize)
+
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	cachePath := filepath.Join(t.TempDir(), "cache")
+
+	// The orchestrator stops before the written chunk is synced.
+	volume, err := NewVolume(ctx, s, "volume-id", 2*ChunkSize, blockSize, cachePath)
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte{1}, int(blockSize))
+	_, err = volume.WriteAt(data, ChunkSize)
+	require.NoError(t, err)
+	require.NoError(t, volume.cache.Close())
+
+	require.NoError(t, SyncCachedVolume(ctx, s, cachePath))
+	assert.NoFileExists(t, cachePath)
+	assert.NoFileExists(t, cachePath+VolumeStateSuffix)
+
+	objects, err := s.ListObjectsWithPrefix(ctx, storage.VolumePrefix("volume-id"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{storage.VolumeChunkPath("volume-id", 1)}, objects)
+
+	reopened, err := NewVolume(ctx, s, "volume-id", 2*ChunkSize, blockSize, filepath.Join(t.TempDir(), "cache"))
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	read := make([]byte, blockSize)
+	_, err = reopened.ReadAt(read, ChunkSize)
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+}
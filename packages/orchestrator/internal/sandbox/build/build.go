@@ -125,6 +125,7 @@ func (b *File) getBuild(buildID *uuid.UUID) (Diff, error) {
 		b.fileType,
 		int64(b.header.Metadata.BlockSize),
 		b.persistence,
+		b.store.chunks,
 	)
 
 	source, err := b.store.Get(storageDiff)
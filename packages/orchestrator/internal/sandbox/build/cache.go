@@ -10,6 +10,8 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/chunkcache"
 )
 
 const (
@@ -26,6 +28,7 @@ type deleteDiff struct {
 
 type DiffStore struct {
 	cachePath string
+	chunks    *chunkcache.Cache
 	cache     *ttlcache.Cache[DiffStoreKey, Diff]
 	ctx       context.Context
 	close     chan struct{}
@@ -37,7 +40,8 @@ type DiffStore struct {
 	pdDelay time.Duration
 }
 
-func NewDiffStore(ctx context.Context, cachePath string, ttl, delay time.Duration, maxUsedPercentage float64) (*DiffStore, error) {
+// NewDiffStore creates a store for the diffs of the builds, the chunks fetched from the storage are also kept in the optional chunk cache.
+func NewDiffStore(ctx context.Context, cachePath string, ttl, delay time.Duration, maxUsedPercentage float64, chunks *chunkcache.Cache) (*DiffStore, error) {
 	err := os.MkdirAll(cachePath, 0o755)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cache directory: %w", err)
@@ -49,6 +53,7 @@ func NewDiffStore(ctx context.Context, cachePath string, ttl, delay time.Duratio
 
 	ds := &DiffStore{
 		cachePath: cachePath,
+		chunks:    chunks,
 		cache:     cache,
 		ctx:       ctx,
 		close:     make(chan struct{}),
@@ -79,9 +84,11 @@ func GetDiffStoreKey(buildID string, diffType DiffType) DiffStoreKey {
 	return DiffStoreKey(fmt.Sprintf("%s/%s", buildID, diffType))
 }
 
+// Close stops the store and closes all cached diffs.
 func (s *DiffStore) Close() {
 	close(s.close)
 	s.cache.Stop()
+	s.cache.DeleteAll()
 }
 
 func (s *DiffStore) Get(diff Diff) (Diff, error) {
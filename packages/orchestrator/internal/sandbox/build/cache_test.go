@@ -54,6 +54,7 @@ func TestNewDiffStore(t *testing.T) {
 		25*time.Hour,
 		60*time.Second,
 		90.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 
@@ -74,6 +75,7 @@ func TestDiffStoreTTLEviction(t *testing.T) {
 		ttl,
 		delay,
 		100.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 	assert.NoError(t, err)
@@ -104,6 +106,7 @@ func TestDiffStoreRefreshTTLEviction(t *testing.T) {
 		ttl,
 		delay,
 		100.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 	assert.NoError(t, err)
@@ -140,6 +143,7 @@ func TestDiffStoreDelayEviction(t *testing.T) {
 		ttl,
 		delay,
 		0.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 	assert.NoError(t, err)
@@ -181,6 +185,7 @@ func TestDiffStoreDelayEvictionAbort(t *testing.T) {
 		ttl,
 		delay,
 		0.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 	assert.NoError(t, err)
@@ -229,6 +234,7 @@ func TestDiffStoreOldestFromCache(t *testing.T) {
 		ttl,
 		delay,
 		100.0,
+		nil,
 	)
 	t.Cleanup(store.Close)
 	assert.NoError(t, err)
@@ -2,13 +2,16 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/chunkcache"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	storage "github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
@@ -23,6 +26,9 @@ type StorageDiff struct {
 	storagePath string
 	blockSize   int64
 	persistence storage.StorageProvider
+	chunks      *chunkcache.Cache
+	buildId     string
+	diffType    DiffType
 }
 
 func newStorageDiff(
@@ -31,6 +37,7 @@ func newStorageDiff(
 	diffType DiffType,
 	blockSize int64,
 	persistence storage.StorageProvider,
+	chunks *chunkcache.Cache,
 ) *StorageDiff {
 	cachePathSuffix := id.Generate()
 
@@ -44,6 +51,9 @@ func newStorageDiff(
 		chunker:     utils.NewSetOnce[*block.Chunker](),
 		blockSize:   blockSize,
 		persistence: persistence,
+		chunks:      chunks,
+		buildId:     buildId,
+		diffType:    diffType,
 		cacheKey:    GetDiffStoreKey(buildId, diffType),
 	}
 }
@@ -65,7 +75,22 @@ func (b *StorageDiff) Init(ctx context.Context) error {
 		return errMsg
 	}
 
-	chunker, err := block.NewChunker(ctx, size, b.blockSize, obj, b.cachePath)
+	storageMetadata, err := b.storageMetadata(ctx)
+	if err != nil {
+		errMsg := fmt.Errorf("failed to get storage metadata: %w", err)
+		b.chunker.SetError(errMsg)
+		return errMsg
+	}
+
+	var base io.ReaderAt = obj
+	if storageMetadata != nil {
+		framed := block.NewFramedReader(obj, storageMetadata, b.storagePath)
+
+		base = framed
+		size = framed.Size()
+	}
+
+	chunker, err := block.NewChunker(ctx, size, b.blockSize, b.chunks.Reader(b.buildId, string(b.diffType), base), b.cachePath)
 	if err != nil {
 		errMsg := fmt.Errorf("failed to create chunker: %w", err)
 		b.chunker.SetError(errMsg)
@@ -75,6 +100,26 @@ func (b *StorageDiff) Init(ctx context.Context) error {
 	return b.chunker.SetValue(chunker)
 }
 
+// storageMetadata returns how the data object is stored, it is nil for raw data objects.
+func (b *StorageDiff) storageMetadata(ctx context.Context) (*header.StorageMetadata, error) {
+	obj, err := b.persistence.OpenObject(ctx, b.storagePath+storage.HeaderSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := header.Deserialize(obj)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		// Builds without a header are stored raw.
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Storage, nil
+}
+
 func (b *StorageDiff) Close() error {
 	c, err := b.chunker.Wait()
 	if err != nil {
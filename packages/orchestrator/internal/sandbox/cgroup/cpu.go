@@ -0,0 +1,234 @@
+package cgroup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// sandboxesGroup is the cgroup the sandbox groups are created in, it only delegates the CPU controller.
+	sandboxesGroup = "e2b-sandboxes"
+
+	// orchestratorGroup is the leaf the orchestrator's processes are moved to, a cgroup with processes can't enable controllers for its children.
+	orchestratorGroup = "orchestrator"
+
+	// cpuPeriodUs is the period the CPU quota is enforced in, one vCPU gets the whole period.
+	cpuPeriodUs = 100_000
+
+	removeTimeout      = 2 * time.Second
+	removePollInterval = 50 * time.Millisecond
+)
+
+var (
+	// root is the mount point of the cgroup v2 hierarchy.
+	root = "/sys/fs/cgroup"
+	// selfCgroup lists the cgroup of the orchestrator, the sandbox groups are created within it.
+	selfCgroup = "/proc/self/cgroup"
+
+	parentMu sync.Mutex
+	// parent is the cgroup the sandbox groups are created in, it is set up on the first use.
+	parent string
+)
+
+// CPU limits the CPU time of the processes in the sandbox's cgroup through the cgroup v2 CPU controller.
+type CPU struct {
+	path string
+}
+
+// NewCPU creates the cgroup for the sandbox, the processes are moved to it by Add.
+func NewCPU(sandboxID string) (*CPU, error) {
+	parent, err := sandboxesParent()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(parent, sandboxID)
+
+	err = os.Mkdir(path, 0o755)
+	if err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("failed to create cgroup '%s': %w", path, err)
+	}
+
+	return &CPU{path: path}, nil
+}
+
+// sandboxesParent sets up the sandboxes group within the orchestrator's own cgroup, the groups above it are left untouched.
+func sandboxesParent() (string, error) {
+	parentMu.Lock()
+	defer parentMu.Unlock()
+
+	if parent != "" {
+		return parent, nil
+	}
+
+	own, err := ownCgroup()
+	if err != nil {
+		return "", err
+	}
+
+	controllers, err := os.ReadFile(filepath.Join(own, "cgroup.controllers"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read controllers of cgroup '%s': %w", own, err)
+	}
+
+	if !slices.Contains(strings.Fields(string(controllers)), "cpu") {
+		return "", fmt.Errorf("cpu controller is not delegated to cgroup '%s'", own)
+	}
+
+	// The root cgroup is the only one that can have processes and enable controllers for its children.
+	if own != root {
+		err = moveOwnProcesses(own)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	group := filepath.Join(own, sandboxesGroup)
+
+	err = os.MkdirAll(group, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cgroup '%s': %w", group, err)
+	}
+
+	// The controller has to be enabled on every level above the group that uses it.
+	for _, dir := range []string{own, group} {
+		err = write(filepath.Join(dir, "cgroup.subtree_control"), "+cpu")
+		if err != nil {
+			return "", fmt.Errorf("failed to enable cpu controller in '%s': %w", dir, err)
+		}
+	}
+
+	parent = group
+
+	return parent, nil
+}
+
+// ownCgroup returns the path of the orchestrator's cgroup in the cgroup v2 hierarchy.
+func ownCgroup() (string, error) {
+	data, err := os.ReadFile(selfCgroup)
+	if err != nil {
+		return "", fmt.Errorf("failed to read cgroup of the orchestrator: %w", err)
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		// The cgroup v2 hierarchy has the ID 0 and no controllers listed.
+		path, ok := strings.CutPrefix(line, "0::")
+		if ok {
+			return filepath.Join(root, path), nil
+		}
+	}
+
+	return "", fmt.Errorf("orchestrator is not in a cgroup v2 hierarchy")
+}
+
+// moveOwnProcesses moves the orchestrator and its descendants from its cgroup to a leaf within it.
+// The processes of other services sharing the cgroup are not moved, enabling the controller fails then.
+func moveOwnProcesses(own string) error {
+	leaf := filepath.Join(own, orchestratorGroup)
+
+	err := os.Mkdir(leaf, 0o755)
+	if err != nil && !errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("failed to create cgroup '%s': %w", leaf, err)
+	}
+
+	return (&CPU{path: leaf}).Add(os.Getpid())
+}
+
+// Add moves the process and all its descendants to the cgroup, the processes they start later stay in it too.
+func (c *CPU) Add(pid int) error {
+	pids, err := descendants(pid)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range append([]int{pid}, pids...) {
+		err = write(filepath.Join(c.path, "cgroup.procs"), strconv.Itoa(p))
+		if err != nil {
+			return fmt.Errorf("failed to move process %d to cgroup: %w", p, err)
+		}
+	}
+
+	return nil
+}
+
+// SetLimit limits the processes to the CPU time of the number of vCPUs, 0 removes the limit.
+func (c *CPU) SetLimit(vcpu int64) error {
+	limit := "max"
+	if vcpu > 0 {
+		limit = strconv.FormatInt(vcpu*cpuPeriodUs, 10)
+	}
+
+	err := write(filepath.Join(c.path, "cpu.max"), fmt.Sprintf("%s %d", limit, cpuPeriodUs))
+	if err != nil {
+		return fmt.Errorf("failed to set cpu limit: %w", err)
+	}
+
+	return nil
+}
+
+// Remove deletes the cgroup, it waits for the killed processes in it to exit.
+func (c *CPU) Remove() error {
+	deadline := time.Now().Add(removeTimeout)
+
+	for {
+		err := os.Remove(c.path)
+		if err == nil || errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		if !errors.Is(err, syscall.EBUSY) || time.Now().After(deadline) {
+			return fmt.Errorf("failed to remove cgroup '%s': %w", c.path, err)
+		}
+
+		time.Sleep(removePollInterval)
+	}
+}
+
+// descendants returns the processes started by the process, recursively.
+func descendants(pid int) ([]int, error) {
+	tasks, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
+	if errors.Is(err, os.ErrNotExist) {
+		// The process exited.
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to list threads of process %d: %w", pid, err)
+	}
+
+	var pids []int
+	for _, task := range tasks {
+		data, err := os.ReadFile(fmt.Sprintf("/proc/%d/task/%s/children", pid, task.Name()))
+		if err != nil {
+			// The thread exited.
+			continue
+		}
+
+		for _, field := range strings.Fields(string(data)) {
+			child, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse child of process %d: %w", pid, err)
+			}
+
+			children, err := descendants(child)
+			if err != nil {
+				return nil, err
+			}
+
+			pids = append(pids, child)
+			pids = append(pids, children...)
+		}
+	}
+
+	return pids, nil
+}
+
+func write(path string, value string) error {
+	return os.WriteFile(path, []byte(value), 0o644)
+}
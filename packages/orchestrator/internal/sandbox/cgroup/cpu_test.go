@@ -0,0 +1,95 @@
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRoot points the hierarchy to a temporary directory, the orchestrator is in the cgroup at the path.
+func fakeRoot(t *testing.T, path string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	self := filepath.Join(t.TempDir(), "cgroup")
+	require.NoError(t, os.WriteFile(self, []byte("0::"+path+"\n"), 0o644))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, path), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, path, "cgroup.controllers"), []byte("cpu memory pids"), 0o644))
+
+	originalRoot, originalSelf := root, selfCgroup
+	root, selfCgroup, parent = dir, self, ""
+	t.Cleanup(func() { root, selfCgroup, parent = originalRoot, originalSelf, "" })
+
+	return dir
+}
+
+func TestCPU_SetLimit(t *testing.T) {
+	dir := fakeRoot(t, "/")
+
+	cpu, err := NewCPU("sandbox")
+	require.NoError(t, err)
+
+	control, err := os.ReadFile(filepath.Join(dir, sandboxesGroup, "cgroup.subtree_control"))
+	require.NoError(t, err)
+	assert.Equal(t, "+cpu", string(control))
+
+	require.NoError(t, cpu.SetLimit(2))
+
+	limit, err := os.ReadFile(filepath.Join(dir, sandboxesGroup, "sandbox", "cpu.max"))
+	require.NoError(t, err)
+	assert.Equal(t, "200000 100000", string(limit))
+
+	require.NoError(t, cpu.SetLimit(0))
+
+	limit, err = os.ReadFile(filepath.Join(dir, sandboxesGroup, "sandbox", "cpu.max"))
+	require.NoError(t, err)
+	assert.Equal(t, "max 100000", string(limit))
+}
+
+func TestCPU_OwnCgroup(t *testing.T) {
+	dir := fakeRoot(t, "/system.slice/orchestrator.service")
+	own := filepath.Join(dir, "system.slice", "orchestrator.service")
+
+	_, err := NewCPU("sandbox")
+	require.NoError(t, err)
+
+	assert.DirExists(t, filepath.Join(own, sandboxesGroup, "sandbox"))
+
+	// The orchestrator is moved out of its cgroup so the controller can be enabled for the children.
+	procs, err := os.ReadFile(filepath.Join(own, orchestratorGroup, "cgroup.procs"))
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(os.Getpid()), string(procs))
+
+	control, err := os.ReadFile(filepath.Join(own, "cgroup.subtree_control"))
+	require.NoError(t, err)
+	assert.Equal(t, "+cpu", string(control))
+
+	// The groups above the orchestrator's own are left untouched.
+	assert.NoFileExists(t, filepath.Join(dir, "cgroup.subtree_control"))
+	assert.NoFileExists(t, filepath.Join(dir, "system.slice", "cgroup.subtree_control"))
+}
+
+func TestCPU_NotDelegated(t *testing.T) {
+	dir := fakeRoot(t, "/system.slice/orchestrator.service")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "system.slice", "orchestrator.service", "cgroup.controllers"), []byte("memory pids"), 0o644))
+
+	_, err := NewCPU("sandbox")
+	require.ErrorContains(t, err, "cpu controller is not delegated")
+}
+
+func TestCPU_Descendants(t *testing.T) {
+	// The test process doesn't start any children.
+	pids, err := descendants(os.Getpid())
+	require.NoError(t, err)
+	assert.Empty(t, pids)
+
+	pids, err = descendants(-1)
+	require.NoError(t, err)
+	assert.Empty(t, pids)
+}
@@ -0,0 +1,345 @@
+package chunkcache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	DefaultPath = "/orchestrator/chunks"
+
+	// tmpSuffix marks chunks that are still being written, they are removed when the cache is loaded.
+	tmpSuffix = ".tmp"
+)
+
+type entry struct {
+	key  string
+	size int64
+}
+
+// Cache is a node-wide cache of chunks fetched from the storage, stored as files on the local disk.
+// Chunks are keyed by the build, the diff type and the chunk offset, so they can be shared by all templates on the node
+// and survive the template cache eviction and the orchestrator restarts.
+// When the cache grows over its maximum size, the least recently used chunks are evicted.
+type Cache struct {
+	path    string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+
+	hits      metric.Int64Counter
+	misses    metric.Int64Counter
+	evictions metric.Int64Counter
+}
+
+func New(path string, maxSize int64, meterProvider metric.MeterProvider) (*Cache, error) {
+	meter := meterProvider.Meter("orchestrator.chunk.cache")
+
+	hits, err := telemetry.GetCounter(meter, telemetry.ChunkCacheHitsCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hits counter: %w", err)
+	}
+
+	misses, err := telemetry.GetCounter(meter, telemetry.ChunkCacheMissesCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create misses counter: %w", err)
+	}
+
+	evictions, err := telemetry.GetCounter(meter, telemetry.ChunkCacheEvictionsCounterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create evictions counter: %w", err)
+	}
+
+	c := &Cache{
+		path:      path,
+		maxSize:   maxSize,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+		hits:      hits,
+		misses:    misses,
+		evictions: evictions,
+	}
+
+	err = c.load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chunk cache: %w", err)
+	}
+
+	return c, nil
+}
+
+func chunkKey(buildID, diffType string, off int64) string {
+	return filepath.Join(buildID, diffType, strconv.FormatInt(off, 10))
+}
+
+// load indexes the chunks left on the disk by the previous runs.
+// The access order is tracked only in memory, the chunks are ordered by the time they were cached instead.
+func (c *Cache) load() error {
+	err := os.MkdirAll(c.path, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	type found struct {
+		entry
+		accessed time.Time
+	}
+
+	var chunks []found
+
+	err = filepath.WalkDir(c.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if filepath.Ext(path) == tmpSuffix {
+			return os.Remove(path)
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		key, err := filepath.Rel(c.path, path)
+		if err != nil {
+			return err
+		}
+
+		chunks = append(chunks, found{
+			entry:    entry{key: key, size: info.Size()},
+			accessed: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].accessed.After(chunks[j].accessed)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, chunk := range chunks {
+		c.entries[chunk.key] = c.lru.PushBack(&chunk.entry)
+		c.size += chunk.size
+	}
+
+	c.evict(context.Background())
+
+	zap.L().Info("chunk cache loaded", zap.String("path", c.path), zap.Int("chunks", len(chunks)), zap.Int64("size", c.size))
+
+	return nil
+}
+
+// get reads the cached chunk into the buffer, it returns false if the chunk is not cached.
+func (c *Cache) get(key string, b []byte) (int, bool) {
+	f, ok := c.open(key)
+	if !ok {
+		return 0, false
+	}
+	defer f.Close()
+
+	// The opened file stays readable even if the chunk is evicted in the meantime.
+	n, err := io.ReadFull(f, b)
+	if err != nil {
+		zap.L().Warn("failed to read cached chunk", zap.String("chunk", key), zap.Error(err))
+		c.remove(key)
+
+		return 0, false
+	}
+
+	return n, true
+}
+
+// open opens the cached chunk and marks it as the most recently used.
+// The chunk is opened under the lock, so it cannot be evicted between the lookup and the open.
+func (c *Cache) open(key string) (*os.File, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	f, err := os.Open(filepath.Join(c.path, key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			zap.L().Warn("failed to open cached chunk", zap.String("chunk", key), zap.Error(err))
+		}
+
+		c.removeElement(element)
+
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+
+	return f, true
+}
+
+func (c *Cache) put(ctx context.Context, key string, b []byte) error {
+	path := filepath.Join(c.path, key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create chunk directory: %w", err)
+	}
+
+	// Write to a temporary file first, so a crash never leaves a partial chunk in the cache.
+	tmpPath := path + tmpSuffix
+
+	err = os.WriteFile(tmpPath, b, 0o644)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to write chunk: %w", err), os.Remove(tmpPath))
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to rename chunk: %w", err), os.Remove(tmpPath))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(element)
+
+		return nil
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry{key: key, size: int64(len(b))})
+	c.size += int64(len(b))
+
+	c.evict(ctx)
+
+	return nil
+}
+
+func (c *Cache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	c.removeElement(element)
+}
+
+func (c *Cache) removeElement(element *list.Element) {
+	e := element.Value.(*entry)
+
+	c.lru.Remove(element)
+	delete(c.entries, e.key)
+	c.size -= e.size
+
+	err := os.Remove(filepath.Join(c.path, e.key))
+	if err != nil && !os.IsNotExist(err) {
+		zap.L().Warn("failed to remove cached chunk", zap.String("chunk", e.key), zap.Error(err))
+	}
+}
+
+// evict removes the least recently used chunks until the cache fits into its maximum size.
+// The caller must hold the lock.
+func (c *Cache) evict(ctx context.Context) {
+	for c.size > c.maxSize {
+		element := c.lru.Back()
+		if element == nil {
+			return
+		}
+
+		c.removeElement(element)
+		c.evictions.Add(ctx, 1)
+	}
+}
+
+// Size returns the size of the cached chunks.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// Reader returns a reader that serves the chunks of the build's diff from the cache and caches the chunks read from the base.
+// The cache is nil-safe, without a cache the base is returned.
+func (c *Cache) Reader(buildID, diffType string, base io.ReaderAt) io.ReaderAt {
+	if c == nil {
+		return base
+	}
+
+	return &reader{
+		cache:    c,
+		base:     base,
+		buildID:  buildID,
+		diffType: diffType,
+	}
+}
+
+type reader struct {
+	cache    *Cache
+	base     io.ReaderAt
+	buildID  string
+	diffType string
+}
+
+func (r *reader) ReadAt(b []byte, off int64) (int, error) {
+	ctx := context.Background()
+	attrs := metric.WithAttributes(attribute.String("diff_type", r.diffType))
+
+	key := chunkKey(r.buildID, r.diffType, off)
+
+	n, ok := r.cache.get(key, b)
+	if ok {
+		r.cache.hits.Add(ctx, 1, attrs)
+
+		return n, nil
+	}
+
+	r.cache.misses.Add(ctx, 1, attrs)
+
+	n, err := r.base.ReadAt(b, off)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return n, err
+	}
+
+	// Only full chunks are cached, the last chunk of an object can still grow when the build is compacted.
+	if n == len(b) {
+		putErr := r.cache.put(ctx, key, b)
+		if putErr != nil {
+			zap.L().Warn("failed to cache chunk", zap.String("chunk", key), zap.Error(putErr))
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,196 @@
+package chunkcache
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+const chunkSize = 1024
+
+type countingReader struct {
+	data  []byte
+	reads int
+}
+
+func (r *countingReader) ReadAt(b []byte, off int64) (int, error) {
+	r.reads++
+
+	n := copy(b, r.data[off:])
+	if n < len(b) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+func newData(chunks int) []byte {
+	data := make([]byte, 0, chunks*chunkSize)
+	for i := range chunks {
+		data = append(data, bytes.Repeat([]byte{byte(i + 1)}, chunkSize)...)
+	}
+
+	return data
+}
+
+func TestCache_ServesCachedChunks(t *testing.T) {
+	cache, err := New(t.TempDir(), 10*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	base := &countingReader{data: newData(2)}
+	r := cache.Reader("build", "memfile", base)
+
+	for range 2 {
+		b := make([]byte, chunkSize)
+
+		n, err := r.ReadAt(b, chunkSize)
+		require.NoError(t, err)
+		assert.Equal(t, chunkSize, n)
+		assert.Equal(t, base.data[chunkSize:], b)
+	}
+
+	assert.Equal(t, 1, base.reads)
+
+	// The same offset of another diff type is a different chunk.
+	other := &countingReader{data: newData(2)}
+	_, err = cache.Reader("build", "rootfs.ext4", other).ReadAt(make([]byte, chunkSize), chunkSize)
+	require.NoError(t, err)
+	assert.Equal(t, 1, other.reads)
+}
+
+func TestCache_DoesNotCachePartialChunks(t *testing.T) {
+	cache, err := New(t.TempDir(), 10*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	base := &countingReader{data: newData(1)[:chunkSize/2]}
+	r := cache.Reader("build", "memfile", base)
+
+	for range 2 {
+		n, err := r.ReadAt(make([]byte, chunkSize), 0)
+		require.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, chunkSize/2, n)
+	}
+
+	assert.Equal(t, 2, base.reads)
+	assert.Equal(t, int64(0), cache.Size())
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache, err := New(t.TempDir(), 2*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	base := &countingReader{data: newData(3)}
+	r := cache.Reader("build", "memfile", base)
+
+	read := func(chunk int) {
+		_, err := r.ReadAt(make([]byte, chunkSize), int64(chunk*chunkSize))
+		require.NoError(t, err)
+	}
+
+	read(0)
+	read(1)
+	read(0)
+	// Evicts chunk 1, which was used least recently.
+	read(2)
+	require.Equal(t, 3, base.reads)
+	assert.Equal(t, int64(2*chunkSize), cache.Size())
+
+	read(0)
+	read(2)
+	require.Equal(t, 3, base.reads)
+
+	read(1)
+	require.Equal(t, 4, base.reads)
+}
+
+func TestCache_SurvivesRestart(t *testing.T) {
+	path := t.TempDir()
+
+	cache, err := New(path, 2*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	base := &countingReader{data: newData(2)}
+
+	for chunk := range 2 {
+		_, err = cache.Reader("build", "memfile", base).ReadAt(make([]byte, chunkSize), int64(chunk*chunkSize))
+		require.NoError(t, err)
+
+		// The order in which the chunks were cached is restored from the modification times.
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	restarted, err := New(path, 2*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*chunkSize), restarted.Size())
+
+	b := make([]byte, chunkSize)
+	_, err = restarted.Reader("build", "memfile", base).ReadAt(b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, base.data[:chunkSize], b)
+	assert.Equal(t, 2, base.reads)
+
+	// A smaller limit evicts the oldest chunks on load.
+	smaller, err := New(path, chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(chunkSize), smaller.Size())
+}
+
+func TestCache_ConcurrentReadsWithEviction(t *testing.T) {
+	cache, err := New(t.TempDir(), chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	data := newData(4)
+	base := bytes.NewReader(data)
+
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			r := cache.Reader("build", "memfile", base)
+			b := make([]byte, chunkSize)
+
+			for i := range 50 {
+				chunk := (worker + i) % 4
+
+				_, err := r.ReadAt(b, int64(chunk*chunkSize))
+				assert.NoError(t, err)
+				assert.Equal(t, data[chunk*chunkSize:(chunk+1)*chunkSize], b)
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.LessOrEqual(t, cache.Size(), int64(chunkSize))
+}
+
+func TestCache_MissingFileIsAMiss(t *testing.T) {
+	path := t.TempDir()
+
+	cache, err := New(path, 2*chunkSize, noop.MeterProvider{})
+	require.NoError(t, err)
+
+	base := &countingReader{data: newData(1)}
+	r := cache.Reader("build", "memfile", base)
+
+	b := make([]byte, chunkSize)
+	_, err = r.ReadAt(b, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, os.Remove(filepath.Join(path, chunkKey("build", "memfile", 0))))
+
+	_, err = r.ReadAt(b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, base.data, b)
+	assert.Equal(t, 2, base.reads)
+}
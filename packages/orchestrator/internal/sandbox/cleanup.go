@@ -90,6 +90,7 @@ func cleanupFiles(files *storage.SandboxFiles) error {
 		files.SandboxFirecrackerSocketPath(),
 		files.SandboxUffdSocketPath(),
 		files.SandboxCacheRootfsLinkPath(),
+		files.SandboxRuntimeDir(),
 	} {
 		err := os.RemoveAll(p)
 		if err != nil {
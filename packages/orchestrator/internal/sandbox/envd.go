@@ -9,13 +9,16 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 )
 
 const (
@@ -89,7 +92,7 @@ func calculateBackoffDelay(attempt int) time.Duration {
 
 // doRequestWithInfiniteRetries does a request with infinite retries until the context is done.
 // The parent context should have a deadline or a timeout.
-func doRequestWithInfiniteRetries(parentCtx context.Context, method, address string, requestBody []byte, accessToken *string) (*http.Response, error) {
+func doRequestWithInfiniteRetries(parentCtx context.Context, client *http.Client, method, address string, requestBody []byte, accessToken *string) (*http.Response, error) {
 	start := time.Now()
 
 	for attempt := 0; ; attempt++ {
@@ -111,7 +114,7 @@ func doRequestWithInfiniteRetries(parentCtx context.Context, method, address str
 			request.Header.Set("X-Access-Token", *accessToken)
 		}
 
-		response, err := httpClient.Do(request)
+		response, err := client.Do(request)
 		cancel()
 
 		if err == nil {
@@ -139,19 +142,39 @@ func doRequestWithInfiniteRetries(parentCtx context.Context, method, address str
 }
 
 type PostInitJSONBody struct {
-	EnvVars     *map[string]string `json:"envVars"`
-	AccessToken *string            `json:"accessToken,omitempty"`
+	EnvVars      *map[string]string `json:"envVars"`
+	AccessToken  *string            `json:"accessToken,omitempty"`
+	VolumeMounts *[]VolumeMount     `json:"volumeMounts,omitempty"`
+	IPv6         *IPv6Network       `json:"ipv6,omitempty"`
 }
 
-func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map[string]string, accessToken *string) error {
+type IPv6Network struct {
+	Interface string `json:"interface"`
+	Address   string `json:"address"`
+	Gateway   string `json:"gateway"`
+}
+
+type VolumeMount struct {
+	Device string `json:"device"`
+	Path   string `json:"path"`
+}
+
+// initEnvd configures the envd in the sandbox, the request is authorized with authToken if the envd already has a token.
+// The envd changes its token to accessToken when authToken is its current token.
+func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map[string]string, accessToken *string, authToken *string) error {
 	childCtx, childSpan := tracer.Start(ctx, "envd-init")
 	defer childSpan.End()
 
+	var config *orchestrator.SandboxConfig
+	if s.Metadata != nil {
+		config = s.config()
+	}
+
 	sandboxID := "unknown"
 	executionID := "unknown"
-	if s.Metadata != nil && s.Metadata.Config != nil {
-		sandboxID = s.Metadata.Config.SandboxId
-		executionID = s.Metadata.Config.ExecutionId
+	if config != nil {
+		sandboxID = config.SandboxId
+		executionID = config.ExecutionId
 	}
 
 	targetIP := envdTargetIP(s.Slot)
@@ -168,17 +191,21 @@ func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map
 		zap.String("execution_id", executionID),
 		zap.String("address", address))
 
-	hostPort := fmt.Sprintf("%s:%d", targetIP.String(), consts.DefaultEnvdServerPort)
+	hostPort := net.JoinHostPort(targetIP.String(), strconv.FormatInt(consts.DefaultEnvdServerPort, 10))
 	probeTimeout := 2 * time.Second
-	if conn, probeErr := net.DialTimeout("tcp", hostPort, probeTimeout); probeErr != nil {
-		zap.L().Warn("preflight envd tcp probe failed",
+	probeCtx, cancelProbe := context.WithTimeout(childCtx, probeTimeout)
+	defer cancelProbe()
+
+	// The probe uses the same dialer as the requests, so it goes through the vsock device when the sandbox has one.
+	if conn, probeErr := s.envdTransport.DialContext(probeCtx, "tcp", hostPort); probeErr != nil {
+		zap.L().Warn("preflight envd probe failed",
 			zap.String("sandbox_id", sandboxID),
 			zap.String("execution_id", executionID),
 			zap.String("host_port", hostPort),
 			zap.Duration("timeout", probeTimeout),
 			zap.Error(probeErr))
 	} else {
-		zap.L().Info("preflight envd tcp probe succeeded",
+		zap.L().Info("preflight envd probe succeeded",
 			zap.String("sandbox_id", sandboxID),
 			zap.String("execution_id", executionID),
 			zap.String("host_port", hostPort))
@@ -190,12 +217,34 @@ func (s *Sandbox) initEnvd(ctx context.Context, tracer trace.Tracer, envVars map
 		AccessToken: accessToken,
 	}
 
+	if config != nil && len(config.Volumes) > 0 {
+		// The volumes are attached to the drive slots in the order they are listed in the config.
+		mounts := make([]VolumeMount, 0, len(config.Volumes))
+		for slot, volume := range config.Volumes {
+			mounts = append(mounts, VolumeMount{
+				Device: fc.VolumeGuestDevice(slot),
+				Path:   volume.GetPath(),
+			})
+		}
+
+		jsonBody.VolumeMounts = &mounts
+	}
+
+	// The kernel args configure only the IPv4 address of the sandbox, envd adds the IPv6 one.
+	if s.Slot != nil && s.Slot.IPv6Enabled() {
+		jsonBody.IPv6 = &IPv6Network{
+			Interface: fc.GuestInterface,
+			Address:   fmt.Sprintf("%s/%d", s.Slot.NamespaceIPv6(), s.Slot.TapIPv6Mask()),
+			Gateway:   s.Slot.TapIPv6().String(),
+		}
+	}
+
 	body, err := json.Marshal(jsonBody)
 	if err != nil {
 		return err
 	}
 
-	response, err := doRequestWithInfiniteRetries(childCtx, "POST", address, body, accessToken)
+	response, err := doRequestWithInfiniteRetries(childCtx, s.envdClient(), "POST", address, body, authToken)
 	if err != nil {
 		return fmt.Errorf("failed to init envd: %w", err)
 	}
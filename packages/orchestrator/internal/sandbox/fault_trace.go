@@ -0,0 +1,112 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	// faultTraceDuration is how long after the resume are the memfile page faults recorded.
+	faultTraceDuration = 10 * time.Second
+	faultTraceTimeout  = 30 * time.Second
+	prefetchWorkers    = 8
+)
+
+// traceMemory prefetches the memfile blocks recorded by a previous resume of the build.
+// If the build has no trace yet, the returned device records the page faults and the trace is uploaded next to the build.
+func traceMemory(
+	cleanup *Cleanup,
+	persistence storage.StorageProvider,
+	files *storage.TemplateFiles,
+	memfile block.ReadonlyDevice,
+	sandboxID string,
+) block.ReadonlyDevice {
+	recorder := uffd.NewFaultTrace(memfile.BlockSize(), faultTraceDuration)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cleanup.Add(func(context.Context) error {
+		cancel()
+
+		return nil
+	})
+
+	logger := zap.L().With(zap.String("sandbox_id", sandboxID), zap.String("build_id", files.BuildId))
+
+	go func() {
+		existing, err := loadFaultTrace(ctx, persistence, files)
+		if err == nil {
+			prefetchErr := existing.Prefetch(ctx, memfile, prefetchWorkers)
+			if prefetchErr != nil && !errors.Is(prefetchErr, context.Canceled) {
+				logger.Warn("failed to prefetch memfile", zap.Error(prefetchErr))
+			}
+
+			return
+		}
+
+		if !errors.Is(err, storage.ErrorObjectNotExist) {
+			logger.Warn("failed to load memfile fault trace", zap.Error(err))
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			// The sandbox did not run for the whole trace duration, the trace would be incomplete.
+			return
+		case <-time.After(faultTraceDuration):
+		}
+
+		if recorder.Len() == 0 {
+			return
+		}
+
+		uploadCtx, uploadCancel := context.WithTimeout(context.Background(), faultTraceTimeout)
+		defer uploadCancel()
+
+		err = storeFaultTrace(uploadCtx, persistence, files, recorder)
+		if err != nil {
+			logger.Warn("failed to store memfile fault trace", zap.Error(err))
+		}
+	}()
+
+	return recorder.Device(memfile)
+}
+
+func loadFaultTrace(ctx context.Context, persistence storage.StorageProvider, files *storage.TemplateFiles) (*uffd.FaultTrace, error) {
+	obj, err := persistence.OpenObject(ctx, files.StorageMemfileTracePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return uffd.DeserializeFaultTrace(obj)
+}
+
+func storeFaultTrace(ctx context.Context, persistence storage.StorageProvider, files *storage.TemplateFiles, trace *uffd.FaultTrace) error {
+	obj, err := persistence.OpenObject(ctx, files.StorageMemfileTracePath())
+	if err != nil {
+		return err
+	}
+
+	serialized, err := trace.Serialize()
+	if err != nil {
+		return err
+	}
+
+	_, err = obj.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("failed to upload fault trace: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package fc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+)
+
+const (
+	// balloonStatsInterval is how often the guest balloon driver updates the memory statistics.
+	balloonStatsInterval = 5 * time.Second
+
+	// balloonMinReserveMiB is the minimum memory kept available to the guest when the balloon is inflated.
+	balloonMinReserveMiB = 128
+	// balloonMinChangeMiB prevents resizing the balloon on small fluctuations of the guest memory usage.
+	balloonMinChangeMiB = 32
+
+	balloonDeflateTimeout      = 5 * time.Second
+	balloonDeflatePollInterval = 100 * time.Millisecond
+)
+
+// balloonEnabled controls whether the newly created VMs get a balloon device.
+// VMs resumed from a snapshot keep the devices they were snapshotted with.
+var balloonEnabled = env.GetEnv("SANDBOX_BALLOON_ENABLED", "true") != "false"
+
+// BalloonTarget returns the balloon size for the guest memory statistics and whether the balloon should be resized.
+//
+// The guest keeps an eighth of its memory limit (at least balloonMinReserveMiB) available. Half of the available memory
+// above the reserve is reclaimed on each call, so an idle guest is shrunk gradually, while a guest that dropped
+// below the reserve gets the missing memory back at once.
+// The balloon always holds the memory of the VM above the limit, so the guest can use at most limitMiB of its memoryMiB.
+func BalloonTarget(memoryMiB int64, limitMiB int64, stats *models.BalloonStats) (int64, bool) {
+	var actual int64
+	if stats.ActualMib != nil {
+		actual = *stats.ActualMib
+	}
+
+	floor := max(memoryMiB-limitMiB, 0)
+
+	available := stats.AvailableMemory >> 20
+	if available == 0 {
+		// Older guest kernels do not report the available memory.
+		available = stats.FreeMemory >> 20
+	}
+
+	if available == 0 {
+		// The guest has not reported the statistics yet, only the limit is enforced.
+		return max(actual, floor), actual < floor
+	}
+
+	reserve := max(balloonMinReserveMiB, limitMiB/8)
+
+	target := actual
+	if surplus := available - reserve; surplus > 0 {
+		target += surplus / 2
+	} else {
+		target += surplus
+	}
+
+	target = min(max(target, floor), max(memoryMiB-reserve, floor))
+
+	change := target - actual
+	if change == 0 || (change > -balloonMinChangeMiB && change < balloonMinChangeMiB && target != 0 && actual >= floor) {
+		return actual, false
+	}
+
+	return target, true
+}
+
+func (p *Process) setupBalloon(ctx context.Context) error {
+	// The balloon starts deflated and may be inflated only by the guest's memory statistics, so it does not limit the guest on boot.
+	return p.client.setBalloon(ctx, 0, true, int64(balloonStatsInterval/time.Second))
+}
+
+// BalloonStats returns the guest memory statistics reported by the balloon device.
+// It fails when the VM does not have a balloon device.
+func (p *Process) BalloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	return p.client.balloonStats(ctx)
+}
+
+// SetBalloon changes the target size of the balloon, the guest driver inflates or deflates it asynchronously.
+func (p *Process) SetBalloon(ctx context.Context, amountMiB int64) error {
+	return p.client.updateBalloon(ctx, amountMiB)
+}
+
+// DeflateBalloon returns all the memory held by the balloon to the guest and waits until the guest driver releases it.
+// The pages reclaimed by the balloon are not populated again until the guest uses them,
+// so a snapshot created after the deflation does not include them.
+func (p *Process) DeflateBalloon(ctx context.Context, tracer trace.Tracer) error {
+	ctx, childSpan := tracer.Start(ctx, "deflate-balloon-fc")
+	defer childSpan.End()
+
+	stats, err := p.client.balloonStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	if stats.ActualMib == nil || *stats.ActualMib == 0 {
+		return nil
+	}
+
+	err = p.client.updateBalloon(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, balloonDeflateTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(balloonDeflatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("balloon not deflated, %d MiB still held: %w", *stats.ActualMib, ctx.Err())
+		case <-ticker.C:
+		}
+
+		stats, err = p.client.balloonStats(ctx)
+		if err != nil {
+			return err
+		}
+
+		if stats.ActualMib == nil || *stats.ActualMib == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package fc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+)
+
+func balloonStats(actualMiB, availableMiB int64) *models.BalloonStats {
+	return &models.BalloonStats{
+		ActualMib:       &actualMiB,
+		AvailableMemory: availableMiB << 20,
+	}
+}
+
+func TestBalloonTarget_InflatesIdleGuestGradually(t *testing.T) {
+	// 1024 MiB guest keeps 128 MiB reserve, half of the 512 MiB surplus is reclaimed.
+	target, ok := BalloonTarget(1024, 1024, balloonStats(0, 640))
+	assert.True(t, ok)
+	assert.Equal(t, int64(256), target)
+
+	target, ok = BalloonTarget(1024, 1024, balloonStats(256, 384))
+	assert.True(t, ok)
+	assert.Equal(t, int64(384), target)
+}
+
+func TestBalloonTarget_DeflatesBelowReserve(t *testing.T) {
+	target, ok := BalloonTarget(1024, 1024, balloonStats(512, 28))
+	assert.True(t, ok)
+	assert.Equal(t, int64(412), target)
+
+	target, ok = BalloonTarget(1024, 1024, balloonStats(20, 100))
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), target)
+}
+
+func TestBalloonTarget_IgnoresSmallChanges(t *testing.T) {
+	_, ok := BalloonTarget(1024, 1024, balloonStats(256, 150))
+	assert.False(t, ok)
+
+	_, ok = BalloonTarget(1024, 1024, balloonStats(256, 110))
+	assert.False(t, ok)
+}
+
+func TestBalloonTarget_KeepsReserve(t *testing.T) {
+	// Without any usage the balloon never takes the reserve.
+	target, ok := BalloonTarget(1024, 1024, balloonStats(800, 1000))
+	assert.True(t, ok)
+	assert.Equal(t, int64(896), target)
+
+	// Small guests are not ballooned below the minimal reserve.
+	_, ok = BalloonTarget(128, 128, balloonStats(0, 120))
+	assert.False(t, ok)
+}
+
+func TestBalloonTarget_WaitsForStats(t *testing.T) {
+	target, ok := BalloonTarget(1024, 1024, &models.BalloonStats{})
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), target)
+}
+
+func TestBalloonTarget_EnforcesLimit(t *testing.T) {
+	// 1024 MiB VM limited to 512 MiB, the balloon holds at least 512 MiB even if the guest uses all its memory.
+	target, ok := BalloonTarget(1024, 512, balloonStats(0, 20))
+	assert.True(t, ok)
+	assert.Equal(t, int64(512), target)
+
+	// The limit is enforced before the guest reports the statistics.
+	target, ok = BalloonTarget(1024, 512, &models.BalloonStats{})
+	assert.True(t, ok)
+	assert.Equal(t, int64(512), target)
+
+	// Small changes are still applied when the balloon is below the limit.
+	target, ok = BalloonTarget(1024, 512, balloonStats(500, 100))
+	assert.True(t, ok)
+	assert.Equal(t, int64(512), target)
+
+	// The idle memory within the limit is reclaimed as usual, the reserve is counted from the limit.
+	target, ok = BalloonTarget(1024, 512, balloonStats(512, 320))
+	assert.True(t, ok)
+	assert.Equal(t, int64(608), target)
+}
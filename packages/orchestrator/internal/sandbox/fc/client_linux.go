@@ -163,8 +163,8 @@ func (c *apiClient) setBootSource(ctx context.Context, kernelArgs string, kernel
 	return nil
 }
 
-func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error {
-	rootfs := "rootfs"
+func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string, limiter *models.RateLimiter) error {
+	rootfs := rootfsDriveID
 	ioEngine := "Async"
 	isRootDevice := true
 	isReadOnly := false
@@ -177,6 +177,7 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 			IsRootDevice: &isRootDevice,
 			IsReadOnly:   &isReadOnly,
 			IoEngine:     &ioEngine,
+			RateLimiter:  limiter,
 		},
 	}
 
@@ -188,14 +189,60 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 	return nil
 }
 
-func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string) error {
+// setDrive attaches an additional writable drive to the VM before it is started.
+func (c *apiClient) setDrive(ctx context.Context, driveID string, path string) error {
+	ioEngine := "Async"
+	isRootDevice := false
+	isReadOnly := false
+	driveConfig := operations.PutGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.Drive{
+			DriveID:      &driveID,
+			PathOnHost:   path,
+			IsRootDevice: &isRootDevice,
+			IsReadOnly:   &isReadOnly,
+			IoEngine:     &ioEngine,
+		},
+	}
+
+	_, err := c.client.Operations.PutGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc drive %s: %w", driveID, err)
+	}
+
+	return nil
+}
+
+// updateDrive reopens the drive's backing file on the running VM, the guest is notified about the new drive size.
+func (c *apiClient) updateDrive(ctx context.Context, driveID string, path string) error {
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.PartialDrive{
+			DriveID:    &driveID,
+			PathOnHost: path,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive %s: %w", driveID, err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string, rx *models.RateLimiter, tx *models.RateLimiter) error {
 	networkConfig := operations.PutGuestNetworkInterfaceByIDParams{
 		Context: ctx,
 		IfaceID: ifaceID,
 		Body: &models.NetworkInterface{
-			IfaceID:     &ifaceID,
-			GuestMac:    tapMac,
-			HostDevName: &tapName,
+			IfaceID:       &ifaceID,
+			GuestMac:      tapMac,
+			HostDevName:   &tapName,
+			RxRateLimiter: rx,
+			TxRateLimiter: tx,
 		},
 	}
 
@@ -270,3 +317,104 @@ func (c *apiClient) startVM(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *apiClient) setVsock(ctx context.Context, guestCID int64, udsPath string) error {
+	vsockConfig := operations.PutGuestVsockParams{
+		Context: ctx,
+		Body: &models.Vsock{
+			GuestCid: &guestCID,
+			UdsPath:  &udsPath,
+		},
+	}
+
+	_, err := c.client.Operations.PutGuestVsock(&vsockConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc vsock config: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) setBalloon(ctx context.Context, amountMib int64, deflateOnOom bool, statsIntervalS int64) error {
+	balloonConfig := operations.PutBalloonParams{
+		Context: ctx,
+		Body: &models.Balloon{
+			AmountMib:             &amountMib,
+			DeflateOnOom:          &deflateOnOom,
+			StatsPollingIntervals: statsIntervalS,
+		},
+	}
+
+	_, err := c.client.Operations.PutBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc balloon config: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMib int64) error {
+	balloonConfig := operations.PatchBalloonParams{
+		Context: ctx,
+		Body: &models.BalloonUpdate{
+			AmountMib: &amountMib,
+		},
+	}
+
+	_, err := c.client.Operations.PatchBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc balloon: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	statsParams := operations.DescribeBalloonStatsParams{
+		Context: ctx,
+	}
+
+	res, err := c.client.Operations.DescribeBalloonStats(&statsParams)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fc balloon stats: %w", err)
+	}
+
+	return res.Payload, nil
+}
+
+func (c *apiClient) updateDriveRateLimiter(ctx context.Context, driveID string, limiter *models.RateLimiter) error {
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.PartialDrive{
+			DriveID:     &driveID,
+			RateLimiter: limiter,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateNetworkRateLimiter(ctx context.Context, ifaceID string, rx *models.RateLimiter, tx *models.RateLimiter) error {
+	networkConfig := operations.PatchGuestNetworkInterfaceByIDParams{
+		Context: ctx,
+		IfaceID: ifaceID,
+		Body: &models.PartialNetworkInterface{
+			IfaceID:       &ifaceID,
+			RxRateLimiter: rx,
+			TxRateLimiter: tx,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestNetworkInterfaceByID(&networkConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc network rate limiter: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
 )
 
 type apiClient struct{}
@@ -34,3 +35,35 @@ func (c *apiClient) pauseVM(ctx context.Context) error {
 func (c *apiClient) createSnapshot(ctx context.Context, snapfilePath string, memfilePath string) error {
 	return nil
 }
+
+func (c *apiClient) setVsock(ctx context.Context, guestCID int64, udsPath string) error {
+	return nil
+}
+
+func (c *apiClient) setBalloon(ctx context.Context, amountMib int64, deflateOnOom bool, statsIntervalS int64) error {
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMib int64) error {
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	return nil, nil
+}
+
+func (c *apiClient) setDrive(ctx context.Context, driveID string, path string) error {
+	return nil
+}
+
+func (c *apiClient) updateDrive(ctx context.Context, driveID string, path string) error {
+	return nil
+}
+
+func (c *apiClient) updateDriveRateLimiter(ctx context.Context, driveID string, limiter *models.RateLimiter) error {
+	return nil
+}
+
+func (c *apiClient) updateNetworkRateLimiter(ctx context.Context, ifaceID string, rx *models.RateLimiter, tx *models.RateLimiter) error {
+	return nil
+}
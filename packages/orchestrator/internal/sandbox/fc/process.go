@@ -19,6 +19,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/socket"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -44,6 +45,14 @@ ip netns exec {{ .namespaceID }} {{ .firecrackerPath }} --api-sock {{ .firecrack
 
 var startScriptTemplate = txtTemplate.Must(txtTemplate.New("fc-start").Parse(startScript))
 
+const rootfsDriveID = "rootfs"
+
+// vsockGuestCID is the context ID of the guest, the lowest CID not reserved for the hypervisor and the host.
+const vsockGuestCID = 3
+
+// GuestInterface is the network interface of the sandbox in the guest, the kernel configures its IPv4 address and envd the IPv6 one.
+const GuestInterface = "eth0"
+
 type ProcessOptions struct {
 	// InitScriptPath is the path to the init script that will be executed inside the VM on kernel start.
 	InitScriptPath string
@@ -72,6 +81,8 @@ type Process struct {
 	rootfsPath string
 	files      *storage.SandboxFiles
 
+	vsockPath string
+
 	Exit chan error
 
 	client *apiClient
@@ -84,6 +95,7 @@ func NewProcess(
 	tracer trace.Tracer,
 	slot *network.Slot,
 	files *storage.SandboxFiles,
+	firecrackerVersion string,
 	rootfsPath string,
 	baseTemplateID string,
 	baseBuildID string,
@@ -111,7 +123,7 @@ func NewProcess(
 		"buildKernelPath":   files.BuildKernelPath(),
 		"buildKernelDir":    files.BuildKernelDir(),
 		"namespaceID":       slot.NamespaceID(),
-		"firecrackerPath":   files.FirecrackerPath(),
+		"firecrackerPath":   firecrackerPath(firecrackerVersion),
 		"firecrackerSocket": files.SandboxFirecrackerSocketPath(),
 	})
 	if err != nil {
@@ -122,7 +134,7 @@ func NewProcess(
 		attribute.String("sandbox.cmd", fcStartScript.String()),
 	)
 
-	_, err = os.Stat(files.FirecrackerPath())
+	_, err = os.Stat(firecrackerPath(firecrackerVersion))
 	if err != nil {
 		return nil, fmt.Errorf("error stating firecracker binary: %w", err)
 	}
@@ -146,6 +158,13 @@ func NewProcess(
 		Setsid: true, // Create a new session
 	}
 
+	// The vsock socket path is relative to the working directory, so the sandboxes resumed from the same snapshot don't share it.
+	err = os.MkdirAll(files.SandboxRuntimeDir(), 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("error creating fc runtime dir: %w", err)
+	}
+	cmd.Dir = files.SandboxRuntimeDir()
+
 	return &Process{
 		Exit:                  make(chan error, 1),
 		cmd:                   cmd,
@@ -154,6 +173,7 @@ func NewProcess(
 		rootfsPath:            rootfsPath,
 		files:                 files,
 		slot:                  slot,
+		vsockPath:             files.SandboxVsockSocketPath(),
 
 		buildRootfsPath: buildRootfsPath,
 	}, nil
@@ -255,6 +275,9 @@ func (p *Process) Create(
 	vCPUCount int64,
 	memoryMB int64,
 	hugePages bool,
+	volumePaths []string,
+	diskRateLimiter *orchestrator.RateLimiter,
+	networkRateLimiter *orchestrator.RateLimiter,
 	options ProcessOptions,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "create-fc")
@@ -276,7 +299,7 @@ func (p *Process) Create(
 	}
 
 	// IPv4 configuration - format: [local_ip]::[gateway_ip]:[netmask]:hostname:iface:dhcp_option:[dns]
-	ipv4 := fmt.Sprintf("%s::%s:%s:instance:%s:off:%s", p.slot.NamespaceIP(), p.slot.TapIPString(), p.slot.TapMaskString(), p.slot.VpeerName(), p.slot.TapName())
+	ipv4 := fmt.Sprintf("%s::%s:%s:instance:%s:off:%s", p.slot.NamespaceIP(), p.slot.TapIPString(), p.slot.TapMaskString(), GuestInterface, p.slot.TapName())
 	args := KernelArgs{
 		// Disable kernel logs for production to speed the FC operations
 		// https://github.com/firecracker-microvm/firecracker/blob/main/docs/prod-host-setup.md#logging-and-performance
@@ -289,6 +312,8 @@ func (p *Process) Create(
 		// Specify root device (required for kernel to mount rootfs)
 		"root":       "/dev/vda",
 		"rootfstype": "ext4",
+		// Discard the blocks of deleted files, so they are not kept in the rootfs diff
+		"rootflags": "discard",
 
 		// Networking IPv4 and IPv6
 		"ip":            ipv4,
@@ -329,7 +354,7 @@ func (p *Process) Create(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
-	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath)
+	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath, rateLimiter(diskRateLimiter))
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -337,8 +362,25 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(childCtx, "set fc drivers config")
 
-	// Network
-	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC())
+	// Volumes
+	err = p.prepareVolumeDrives(volumePaths)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error preparing fc volume drives: %w", err), fcStopErr)
+	}
+
+	err = p.setupVolumeDrives(childCtx)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting fc volume drives config: %w", err), fcStopErr)
+	}
+	telemetry.ReportEvent(childCtx, "set fc volume drives config")
+
+	// Network, the limits apply to each direction separately
+	networkLimiter := rateLimiter(networkRateLimiter)
+	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC(), networkLimiter, networkLimiter)
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -354,6 +396,24 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(childCtx, "set fc machine config")
 
+	err = p.client.setVsock(childCtx, vsockGuestCID, storage.SandboxVsockSocketName)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting fc vsock config: %w", err), fcStopErr)
+	}
+	telemetry.ReportEvent(childCtx, "set fc vsock config")
+
+	if balloonEnabled {
+		err = p.setupBalloon(childCtx)
+		if err != nil {
+			fcStopErr := p.Stop()
+
+			return errors.Join(fmt.Errorf("error setting fc balloon config: %w", err), fcStopErr)
+		}
+		telemetry.ReportEvent(childCtx, "set fc balloon config")
+	}
+
 	err = p.client.startVM(childCtx)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -372,6 +432,7 @@ func (p *Process) Resume(
 	uffdSocketPath string,
 	snapfile template.File,
 	uffdReady chan struct{},
+	volumePaths []string,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "resume-fc")
 	defer childSpan.End()
@@ -396,6 +457,13 @@ func (p *Process) Resume(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
+	err = p.prepareVolumeDrives(volumePaths)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error preparing volume drives: %w", err), fcStopErr)
+	}
+
 	err = p.client.loadSnapshot(
 		childCtx,
 		uffdSocketPath,
@@ -415,6 +483,13 @@ func (p *Process) Resume(
 		return errors.Join(fmt.Errorf("error resuming vm: %w", err), fcStopErr)
 	}
 
+	err = p.attachVolumeDrives(childCtx, len(volumePaths))
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error attaching volumes: %w", err), fcStopErr)
+	}
+
 	err = p.client.setMmds(childCtx, mmdsMetadata)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -431,6 +506,12 @@ func (p *Process) Resume(
 	return nil
 }
 
+// VsockPath returns the path of the unix socket connected to the VM's vsock device.
+// The socket does not exist when the VM was resumed from a snapshot created without the vsock device.
+func (p *Process) VsockPath() string {
+	return p.vsockPath
+}
+
 func (p *Process) Pid() (int, error) {
 	if p.cmd.Process == nil {
 		return 0, fmt.Errorf("fc process not started")
@@ -459,6 +540,24 @@ func (p *Process) Pause(ctx context.Context, tracer trace.Tracer) error {
 	return p.client.pauseVM(ctx)
 }
 
+// Unpause resumes the VM paused by Pause.
+func (p *Process) Unpause(ctx context.Context, tracer trace.Tracer) error {
+	ctx, childSpan := tracer.Start(ctx, "unpause-fc")
+	defer childSpan.End()
+
+	return p.client.resumeVM(ctx)
+}
+
+// SetMmds replaces the metadata the guest reads from MMDS.
+func (p *Process) SetMmds(ctx context.Context, metadata *MmdsMetadata) error {
+	err := p.client.setMmds(ctx, metadata)
+	if err != nil {
+		return fmt.Errorf("error setting mmds: %w", err)
+	}
+
+	return nil
+}
+
 // CreateSnapshot VM needs to be paused before creating a snapshot.
 func (p *Process) CreateSnapshot(ctx context.Context, tracer trace.Tracer, snapfilePath string, memfilePath string) error {
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
@@ -0,0 +1,65 @@
+package fc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// rateLimiterRefillTimeMs makes the token bucket sizes per second values.
+const rateLimiterRefillTimeMs = 1000
+
+// tokenBucket returns a bucket refilled with rate tokens every second, a zero rate returns a bucket that disables the limit.
+func tokenBucket(rate int64, burst int64) *models.TokenBucket {
+	refillTime := int64(rateLimiterRefillTimeMs)
+	bucket := &models.TokenBucket{
+		Size:       &rate,
+		RefillTime: &refillTime,
+	}
+
+	if rate > 0 && burst > 0 {
+		bucket.OneTimeBurst = &burst
+	}
+
+	return bucket
+}
+
+// rateLimiter converts the sandbox limits to the Firecracker rate limiter, nil limits return nil.
+// Both buckets are always set, so updating the limiter also removes the limits that are no longer set.
+func rateLimiter(limits *orchestrator.RateLimiter) *models.RateLimiter {
+	if limits == nil {
+		return nil
+	}
+
+	return &models.RateLimiter{
+		Bandwidth: tokenBucket(limits.GetBandwidthBytesPerSecond(), limits.GetBandwidthBurstBytes()),
+		Ops:       tokenBucket(limits.GetOpsPerSecond(), limits.GetOpsBurst()),
+	}
+}
+
+// SetRateLimiters updates the rate limiters of the rootfs drive and the network interface of the running VM.
+// The limits of a created VM are set in its initial config, this is for the VMs loaded from a snapshot and for the later changes.
+// The network limits apply to each direction separately. Nil limits keep the current limiter of the device.
+func (p *Process) SetRateLimiters(ctx context.Context, disk *orchestrator.RateLimiter, network *orchestrator.RateLimiter) error {
+	var errs []error
+
+	if disk != nil {
+		err := p.client.updateDriveRateLimiter(ctx, rootfsDriveID, rateLimiter(disk))
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if network != nil {
+		limiter := rateLimiter(network)
+
+		err := p.client.updateNetworkRateLimiter(ctx, p.slot.VpeerName(), limiter, limiter)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,40 @@
+package fc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestRateLimiter_Nil(t *testing.T) {
+	assert.Nil(t, rateLimiter(nil))
+}
+
+func TestRateLimiter_ConvertsLimitsPerSecond(t *testing.T) {
+	limiter := rateLimiter(&orchestrator.RateLimiter{
+		BandwidthBytesPerSecond: 10 << 20,
+		BandwidthBurstBytes:     20 << 20,
+		OpsPerSecond:            1000,
+	})
+	require.NotNil(t, limiter)
+
+	assert.Equal(t, int64(10<<20), *limiter.Bandwidth.Size)
+	assert.Equal(t, int64(rateLimiterRefillTimeMs), *limiter.Bandwidth.RefillTime)
+	assert.Equal(t, int64(20<<20), *limiter.Bandwidth.OneTimeBurst)
+
+	assert.Equal(t, int64(1000), *limiter.Ops.Size)
+	assert.Nil(t, limiter.Ops.OneTimeBurst)
+}
+
+func TestRateLimiter_ZeroDisablesTheBucket(t *testing.T) {
+	limiter := rateLimiter(&orchestrator.RateLimiter{OpsBurst: 100})
+	require.NotNil(t, limiter)
+
+	// Zero sized buckets are set explicitly, so an update removes the previous limits.
+	assert.Equal(t, int64(0), *limiter.Bandwidth.Size)
+	assert.Equal(t, int64(0), *limiter.Ops.Size)
+	assert.Nil(t, limiter.Ops.OneTimeBurst)
+}
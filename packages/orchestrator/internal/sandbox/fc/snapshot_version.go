@@ -0,0 +1,156 @@
+package fc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// ErrIncompatibleSnapshot is returned when none of the Firecracker versions on the node can load the snapshot.
+var ErrIncompatibleSnapshot = errors.New("snapshot is not compatible with the Firecracker versions on the node")
+
+// versionsDir is the directory with the Firecracker binaries, one directory per version.
+var versionsDir = storage.FirecrackerVersionsDir
+
+// snapshotVersions caches the snapshot versions of the binaries, the binaries of a version don't change.
+var snapshotVersions = smap.New[*semver.Version]()
+
+func firecrackerPath(firecrackerVersion string) string {
+	return filepath.Join(versionsDir, firecrackerVersion, storage.FirecrackerBinaryName)
+}
+
+// SnapshotVersion returns the snapshot data format version the Firecracker version creates and loads.
+func SnapshotVersion(ctx context.Context, firecrackerVersion string) (*semver.Version, error) {
+	path := firecrackerPath(firecrackerVersion)
+
+	if version, ok := snapshotVersions.Get(path); ok {
+		return version, nil
+	}
+
+	out, err := exec.CommandContext(ctx, path, "--snapshot-version").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot version of Firecracker %s: %w", firecrackerVersion, err)
+	}
+
+	version, err := semver.NewVersion(strings.TrimSpace(string(out)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot version of Firecracker %s: %w", firecrackerVersion, err)
+	}
+
+	snapshotVersions.Insert(path, version)
+
+	return version, nil
+}
+
+// NewSnapshotMetadata returns the metadata of the snapshot created by the Firecracker version.
+// The snapshot version is left empty if it can't be determined, the snapshot can be still resumed by the same Firecracker version.
+func NewSnapshotMetadata(ctx context.Context, firecrackerVersion string) (*storage.SnapshotMetadata, error) {
+	metadata := &storage.SnapshotMetadata{
+		FirecrackerVersion: firecrackerVersion,
+	}
+
+	version, err := SnapshotVersion(ctx, firecrackerVersion)
+	if err != nil {
+		return metadata, err
+	}
+
+	metadata.SnapshotVersion = version.Original()
+
+	return metadata, nil
+}
+
+// CanLoadSnapshot reports whether the Firecracker with the supported snapshot version can load the snapshot.
+// Firecracker loads the snapshots with the same major version and the minor version up to the one it supports.
+func CanLoadSnapshot(supported, snapshot *semver.Version) bool {
+	return supported.Major() == snapshot.Major() && supported.Minor() >= snapshot.Minor()
+}
+
+// SelectFirecracker returns the Firecracker version the snapshot is resumed with.
+// The Firecracker that created the snapshot is used when it is on the node, otherwise the newest version that can load the snapshot.
+func SelectFirecracker(ctx context.Context, metadata *storage.SnapshotMetadata) (string, error) {
+	_, err := os.Stat(firecrackerPath(metadata.FirecrackerVersion))
+	if err == nil {
+		return metadata.FirecrackerVersion, nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("error stating firecracker binary: %w", err)
+	}
+
+	if metadata.SnapshotVersion == "" {
+		return "", fmt.Errorf("%w: Firecracker %s that created the snapshot is not available and the snapshot version is unknown", ErrIncompatibleSnapshot, metadata.FirecrackerVersion)
+	}
+
+	snapshot, err := semver.NewVersion(metadata.SnapshotVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse snapshot version: %w", err)
+	}
+
+	versions, err := firecrackerVersions()
+	if err != nil {
+		return "", err
+	}
+
+	for _, version := range versions {
+		supported, err := SnapshotVersion(ctx, version)
+		if err != nil {
+			// A broken binary shouldn't prevent using the other versions.
+			continue
+		}
+
+		if CanLoadSnapshot(supported, snapshot) {
+			return version, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: Firecracker %s that created the snapshot is not available and no other version supports the snapshot version %s", ErrIncompatibleSnapshot, metadata.FirecrackerVersion, metadata.SnapshotVersion)
+}
+
+// firecrackerVersions returns the Firecracker versions on the node, the newest release first.
+func firecrackerVersions() ([]string, error) {
+	entries, err := os.ReadDir(versionsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Firecracker versions: %w", err)
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, aErr := releaseVersion(versions[i])
+		b, bErr := releaseVersion(versions[j])
+
+		switch {
+		case aErr != nil || bErr != nil:
+			// The versions that can't be parsed go last.
+			return aErr == nil && bErr != nil
+		case a.Equal(b):
+			return versions[i] > versions[j]
+		default:
+			return a.GreaterThan(b)
+		}
+	})
+
+	return versions, nil
+}
+
+// releaseVersion parses the release of the Firecracker version, the structure is last_tag[-prerelease]_commit_hash.
+func releaseVersion(firecrackerVersion string) (*semver.Version, error) {
+	release, _, _ := strings.Cut(firecrackerVersion, "_")
+
+	return semver.NewVersion(release)
+}
@@ -0,0 +1,88 @@
+package fc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// fakeVersions creates the Firecracker binaries that print their snapshot versions.
+func fakeVersions(t *testing.T, snapshotVersions map[string]string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for firecrackerVersion, snapshotVersion := range snapshotVersions {
+		path := filepath.Join(dir, firecrackerVersion, storage.FirecrackerBinaryName)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho "+snapshotVersion+"\n"), 0o755))
+	}
+
+	original := versionsDir
+	versionsDir = dir
+	t.Cleanup(func() { versionsDir = original })
+}
+
+func TestCanLoadSnapshot(t *testing.T) {
+	supported := semver.MustParse("v2.1.0")
+
+	assert.True(t, CanLoadSnapshot(supported, semver.MustParse("v2.0.0")))
+	assert.True(t, CanLoadSnapshot(supported, semver.MustParse("v2.1.0")))
+	assert.False(t, CanLoadSnapshot(supported, semver.MustParse("v2.2.0")))
+	assert.False(t, CanLoadSnapshot(supported, semver.MustParse("v1.0.0")))
+	assert.False(t, CanLoadSnapshot(supported, semver.MustParse("v3.0.0")))
+}
+
+func TestSelectFirecracker(t *testing.T) {
+	fakeVersions(t, map[string]string{
+		"v1.9.1_aaaaaaa":  "v2.0.0",
+		"v1.10.1_bbbbbbb": "v2.1.0",
+		"v1.12.0_ccccccc": "v3.0.0",
+	})
+
+	ctx := context.Background()
+
+	t.Run("creator available", func(t *testing.T) {
+		version, err := SelectFirecracker(ctx, &storage.SnapshotMetadata{FirecrackerVersion: "v1.9.1_aaaaaaa", SnapshotVersion: "v2.0.0"})
+		require.NoError(t, err)
+		assert.Equal(t, "v1.9.1_aaaaaaa", version)
+	})
+
+	t.Run("newest compatible", func(t *testing.T) {
+		version, err := SelectFirecracker(ctx, &storage.SnapshotMetadata{FirecrackerVersion: "v1.8.0_ddddddd", SnapshotVersion: "v2.0.0"})
+		require.NoError(t, err)
+		assert.Equal(t, "v1.10.1_bbbbbbb", version)
+	})
+
+	t.Run("unknown snapshot version", func(t *testing.T) {
+		_, err := SelectFirecracker(ctx, &storage.SnapshotMetadata{FirecrackerVersion: "v1.8.0_ddddddd"})
+		require.ErrorIs(t, err, ErrIncompatibleSnapshot)
+	})
+
+	t.Run("no compatible version", func(t *testing.T) {
+		_, err := SelectFirecracker(ctx, &storage.SnapshotMetadata{FirecrackerVersion: "v1.13.0_eeeeeee", SnapshotVersion: "v4.0.0"})
+		require.ErrorIs(t, err, ErrIncompatibleSnapshot)
+	})
+}
+
+func TestNewSnapshotMetadata(t *testing.T) {
+	fakeVersions(t, map[string]string{
+		"v1.10.1_bbbbbbb": "v2.1.0",
+	})
+
+	metadata, err := NewSnapshotMetadata(context.Background(), "v1.10.1_bbbbbbb")
+	require.NoError(t, err)
+	assert.Equal(t, &storage.SnapshotMetadata{FirecrackerVersion: "v1.10.1_bbbbbbb", SnapshotVersion: "v2.1.0"}, metadata)
+
+	metadata, err = NewSnapshotMetadata(context.Background(), "v1.8.0_ddddddd")
+	require.Error(t, err)
+	assert.Equal(t, &storage.SnapshotMetadata{FirecrackerVersion: "v1.8.0_ddddddd"}, metadata)
+}
@@ -0,0 +1,93 @@
+package fc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	// MaxVolumes is the number of volume drive slots the VMs are created with.
+	// Drives cannot be added to a VM resumed from a snapshot, so the slots are reserved when the template is built
+	// and the attached volumes replace the placeholder drives after the resume.
+	MaxVolumes = 4
+
+	volumePlaceholderSize = 1 << 20
+)
+
+func volumeDriveID(slot int) string {
+	return fmt.Sprintf("volume-%d", slot)
+}
+
+// VolumeGuestDevice returns the guest block device of the volume slot, the rootfs is always the first drive.
+func VolumeGuestDevice(slot int) string {
+	return fmt.Sprintf("/dev/vd%c", 'b'+slot)
+}
+
+// prepareVolumeDrives links the volume devices to the drive slots in the runtime directory.
+// The drive paths are relative to the runtime directory, unused slots are backed by an empty placeholder file.
+func (p *Process) prepareVolumeDrives(volumePaths []string) error {
+	if len(volumePaths) > MaxVolumes {
+		return fmt.Errorf("too many volumes: %d, at most %d volumes can be attached", len(volumePaths), MaxVolumes)
+	}
+
+	for slot := range MaxVolumes {
+		drivePath := filepath.Join(p.files.SandboxRuntimeDir(), volumeDriveID(slot))
+
+		if slot < len(volumePaths) {
+			err := utils.SymlinkForce(volumePaths[slot], drivePath)
+			if err != nil {
+				return fmt.Errorf("error symlinking volume %d: %w", slot, err)
+			}
+
+			continue
+		}
+
+		placeholder, err := os.Create(drivePath)
+		if err != nil {
+			return fmt.Errorf("error creating volume %d placeholder: %w", slot, err)
+		}
+
+		err = placeholder.Truncate(volumePlaceholderSize)
+		if err != nil {
+			placeholder.Close()
+
+			return fmt.Errorf("error truncating volume %d placeholder: %w", slot, err)
+		}
+
+		err = placeholder.Close()
+		if err != nil {
+			return fmt.Errorf("error closing volume %d placeholder: %w", slot, err)
+		}
+	}
+
+	return nil
+}
+
+// setupVolumeDrives adds the volume drive slots to the VM before it is started.
+func (p *Process) setupVolumeDrives(ctx context.Context) error {
+	for slot := range MaxVolumes {
+		err := p.client.setDrive(ctx, volumeDriveID(slot), volumeDriveID(slot))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachVolumeDrives makes the resumed VM reopen the drives of the attached volumes,
+// so the guest sees the volume size instead of the size of the placeholder in the snapshot.
+func (p *Process) attachVolumeDrives(ctx context.Context, count int) error {
+	for slot := range count {
+		err := p.client.updateDrive(ctx, volumeDriveID(slot), volumeDriveID(slot))
+		if err != nil {
+			return fmt.Errorf("the template does not support volumes, it must be rebuilt: %w", err)
+		}
+	}
+
+	return nil
+}
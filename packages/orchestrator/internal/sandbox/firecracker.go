@@ -0,0 +1,43 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// selectFirecracker returns the Firecracker version that can resume the snapshot of the template.
+// The config is updated to the selected version, so the snapshots of the sandbox record the Firecracker that created them.
+func selectFirecracker(ctx context.Context, t template.Template, config *orchestrator.SandboxConfig) (string, error) {
+	metadata, err := t.SnapshotMetadata()
+	if err != nil {
+		return "", fmt.Errorf("failed to get snapshot metadata: %w", err)
+	}
+
+	version, err := fc.SelectFirecracker(ctx, metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to select Firecracker version: %w", err)
+	}
+
+	if version != config.FirecrackerVersion {
+		zap.L().Info("resuming snapshot with a different Firecracker version",
+			logger.WithSandboxID(config.SandboxId),
+			logger.WithBuildID(config.BuildId),
+			zap.String("snapshot_firecracker_version", metadata.FirecrackerVersion),
+			zap.String("firecracker_version", version),
+		)
+
+		config.FirecrackerVersion = version
+	}
+
+	return version, nil
+}
@@ -21,7 +21,7 @@ func (c *Checks) GetHealth(timeout time.Duration) (bool, error) {
 		return false, err
 	}
 
-	response, err := httpClient.Do(request)
+	response, err := c.sandbox.envdClient().Do(request)
 	if err != nil {
 		return false, err
 	}
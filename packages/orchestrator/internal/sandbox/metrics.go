@@ -29,11 +29,11 @@ func (c *Checks) GetMetrics(timeout time.Duration) (*Metrics, error) {
 		return nil, err
 	}
 
-	if c.sandbox.Metadata.Config.EnvdAccessToken != nil {
-		request.Header.Set("X-Access-Token", *c.sandbox.Metadata.Config.EnvdAccessToken)
+	if token := c.sandbox.config().EnvdAccessToken; token != nil {
+		request.Header.Set("X-Access-Token", *token)
 	}
 
-	response, err := httpClient.Do(request)
+	response, err := c.sandbox.envdClient().Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,11 @@ type Provider interface {
 	Size() (int64, error)
 }
 
+// Trimmer is implemented by providers that can discard ranges.
+type Trimmer interface {
+	Trim(off, length int64) error
+}
+
 const dispatchBufferSize = 4 * 1024 * 1024
 
 // NBD Commands
@@ -299,21 +304,19 @@ func (d *Dispatch) cmdWrite(cmdHandle uint64, cmdFrom uint64, cmdData []byte) er
  * cmdTrim
  *
  */
-func (d *Dispatch) cmdTrim(handle uint64, _ uint64, _ uint32) error {
-	// TODO: Ask the provider
-	/*
-		e := d.prov.Trim(from, length)
-		if e != storage.StorageError_SUCCESS {
-			err := d.writeResponse(1, handle, []byte{})
-			if err != nil {
-				return err
-			}
-		} else {
-	*/
-	err := d.writeResponse(0, handle, []byte{})
+func (d *Dispatch) cmdTrim(handle uint64, from uint64, length uint32) error {
+	trimmer, ok := d.prov.(Trimmer)
+	if !ok {
+		// Trim is only advisory, so there is nothing to do when the provider cannot discard data.
+		return d.writeResponse(0, handle, []byte{})
+	}
+
+	err := trimmer.Trim(int64(from), int64(length))
 	if err != nil {
-		return err
+		zap.L().Error("nbd error cmd trim", zap.Uint64("from", from), zap.Uint32("length", length), zap.Error(err))
+
+		return d.writeResponse(1, handle, []byte{})
 	}
-	//	}
-	return nil
+
+	return d.writeResponse(0, handle, []byte{})
 }
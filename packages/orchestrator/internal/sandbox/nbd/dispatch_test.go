@@ -0,0 +1,117 @@
+package nbd
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// zeroDevice is a template device that contains only zeroes.
+type zeroDevice struct {
+	size      int64
+	blockSize int64
+}
+
+func (z *zeroDevice) ReadAt(p []byte, _ int64) (int, error) {
+	clear(p)
+
+	return len(p), nil
+}
+
+func (z *zeroDevice) Slice(_, length int64) ([]byte, error) {
+	return make([]byte, length), nil
+}
+
+func (z *zeroDevice) Size() (int64, error) {
+	return z.size, nil
+}
+
+func (z *zeroDevice) BlockSize() int64 {
+	return z.blockSize
+}
+
+func (z *zeroDevice) Header() *header.Header {
+	return nil
+}
+
+func (z *zeroDevice) Close() error {
+	return nil
+}
+
+func sendRequest(t *testing.T, conn net.Conn, cmd uint32, handle, from uint64, length uint32, data []byte) {
+	t.Helper()
+
+	request := make([]byte, 28)
+	binary.BigEndian.PutUint32(request, NBDRequestMagic)
+	binary.BigEndian.PutUint32(request[4:], cmd)
+	binary.BigEndian.PutUint64(request[8:], handle)
+	binary.BigEndian.PutUint64(request[16:], from)
+	binary.BigEndian.PutUint32(request[24:], length)
+
+	_, err := conn.Write(append(request, data...))
+	require.NoError(t, err)
+}
+
+func readResponse(t *testing.T, conn net.Conn, handle uint64) {
+	t.Helper()
+
+	response := make([]byte, 16)
+	_, err := io.ReadFull(conn, response)
+	require.NoError(t, err)
+
+	require.Equal(t, uint32(NBDResponseMagic), binary.BigEndian.Uint32(response))
+	require.Zero(t, binary.BigEndian.Uint32(response[4:]), "request failed")
+	require.Equal(t, handle, binary.BigEndian.Uint64(response[8:]))
+}
+
+// The guest discards the blocks of deleted files, the NBD trim requests mark them as empty in the rootfs diff.
+func TestDispatch_TrimMarksOverlayBlocksEmpty(t *testing.T) {
+	blockSize := int64(header.RootfsBlockSize)
+	size := 4 * blockSize
+
+	cache, err := block.NewCache(size, blockSize, filepath.Join(t.TempDir(), "cache"), false)
+	require.NoError(t, err)
+
+	overlay := block.NewOverlay(&zeroDevice{size: size, blockSize: blockSize}, cache, blockSize)
+	defer overlay.Close()
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	dispatch := NewDispatch(context.Background(), server, overlay)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- dispatch.Handle()
+	}()
+
+	data := bytes.Repeat([]byte{1}, int(3*blockSize))
+	sendRequest(t, client, NBDCmdWrite, 1, 0, uint32(len(data)), data)
+	readResponse(t, client, 1)
+
+	sendRequest(t, client, NBDCmdTrim, 2, uint64(blockSize), uint32(blockSize), nil)
+	readResponse(t, client, 2)
+
+	sendRequest(t, client, NBDCmdDisconnect, 3, 0, 0, nil)
+	require.NoError(t, <-done)
+
+	var diff bytes.Buffer
+	m, err := overlay.ExportToDiff(&diff)
+	require.NoError(t, err)
+
+	assert.True(t, m.Dirty.Test(0))
+	assert.False(t, m.Dirty.Test(1))
+	assert.True(t, m.Dirty.Test(2))
+	assert.True(t, m.Empty.Test(1))
+	assert.Equal(t, bytes.Repeat([]byte{1}, int(2*blockSize)), diff.Bytes())
+}
@@ -0,0 +1,73 @@
+//go:build linux
+// +build linux
+
+package nbd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// DisconnectOrphaned disconnects the devices connected by processes that are not running anymore.
+// The devices are served from the process that connected them, so they can't be used after it crashes.
+func DisconnectOrphaned(ctx context.Context) (int, error) {
+	pidFiles, err := filepath.Glob("/sys/block/nbd*/pid")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	disconnected := 0
+	var errs []error
+	for _, pidFile := range pidFiles {
+		name := filepath.Base(filepath.Dir(pidFile))
+
+		slot, err := strconv.ParseUint(strings.TrimPrefix(name, "nbd"), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		data, err := os.ReadFile(pidFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// The device was disconnected in the meantime.
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("failed to read pid of %s: %w", name, err))
+
+			continue
+		}
+
+		pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse pid of %s: %w", name, err))
+
+			continue
+		}
+
+		if pid != 0 && utils.ProcessExists(pid) {
+			continue
+		}
+
+		err = disconnectNBDWithTimeout(ctx, DeviceSlot(slot), disconnectTimeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to disconnect %s: %w", name, err))
+
+			continue
+		}
+
+		zap.L().Info("Disconnected orphaned NBD device", zap.String("device", GetDevicePath(DeviceSlot(slot))), zap.Int("pid", pid))
+		disconnected++
+	}
+
+	return disconnected, errors.Join(errs...)
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package nbd
+
+import (
+	"context"
+)
+
+func DisconnectOrphaned(_ context.Context) (int, error) {
+	return 0, nil
+}
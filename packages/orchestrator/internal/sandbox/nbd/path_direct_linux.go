@@ -131,6 +131,9 @@ func (d *DirectPathMount) Open(ctx context.Context) (retDeviceIndex uint32, err
 		opts = append(opts, nbdnl.WithDeadconnTimeout(connectTimeout))
 
 		serverFlags := nbdnl.FlagHasFlags | nbdnl.FlagCanMulticonn
+		if _, ok := d.Backend.(Trimmer); ok {
+			serverFlags |= nbdnl.FlagSendTrim
+		}
 
 		idx, err := nbdnl.Connect(deviceIndex, d.socksClient, uint64(size), 0, serverFlags, opts...)
 		if err == nil {
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
 	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
 )
 
 func cleanupDanglingNamespace(slot *Slot) error {
@@ -56,3 +58,70 @@ func cleanupDanglingNamespace(slot *Slot) error {
 
 	return nil
 }
+
+// cleanupOrphanedSlot kills the processes in the slot's namespace and removes its network.
+func cleanupOrphanedSlot(slot *Slot) error {
+	nsPath := filepath.Join(netNamespacesDir, slot.NamespaceID())
+
+	_, err := os.Stat(nsPath)
+	if errors.Is(err, os.ErrNotExist) {
+		// The network was never created for the slot or it was already removed.
+		return cleanupDanglingNamespace(slot)
+	}
+
+	err = killNamespaceProcesses(nsPath)
+	if err != nil {
+		return fmt.Errorf("failed to kill processes in namespace %s: %w", slot.NamespaceID(), err)
+	}
+
+	err = slot.RemoveNetwork()
+	if err != nil {
+		// The network may be only partially created, remove what is left of it.
+		zap.L().Warn("failed to remove orphaned slot network", zap.String("namespace", slot.NamespaceID()), zap.Error(err))
+
+		return cleanupDanglingNamespace(slot)
+	}
+
+	return nil
+}
+
+// killNamespaceProcesses kills all processes running in the network namespace.
+func killNamespaceProcesses(nsPath string) error {
+	var nsStat unix.Stat_t
+	err := unix.Stat(nsPath, &nsStat)
+	if err != nil {
+		return fmt.Errorf("failed to stat namespace: %w", err)
+	}
+
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return fmt.Errorf("failed to read processes: %w", err)
+	}
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		var procStat unix.Stat_t
+		err = unix.Stat(fmt.Sprintf("/proc/%d/ns/net", pid), &procStat)
+		if err != nil {
+			// The process exited in the meantime.
+			continue
+		}
+
+		if procStat.Dev != nsStat.Dev || procStat.Ino != nsStat.Ino {
+			continue
+		}
+
+		err = unix.Kill(pid, unix.SIGKILL)
+		if err != nil && !errors.Is(err, unix.ESRCH) {
+			return fmt.Errorf("failed to kill process %d: %w", pid, err)
+		}
+
+		zap.L().Info("Killed orphaned process", zap.Int("pid", pid), zap.String("namespace", filepath.Base(nsPath)))
+	}
+
+	return nil
+}
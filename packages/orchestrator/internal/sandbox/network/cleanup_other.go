@@ -5,3 +5,7 @@ package network
 func cleanupDanglingNamespace(_ *Slot) error {
 	return nil
 }
+
+func cleanupOrphanedSlot(_ *Slot) error {
+	return nil
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	resolver "github.com/miekg/dns"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const dnsPort = 53
+
+// dnsUpstream is the resolver the allowed queries are forwarded to.
+var dnsUpstream = env.GetEnv("EGRESS_DNS_UPSTREAM", "8.8.8.8:53")
+
+// resolvedAddress is an address from a DNS answer, it's allowed for the TTL of its record.
+type resolvedAddress struct {
+	Addr netip.Addr
+	TTL  time.Duration
+}
+
+// dnsInterceptor answers the DNS queries of a sandbox with an egress domain allow-list.
+// Only the allowed domains are resolved and their addresses are allowed in the slot firewall before the answer is returned.
+type dnsInterceptor struct {
+	domains  []string
+	upstream string
+	allow    func(addrs []resolvedAddress) error
+
+	client *resolver.Client
+	udp    *resolver.Server
+	tcp    *resolver.Server
+
+	closeOnce sync.Once
+}
+
+func newDNSInterceptor(domains []string, upstream string, allow func(addrs []resolvedAddress) error) *dnsInterceptor {
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		normalized = append(normalized, resolver.CanonicalName(domain))
+	}
+
+	return &dnsInterceptor{
+		domains:  normalized,
+		upstream: upstream,
+		allow:    allow,
+		client:   &resolver.Client{},
+	}
+}
+
+// Start listens on the address for UDP and TCP queries.
+func (i *dnsInterceptor) Start(addr string) error {
+	packetConn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on udp %s: %w", addr, err)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to listen on tcp %s: %w", addr, err), packetConn.Close())
+	}
+
+	i.udp = &resolver.Server{PacketConn: packetConn, Handler: i}
+	i.tcp = &resolver.Server{Listener: listener, Handler: i}
+
+	for _, srv := range []*resolver.Server{i.udp, i.tcp} {
+		go func() {
+			if err := srv.ActivateAndServe(); err != nil {
+				zap.L().Error("egress DNS interceptor stopped", zap.String("addr", addr), zap.Error(err))
+			}
+		}()
+	}
+
+	return nil
+}
+
+func (i *dnsInterceptor) Close() error {
+	var errs []error
+
+	i.closeOnce.Do(func() {
+		for _, srv := range []*resolver.Server{i.udp, i.tcp} {
+			if srv == nil {
+				continue
+			}
+
+			if err := srv.Shutdown(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+func (i *dnsInterceptor) ServeDNS(w resolver.ResponseWriter, r *resolver.Msg) {
+	if len(r.Question) != 1 || !i.allowed(r.Question[0].Name) {
+		i.reply(w, r, resolver.RcodeRefused)
+
+		return
+	}
+
+	network := "udp"
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		network = "tcp"
+	}
+
+	client := *i.client
+	client.Net = network
+
+	res, _, err := client.Exchange(r, i.upstream)
+	if err != nil {
+		zap.L().Warn("failed to forward egress DNS query", zap.String("name", r.Question[0].Name), zap.Error(err))
+		i.reply(w, r, resolver.RcodeServerFailure)
+
+		return
+	}
+
+	var addrs []resolvedAddress
+	for _, rr := range res.Answer {
+		var ip []byte
+		switch record := rr.(type) {
+		case *resolver.A:
+			ip = record.A
+		case *resolver.AAAA:
+			ip = record.AAAA
+		default:
+			continue
+		}
+
+		if addr, ok := netip.AddrFromSlice(ip); ok {
+			addrs = append(addrs, resolvedAddress{
+				Addr: addr.Unmap(),
+				TTL:  time.Duration(rr.Header().Ttl) * time.Second,
+			})
+		}
+	}
+
+	if len(addrs) > 0 {
+		if err := i.allow(addrs); err != nil {
+			zap.L().Error("failed to allow resolved addresses", zap.String("name", r.Question[0].Name), zap.Error(err))
+			i.reply(w, r, resolver.RcodeServerFailure)
+
+			return
+		}
+	}
+
+	if err := w.WriteMsg(res); err != nil {
+		zap.L().Debug("failed to write egress DNS answer", zap.Error(err))
+	}
+}
+
+func (i *dnsInterceptor) reply(w resolver.ResponseWriter, r *resolver.Msg, rcode int) {
+	m := new(resolver.Msg)
+	m.SetRcode(r, rcode)
+
+	if err := w.WriteMsg(m); err != nil {
+		zap.L().Debug("failed to write egress DNS answer", zap.Error(err))
+	}
+}
+
+func (i *dnsInterceptor) allowed(name string) bool {
+	return matchDomain(i.domains, resolver.CanonicalName(name))
+}
+
+// matchDomain reports whether the canonical name matches one of the canonical patterns,
+// *.example.com matches the subdomains of example.com, but not example.com itself.
+func matchDomain(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(name, "."+suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if name == pattern {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package network
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	resolver "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUpstream answers all the A queries with 93.184.216.34 and the AAAA queries with 2606:2800:220:1::25c8:1946.
+func fakeUpstream(t *testing.T) string {
+	t.Helper()
+
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &resolver.Server{
+		PacketConn: packetConn,
+		Handler: resolver.HandlerFunc(func(w resolver.ResponseWriter, r *resolver.Msg) {
+			m := new(resolver.Msg)
+			m.SetReply(r)
+
+			if r.Question[0].Qtype == resolver.TypeAAAA {
+				m.Answer = append(m.Answer, &resolver.AAAA{
+					Hdr:  resolver.RR_Header{Name: r.Question[0].Name, Rrtype: resolver.TypeAAAA, Class: resolver.ClassINET, Ttl: 60},
+					AAAA: net.ParseIP("2606:2800:220:1::25c8:1946"),
+				})
+			} else {
+				m.Answer = append(m.Answer, &resolver.A{
+					Hdr: resolver.RR_Header{Name: r.Question[0].Name, Rrtype: resolver.TypeA, Class: resolver.ClassINET, Ttl: 60},
+					A:   net.ParseIP("93.184.216.34"),
+				})
+			}
+
+			_ = w.WriteMsg(m)
+		}),
+	}
+
+	go func() { _ = srv.ActivateAndServe() }()
+	t.Cleanup(func() { _ = srv.Shutdown() })
+
+	return packetConn.LocalAddr().String()
+}
+
+func TestMatchDomain(t *testing.T) {
+	patterns := []string{"example.com.", "*.pypi.org."}
+
+	assert.True(t, matchDomain(patterns, "example.com."))
+	assert.False(t, matchDomain(patterns, "www.example.com."))
+	assert.True(t, matchDomain(patterns, "files.pypi.org."))
+	assert.True(t, matchDomain(patterns, "a.files.pypi.org."))
+	assert.False(t, matchDomain(patterns, "pypi.org."))
+	assert.False(t, matchDomain(patterns, "evilpypi.org."))
+}
+
+func TestDNSInterceptor(t *testing.T) {
+	var mu sync.Mutex
+	var allowed []resolvedAddress
+
+	interceptor := newDNSInterceptor([]string{"Example.com", "*.pypi.org"}, fakeUpstream(t), func(addrs []resolvedAddress) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		allowed = append(allowed, addrs...)
+
+		return nil
+	})
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.LocalAddr().String()
+	require.NoError(t, listener.Close())
+
+	require.NoError(t, interceptor.Start(addr))
+	t.Cleanup(func() { _ = interceptor.Close() })
+
+	client := &resolver.Client{}
+
+	m := new(resolver.Msg)
+	m.SetQuestion("files.pypi.org.", resolver.TypeA)
+
+	res, _, err := client.Exchange(m, addr)
+	require.NoError(t, err)
+	assert.Equal(t, resolver.RcodeSuccess, res.Rcode)
+	require.Len(t, res.Answer, 1)
+
+	m.SetQuestion("example.com.", resolver.TypeAAAA)
+
+	res, _, err = client.Exchange(m, addr)
+	require.NoError(t, err)
+	assert.Equal(t, resolver.RcodeSuccess, res.Rcode)
+	require.Len(t, res.Answer, 1)
+
+	m.SetQuestion("example.org.", resolver.TypeA)
+
+	res, _, err = client.Exchange(m, addr)
+	require.NoError(t, err)
+	assert.Equal(t, resolver.RcodeRefused, res.Rcode)
+	assert.Empty(t, res.Answer)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []resolvedAddress{
+		{Addr: netip.MustParseAddr("93.184.216.34"), TTL: time.Minute},
+		{Addr: netip.MustParseAddr("2606:2800:220:1::25c8:1946"), TTL: time.Minute},
+	}, allowed)
+}
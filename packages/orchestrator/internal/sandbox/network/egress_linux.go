@@ -0,0 +1,55 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/coreos/go-iptables/iptables"
+)
+
+// dnsRedirectRules are the rules redirecting the DNS queries of the sandbox to the slot DNS interceptor.
+func (s *Slot) dnsRedirectRules() [][]string {
+	destination := s.VethIP().String() + ":" + strconv.Itoa(dnsPort)
+
+	return [][]string{
+		{"-i", s.TapName(), "-p", "udp", "--dport", strconv.Itoa(dnsPort), "-j", "DNAT", "--to-destination", destination},
+		{"-i", s.TapName(), "-p", "tcp", "--dport", strconv.Itoa(dnsPort), "-j", "DNAT", "--to-destination", destination},
+	}
+}
+
+// redirectDNS redirects the DNS queries to the slot DNS interceptor, it must be called in the slot network namespace.
+func (s *Slot) redirectDNS() error {
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	for _, rule := range s.dnsRedirectRules() {
+		err = tables.AppendUnique("nat", "PREROUTING", rule...)
+		if err != nil {
+			return fmt.Errorf("error creating DNS redirect rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeDNSRedirect removes the DNS redirect rules, it must be called in the slot network namespace.
+func (s *Slot) removeDNSRedirect() error {
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	for _, rule := range s.dnsRedirectRules() {
+		err = tables.DeleteIfExists("nat", "PREROUTING", rule...)
+		if err != nil {
+			return fmt.Errorf("error deleting DNS redirect rule: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+)
+
+func (s *Slot) redirectDNS() error {
+	return errors.New("platform does not support DNS redirect")
+}
+
+func (s *Slot) removeDNSRedirect() error {
+	return nil
+}
@@ -4,25 +4,34 @@ import (
 	"fmt"
 	"net/netip"
 	"os"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
 	"github.com/google/nftables/expr"
 	"github.com/ngrok/firewall_toolkit/pkg/expressions"
 	"github.com/ngrok/firewall_toolkit/pkg/rule"
 	"github.com/ngrok/firewall_toolkit/pkg/set"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 )
 
 const (
 	tableName = "slot-firewall"
-)
 
-var blockedRanges = []string{
-	"10.0.0.0/8",
-	"169.254.0.0/16",
-	"192.168.0.0/16",
-	"172.16.0.0/12",
-}
+	// ctStatusDstNAT is the IPS_DST_NAT conntrack status bit, set on the connections with the destination rewritten by DNAT.
+	ctStatusDstNAT uint32 = 1 << 5
+
+	// connectionsCounterName is the counter of the new connections opened by the sandbox.
+	connectionsCounterName = "new_connections"
+
+	// minResolvedTimeout is the shortest time a resolved address is allowed for, the sandbox has to be able to connect
+	// to it after the answer even if the record has no TTL.
+	minResolvedTimeout = 30 * time.Second
+)
 
 type Firewall struct {
 	conn         *nftables.Conn
@@ -30,7 +39,32 @@ type Firewall struct {
 	chain        *nftables.Chain
 	blockSet     set.Set
 	allowSet     set.Set
+	blockSet6    set.Set
+	allowSet6    set.Set
 	tapInterface string
+
+	// deniedSet and deniedSet6 have the custom blocked CIDRs, unlike the block sets they are checked after the allowed ones.
+	deniedSet  set.Set
+	deniedSet6 set.Set
+
+	// networkSet has the team networks range, only the peers of the sandbox are reachable in it.
+	networkSet set.Set
+	peersSet   set.Set
+
+	// resolvedSet and resolvedSet6 have the addresses resolved for the allowed domains, they expire by the TTL of their records.
+	resolvedSet  *nftables.Set
+	resolvedSet6 *nftables.Set
+
+	connections *nftables.CounterObj
+
+	mu sync.Mutex
+	// customAllowed and customBlocked are the CIDRs added on top of the original ranges of the sets.
+	customAllowed []netip.Prefix
+	customBlocked []netip.Prefix
+	// peers are the addresses of the sandboxes in the same team network.
+	peers []netip.Addr
+	// resolved are the expiration times of the addresses in the resolved sets.
+	resolved map[netip.Addr]time.Time
 }
 
 func NewFirewall(tapIf string) (*Firewall, error) {
@@ -62,6 +96,45 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new allow set: %w", err)
 	}
+	blockSet6, err := set.New(conn, table, "filtered_blocklist6", nftables.TypeIP6Addr)
+	if err != nil {
+		return nil, fmt.Errorf("new IPv6 block set: %w", err)
+	}
+	allowSet6, err := set.New(conn, table, "filtered_allowlist6", nftables.TypeIP6Addr)
+	if err != nil {
+		return nil, fmt.Errorf("new IPv6 allow set: %w", err)
+	}
+	deniedSet, err := set.New(conn, table, "filtered_denylist", nftables.TypeIPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("new deny set: %w", err)
+	}
+	deniedSet6, err := set.New(conn, table, "filtered_denylist6", nftables.TypeIP6Addr)
+	if err != nil {
+		return nil, fmt.Errorf("new IPv6 deny set: %w", err)
+	}
+	networkSet, err := set.New(conn, table, "team_networks", nftables.TypeIPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("new team networks set: %w", err)
+	}
+	peersSet, err := set.New(conn, table, "team_peers", nftables.TypeIPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("new team peers set: %w", err)
+	}
+
+	resolvedSet := &nftables.Set{Table: table, Name: "resolved_allowlist", KeyType: nftables.TypeIPAddr, HasTimeout: true}
+	if err := conn.AddSet(resolvedSet, nil); err != nil {
+		return nil, fmt.Errorf("new resolved set: %w", err)
+	}
+	resolvedSet6 := &nftables.Set{Table: table, Name: "resolved_allowlist6", KeyType: nftables.TypeIP6Addr, HasTimeout: true}
+	if err := conn.AddSet(resolvedSet6, nil); err != nil {
+		return nil, fmt.Errorf("new IPv6 resolved set: %w", err)
+	}
+
+	connections := &nftables.CounterObj{
+		Table: table,
+		Name:  connectionsCounterName,
+	}
+	conn.AddObj(connections)
 
 	fw := &Firewall{
 		conn:         conn,
@@ -69,7 +142,17 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		chain:        chain,
 		blockSet:     blockSet,
 		allowSet:     allowSet,
+		blockSet6:    blockSet6,
+		allowSet6:    allowSet6,
 		tapInterface: tapIf,
+		deniedSet:    deniedSet,
+		deniedSet6:   deniedSet6,
+		networkSet:   networkSet,
+		peersSet:     peersSet,
+		resolvedSet:  resolvedSet,
+		resolvedSet6: resolvedSet6,
+		connections:  connections,
+		resolved:     make(map[netip.Addr]time.Time),
 	}
 
 	// Add firewall rules to the chain
@@ -82,6 +165,11 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error while configuring initial block set: %w", err)
 	}
+
+	err = fw.initTeamNetworks()
+	if err != nil {
+		return nil, fmt.Errorf("error while configuring team networks set: %w", err)
+	}
 	return fw, nil
 }
 
@@ -102,6 +190,54 @@ func (fw *Firewall) installRules() error {
 		},
 	}
 
+	// helpers for the address family of the packet, the addresses are loaded from different offsets of the IPv4 and IPv6 headers
+	ipv4Match := slices.Clip(append(slices.Clone(ifaceMatch),
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{byte(nftables.TableFamilyIPv4)}},
+	))
+	ipv6Match := slices.Clip(append(slices.Clone(ifaceMatch),
+		&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{byte(nftables.TableFamilyIPv6)}},
+	))
+
+	// The rules are appended in the order they are evaluated in.
+	// Count the new connections of the sandbox, the rule is first so the connections are counted before any verdict.
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ifaceMatch,
+			&expr.Ct{Register: 1, Key: expr.CtKeySTATE},
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(expr.CtStateBitNEW),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(0)},
+			&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: connectionsCounterName},
+		),
+	})
+
+	// Allow the peers in the team network
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
+			expressions.IPv4DestinationAddress(1),
+			expressions.IPSetLookUp(fw.peersSet.Set(), 1),
+			expressions.Accept(),
+		),
+	})
+
+	// Drop the team networks traffic to the sandboxes that are not peers, the custom allowed CIDRs can't override it.
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
+			expressions.IPv4DestinationAddress(1),
+			expressions.IPSetLookUp(fw.networkSet.Set(), 1),
+			expressions.Drop(),
+		),
+	})
+
 	// Allow ESTABLISHED,RELATED
 	exprs, err := rule.Build(
 		expr.VerdictAccept,
@@ -112,29 +248,126 @@ func (fw *Firewall) installRules() error {
 	if err != nil {
 		return fmt.Errorf("build rule for established/related: %w", err)
 	}
-	fw.conn.InsertRule(&nftables.Rule{
+	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
 		Exprs: append(ifaceMatch,
 			exprs...,
 		),
 	})
 
-	// Allow anything in allowSet
-	fw.conn.InsertRule(&nftables.Rule{
+	// Allow the connections redirected by DNAT in the namespace (DNS queries for the egress policy),
+	// the redirect target is in the blocked ranges.
+	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
 		Exprs: append(ifaceMatch,
+			&expr.Ct{Register: 1, Key: expr.CtKeySTATUS},
+			&expr.Bitwise{
+				SourceRegister: 1,
+				DestRegister:   1,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(ctStatusDstNAT),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: binaryutil.NativeEndian.PutUint32(0)},
+			expressions.Accept(),
+		),
+	})
+
+	// Allow Logs Collector IP for logs, it can be in the blocked ranges.
+	if ip := os.Getenv("LOGS_COLLECTOR_PUBLIC_IP"); ip != "" {
+		collector, err := parsePrefix(strings.TrimPrefix(ip, "http://"))
+		if err != nil {
+			return fmt.Errorf("parse logs collector IP: %w", err)
+		}
+
+		if collector.Addr().Is4() {
+			fw.conn.AddRule(&nftables.Rule{
+				Table: fw.table, Chain: fw.chain,
+				Exprs: append(ipv4Match,
+					expressions.IPv4DestinationAddress(1),
+					&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: collector.Addr().AsSlice()},
+					expressions.Accept(),
+				),
+			})
+		}
+	}
+
+	// Drop anything in blockSet, the egress policy can't allow the blocked ranges.
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
+			expressions.IPv4DestinationAddress(1),
+			expressions.IPSetLookUp(fw.blockSet.Set(), 1),
+			expressions.Drop(),
+		),
+	})
+
+	// Drop anything in blockSet6
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv6Match,
+			expressions.IPv6DestinationAddress(1),
+			expressions.IPSetLookUp(fw.blockSet6.Set(), 1),
+			expressions.Drop(),
+		),
+	})
+
+	// Allow anything in allowSet
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
 			expressions.IPv4DestinationAddress(1),
 			expressions.IPSetLookUp(fw.allowSet.Set(), 1),
 			expressions.Accept(),
 		),
 	})
 
-	// Drop anything in blockSet
+	// Allow anything in allowSet6
 	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
-		Exprs: append(ifaceMatch,
+		Exprs: append(ipv6Match,
+			expressions.IPv6DestinationAddress(1),
+			expressions.IPSetLookUp(fw.allowSet6.Set(), 1),
+			expressions.Accept(),
+		),
+	})
+
+	// Allow the addresses resolved for the allowed domains
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
 			expressions.IPv4DestinationAddress(1),
-			expressions.IPSetLookUp(fw.blockSet.Set(), 1),
+			expressions.IPSetLookUp(fw.resolvedSet, 1),
+			expressions.Accept(),
+		),
+	})
+
+	// Allow the IPv6 addresses resolved for the allowed domains
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv6Match,
+			expressions.IPv6DestinationAddress(1),
+			expressions.IPSetLookUp(fw.resolvedSet6, 1),
+			expressions.Accept(),
+		),
+	})
+
+	// Drop anything in deniedSet
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv4Match,
+			expressions.IPv4DestinationAddress(1),
+			expressions.IPSetLookUp(fw.deniedSet.Set(), 1),
+			expressions.Drop(),
+		),
+	})
+
+	// Drop anything in deniedSet6
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ipv6Match,
+			expressions.IPv6DestinationAddress(1),
+			expressions.IPSetLookUp(fw.deniedSet6.Set(), 1),
 			expressions.Drop(),
 		),
 	})
@@ -146,40 +379,38 @@ func (fw *Firewall) installRules() error {
 	return nil
 }
 
+// NewConnections returns the number of the new connections opened by the sandbox since the firewall was created.
+func (fw *Firewall) NewConnections() (uint64, error) {
+	obj, err := fw.conn.GetObject(fw.connections)
+	if err != nil {
+		return 0, fmt.Errorf("get new connections counter: %w", err)
+	}
+
+	counter, ok := obj.(*nftables.CounterObj)
+	if !ok {
+		return 0, fmt.Errorf("unexpected new connections counter type %T", obj)
+	}
+
+	return counter.Packets, nil
+}
+
 // AddBlockedIP adds a single CIDR to the block set at runtime.
 func (fw *Firewall) AddBlockedIP(cidr string) error {
-	// 0.0.0.0/0 is not valid IP per GoLang, so we handle it as a special case
-	if cidr == "0.0.0.0/0" {
-		fw.conn.FlushSet(fw.blockSet.Set())
-
-		toAppend := []nftables.SetElement{
-			{Key: netip.MustParseAddr("0.0.0.0").AsSlice()},
-			{
-				Key:         netip.MustParseAddr("255.255.255.255").AsSlice(),
-				IntervalEnd: true,
-			},
-		}
+	prefix, err := parsePrefix(cidr)
+	if err != nil {
+		return err
+	}
 
-		if err := fw.conn.SetAddElements(fw.blockSet.Set(), toAppend); err != nil {
-			return fmt.Errorf("add elements to block set: %w", err)
-		}
-	} else {
-		current, err := fw.blockSet.Elements(fw.conn)
-		if err != nil {
-			return err
-		}
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
 
-		data, err := set.AddressStringsToSetData([]string{cidr})
-		if err != nil {
-			return err
-		}
-		merged := append(current, data...)
-		if err := fw.blockSet.ClearAndAddElements(fw.conn, merged); err != nil {
-			return err
-		}
+	fw.customBlocked = append(fw.customBlocked, prefix)
+
+	if err := fw.syncBlocked(); err != nil {
+		return err
 	}
 
-	err := fw.conn.Flush()
+	err = fw.conn.Flush()
 	if err != nil {
 		return fmt.Errorf("flush add blocked IP changes: %w", err)
 	}
@@ -188,16 +419,26 @@ func (fw *Firewall) AddBlockedIP(cidr string) error {
 
 // AddAllowedIP adds a single CIDR to the allow set at runtime.
 func (fw *Firewall) AddAllowedIP(cidr string) error {
-	data, err := set.AddressStringsToSetData([]string{cidr})
+	prefix, err := parsePrefix(cidr)
 	if err != nil {
 		return err
 	}
-	current, err := fw.allowSet.Elements(fw.conn)
+
+	blocked, err := blockedPrefixes()
 	if err != nil {
 		return err
 	}
-	merged := append(current, data...)
-	if err := fw.allowSet.ClearAndAddElements(fw.conn, merged); err != nil {
+
+	if insidePrefixes(blocked, prefix) {
+		return fmt.Errorf("CIDR '%s' is in the blocked ranges", cidr)
+	}
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	fw.customAllowed = append(fw.customAllowed, prefix)
+
+	if err := fw.syncAllowed(); err != nil {
 		return err
 	}
 
@@ -208,6 +449,176 @@ func (fw *Firewall) AddAllowedIP(cidr string) error {
 	return nil
 }
 
+// SetCustom replaces the custom allowed and blocked CIDRs, the allowed CIDRs take precedence over the blocked ones.
+// The allowed CIDRs in the blocked ranges are dropped, the blocked ranges stay unreachable.
+func (fw *Firewall) SetCustom(allowed, blocked []string) error {
+	allowedPrefixes, err := parsePrefixes(allowed)
+	if err != nil {
+		return fmt.Errorf("parse allowed CIDRs: %w", err)
+	}
+
+	deniedPrefixes, err := parsePrefixes(blocked)
+	if err != nil {
+		return fmt.Errorf("parse blocked CIDRs: %w", err)
+	}
+
+	blockedRanges, err := blockedPrefixes()
+	if err != nil {
+		return err
+	}
+
+	allowedPrefixes = slices.DeleteFunc(allowedPrefixes, func(prefix netip.Prefix) bool {
+		return insidePrefixes(blockedRanges, prefix)
+	})
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	fw.customAllowed = allowedPrefixes
+	fw.customBlocked = deniedPrefixes
+
+	if err := fw.syncAllowed(); err != nil {
+		return err
+	}
+
+	if err := fw.syncBlocked(); err != nil {
+		return err
+	}
+
+	err = fw.conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush custom rules changes: %w", err)
+	}
+	return nil
+}
+
+// AllowResolved allows the addresses until the TTL of their records expires, the addresses resolved again get their timeout refreshed.
+// The addresses in the blocked ranges are skipped, so the allowed domains can't be pointed at the node or its network.
+func (fw *Firewall) AllowResolved(addrs []resolvedAddress) error {
+	blocked, err := blockedPrefixes()
+	if err != nil {
+		return err
+	}
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	now := time.Now()
+
+	for addr, expiresAt := range fw.resolved {
+		if !expiresAt.After(now) {
+			delete(fw.resolved, addr)
+		}
+	}
+
+	for _, resolved := range addrs {
+		addr := resolved.Addr.Unmap()
+		if insidePrefixes(blocked, netip.PrefixFrom(addr, addr.BitLen())) {
+			continue
+		}
+
+		timeout := max(resolved.TTL, minResolvedTimeout)
+
+		expiresAt, ok := fw.resolved[addr]
+		if ok && !expiresAt.Before(now.Add(timeout)) {
+			continue
+		}
+
+		err := fw.allowResolved(addr, timeout, ok)
+		if err != nil {
+			return err
+		}
+
+		fw.resolved[addr] = now.Add(timeout)
+	}
+
+	return nil
+}
+
+// allowResolved adds the address to the resolved set, the element in the set is replaced to refresh its timeout.
+func (fw *Firewall) allowResolved(addr netip.Addr, timeout time.Duration, refresh bool) error {
+	s := fw.resolvedSet
+	if addr.Is6() {
+		s = fw.resolvedSet6
+	}
+
+	elements := []nftables.SetElement{{Key: addr.AsSlice(), Timeout: timeout}}
+
+	if refresh {
+		if err := fw.conn.SetDeleteElements(s, elements); err != nil {
+			return fmt.Errorf("delete resolved address: %w", err)
+		}
+
+		if err := fw.conn.SetAddElements(s, elements); err != nil {
+			return fmt.Errorf("add resolved address: %w", err)
+		}
+
+		// The element may have expired in the meantime, then it's only added.
+		if err := fw.conn.Flush(); err == nil {
+			return nil
+		}
+	}
+
+	if err := fw.conn.SetAddElements(s, elements); err != nil {
+		return fmt.Errorf("add resolved address: %w", err)
+	}
+
+	err := fw.conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush resolved address changes: %w", err)
+	}
+	return nil
+}
+
+// SetPeers replaces the addresses of the sandboxes reachable in the team network, it only touches the set when they change.
+func (fw *Firewall) SetPeers(peers []netip.Addr) error {
+	sorted := slices.Clone(peers)
+	slices.SortFunc(sorted, func(a, b netip.Addr) int { return a.Compare(b) })
+	sorted = slices.Compact(sorted)
+
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	if slices.Equal(fw.peers, sorted) {
+		return nil
+	}
+
+	fw.peers = sorted
+
+	if len(sorted) == 0 {
+		fw.conn.FlushSet(fw.peersSet.Set())
+	} else {
+		prefixes := make([]netip.Prefix, 0, len(sorted))
+		for _, peer := range sorted {
+			prefixes = append(prefixes, netip.PrefixFrom(peer, peer.BitLen()))
+		}
+
+		if err := fw.peersSet.ClearAndAddElements(fw.conn, prefixesSetData(prefixes)); err != nil {
+			return err
+		}
+	}
+
+	err := fw.conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush team peers changes: %w", err)
+	}
+	return nil
+}
+
+// initTeamNetworks fills the team networks set with the range of the team networks addresses.
+func (fw *Firewall) initTeamNetworks() error {
+	prefix, err := parsePrefix(consts.TeamNetworksCIDR)
+	if err != nil {
+		return fmt.Errorf("parse team networks CIDR: %w", err)
+	}
+
+	if err := fw.networkSet.ClearAndAddElements(fw.conn, prefixesSetData([]netip.Prefix{prefix})); err != nil {
+		return err
+	}
+
+	return fw.conn.Flush()
+}
+
 func (fw *Firewall) ResetAllCustom() error {
 	if err := fw.ResetBlockedCustom(); err != nil {
 		return fmt.Errorf("clear block set: %w", err)
@@ -221,33 +632,236 @@ func (fw *Firewall) ResetAllCustom() error {
 
 // ResetBlockedCustom resets the block set back to original ranges.
 func (fw *Firewall) ResetBlockedCustom() error {
-	initData, err := set.AddressStringsToSetData(blockedRanges)
-	if err != nil {
-		return fmt.Errorf("parse initial block CIDRs: %w", err)
-	}
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
 
-	if err := fw.blockSet.ClearAndAddElements(fw.conn, initData); err != nil {
+	fw.customBlocked = nil
+
+	if err := fw.syncBlocked(); err != nil {
 		return err
 	}
 	return fw.conn.Flush()
 }
 
-// ResetAllowedCustom resets allow set back to original ranges.
+// ResetAllowedCustom resets allow set back to original ranges and drops the resolved addresses.
 func (fw *Firewall) ResetAllowedCustom() error {
-	initIps := make([]string, 0)
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
 
-	// Allow Logs Collector IP for logs
-	if ip := os.Getenv("LOGS_COLLECTOR_PUBLIC_IP"); ip != "" {
-		ip = strings.TrimPrefix(ip, "http://") + "/32"
-		initIps = append(initIps, ip)
+	fw.customAllowed = nil
+	fw.resolved = make(map[netip.Addr]time.Time)
+
+	if err := fw.syncAllowed(); err != nil {
+		return err
 	}
 
-	initData, err := set.AddressStringsToSetData(initIps)
+	fw.conn.FlushSet(fw.resolvedSet)
+	fw.conn.FlushSet(fw.resolvedSet6)
+
+	return fw.conn.Flush()
+}
+
+// syncBlocked queues the replacement of the block sets with the blocked ranges and the deny sets with the custom blocked CIDRs.
+func (fw *Firewall) syncBlocked() error {
+	blocked, err := blockedPrefixes()
 	if err != nil {
-		return fmt.Errorf("parse initial allow CIDRs: %w", err)
+		return err
 	}
-	if err := fw.allowSet.ClearAndAddElements(fw.conn, initData); err != nil {
+
+	if err := fw.replaceSets(fw.blockSet, fw.blockSet6, blocked); err != nil {
 		return err
 	}
-	return fw.conn.Flush()
+
+	return fw.replaceSets(fw.deniedSet, fw.deniedSet6, fw.customBlocked)
+}
+
+// syncAllowed queues the replacement of the allow set with the custom allowed CIDRs.
+func (fw *Firewall) syncAllowed() error {
+	return fw.replaceSets(fw.allowSet, fw.allowSet6, fw.customAllowed)
+}
+
+// blockedPrefixes are the ranges the sandboxes can't reach even if the egress policy allows them.
+func blockedPrefixes() ([]netip.Prefix, error) {
+	prefixes, err := parsePrefixes(consts.BlockedEgressRanges)
+	if err != nil {
+		return nil, fmt.Errorf("parse initial block CIDRs: %w", err)
+	}
+
+	// The sandboxes can't reach each other through the host IPv6 addresses of their slots.
+	if ipv6NetworkCIDR != nil {
+		prefix, err := parsePrefix(ipv6NetworkCIDR.String())
+		if err != nil {
+			return nil, fmt.Errorf("parse IPv6 network CIDR: %w", err)
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	// The sandboxes can't reach the node and its neighbours through their global IPv6 addresses.
+	return append(prefixes, hostIPv6Prefixes...), nil
+}
+
+// insidePrefixes checks if the prefix is in any of the prefixes.
+func insidePrefixes(prefixes []netip.Prefix, prefix netip.Prefix) bool {
+	return slices.ContainsFunc(prefixes, func(p netip.Prefix) bool {
+		return p.Bits() <= prefix.Bits() && p.Contains(prefix.Addr())
+	})
+}
+
+// replaceSets queues the replacement of the IPv4 and IPv6 sets with the prefixes of their address family.
+func (fw *Firewall) replaceSets(set4, set6 set.Set, prefixes []netip.Prefix) error {
+	var prefixes4, prefixes6 []netip.Prefix
+	for _, prefix := range prefixes {
+		if prefix.Addr().Is4() {
+			prefixes4 = append(prefixes4, prefix)
+		} else {
+			prefixes6 = append(prefixes6, prefix)
+		}
+	}
+
+	for _, s := range []struct {
+		set      set.Set
+		prefixes []netip.Prefix
+	}{{set4, prefixes4}, {set6, prefixes6}} {
+		if len(s.prefixes) == 0 {
+			fw.conn.FlushSet(s.set.Set())
+
+			continue
+		}
+
+		if err := s.set.ClearAndAddElements(fw.conn, prefixesSetData(s.prefixes)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parsePrefix parses an IPv4 or IPv6 CIDR or address, the address is treated as a single address CIDR.
+// The IPv4-mapped IPv6 addresses are converted to IPv4.
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	var prefix netip.Prefix
+
+	if strings.Contains(cidr, "/") {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+		}
+
+		prefix = p
+	} else {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid IP address '%s': %w", cidr, err)
+		}
+
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if addr := prefix.Addr(); addr.Is4In6() {
+		bits := prefix.Bits() - 96
+		if bits < 0 {
+			return netip.Prefix{}, fmt.Errorf("invalid IPv4-mapped CIDR '%s'", cidr)
+		}
+
+		prefix = netip.PrefixFrom(addr.Unmap(), bits)
+	}
+
+	return prefix.Masked(), nil
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		prefix, err := parsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
+
+func prefixesContain(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergePrefixes drops the duplicated prefixes and the prefixes covered by other ones,
+// the elements of the interval sets can't overlap.
+func mergePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	sorted := slices.Clone(prefixes)
+	slices.SortFunc(sorted, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+
+		return a.Bits() - b.Bits()
+	})
+
+	merged := make([]netip.Prefix, 0, len(sorted))
+	for _, prefix := range sorted {
+		// The previous prefix starts at a lower or the same address, so it either covers the prefix or doesn't overlap with it.
+		if len(merged) > 0 && merged[len(merged)-1].Overlaps(prefix) {
+			continue
+		}
+
+		merged = append(merged, prefix)
+	}
+
+	return merged
+}
+
+// prefixesSetData converts the prefixes of one address family to the set data, the prefixes ending with the last address
+// of the family are converted to ranges ending one address before, because the end of the interval is the next address after the prefix.
+func prefixesSetData(prefixes []netip.Prefix) []set.SetData {
+	merged := mergePrefixes(prefixes)
+
+	data := make([]set.SetData, 0, len(merged))
+	for _, prefix := range merged {
+		start, end := prefix.Addr(), lastAddr(prefix)
+
+		// The unspecified address can't be in the set, it's never a destination anyway.
+		if start.IsUnspecified() {
+			start = start.Next()
+		}
+
+		// The broadcast address can't be represented as the end of the interval.
+		if !end.Next().IsValid() {
+			end = end.Prev()
+		}
+
+		if end.Less(start) {
+			continue
+		}
+
+		if start == prefix.Addr() && end == lastAddr(prefix) {
+			data = append(data, set.SetData{Prefix: prefix})
+
+			continue
+		}
+
+		data = append(data, set.SetData{AddressRangeStart: start, AddressRangeEnd: end})
+	}
+
+	return data
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(addr)*8; bit++ {
+		addr[bit/8] |= 0x80 >> (bit % 8)
+	}
+
+	last, _ := netip.AddrFromSlice(addr)
+
+	return last
 }
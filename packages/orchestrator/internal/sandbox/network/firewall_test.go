@@ -0,0 +1,105 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/ngrok/firewall_toolkit/pkg/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrefix(t *testing.T) {
+	prefix, err := parsePrefix("1.2.3.4")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("1.2.3.4/32"), prefix)
+
+	prefix, err = parsePrefix("10.1.2.3/8")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), prefix)
+
+	prefix, err = parsePrefix("2001:db8::1/32")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::/32"), prefix)
+
+	prefix, err = parsePrefix("::ffff:10.1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("10.1.2.3/32"), prefix)
+
+	_, err = parsePrefix("example.com")
+	require.Error(t, err)
+}
+
+func TestMergePrefixes(t *testing.T) {
+	merged := mergePrefixes([]netip.Prefix{
+		netip.MustParsePrefix("10.1.0.0/16"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("1.1.1.1/32"),
+		netip.MustParsePrefix("1.1.1.1/32"),
+	})
+
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("1.1.1.1/32"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+	}, merged)
+
+	merged = mergePrefixes([]netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("0.0.0.0/0"),
+	})
+
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}, merged)
+}
+
+func TestPrefixesSetData(t *testing.T) {
+	data := prefixesSetData([]netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/0"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+	})
+
+	assert.Equal(t, []set.SetData{
+		{AddressRangeStart: netip.MustParseAddr("0.0.0.1"), AddressRangeEnd: netip.MustParseAddr("255.255.255.254")},
+	}, data)
+
+	data = prefixesSetData([]netip.Prefix{
+		netip.MustParsePrefix("8.8.8.8/32"),
+		netip.MustParsePrefix("255.255.255.255/32"),
+	})
+
+	assert.Equal(t, []set.SetData{{Prefix: netip.MustParsePrefix("8.8.8.8/32")}}, data)
+
+	data = prefixesSetData([]netip.Prefix{
+		netip.MustParsePrefix("::/0"),
+		netip.MustParsePrefix("fc00::/7"),
+	})
+
+	assert.Equal(t, []set.SetData{
+		{AddressRangeStart: netip.MustParseAddr("::1"), AddressRangeEnd: netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe")},
+	}, data)
+
+	data = prefixesSetData([]netip.Prefix{netip.MustParsePrefix("0.0.0.0/32")})
+
+	assert.Empty(t, data)
+
+	data = prefixesSetData([]netip.Prefix{netip.MustParsePrefix("2001:db8::/32")})
+
+	assert.Equal(t, []set.SetData{{Prefix: netip.MustParsePrefix("2001:db8::/32")}}, data)
+}
+
+func TestInsidePrefixes(t *testing.T) {
+	blocked := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("169.254.0.0/16"),
+		netip.MustParsePrefix("fe80::/10"),
+	}
+
+	assert.True(t, insidePrefixes(blocked, netip.MustParsePrefix("169.254.169.254/32")))
+	assert.True(t, insidePrefixes(blocked, netip.MustParsePrefix("10.0.0.0/8")))
+	assert.True(t, insidePrefixes(blocked, netip.MustParsePrefix("fe80::1/128")))
+	// The wider ranges are allowed, the blocked ranges are dropped before the allowed ones.
+	assert.False(t, insidePrefixes(blocked, netip.MustParsePrefix("0.0.0.0/0")))
+	assert.False(t, insidePrefixes(blocked, netip.MustParsePrefix("1.1.1.1/32")))
+	assert.False(t, insidePrefixes(blocked, netip.MustParsePrefix("::ffff:0:0/96")))
+}
@@ -5,9 +5,11 @@ package network
 
 import (
 	"fmt"
+	"net/netip"
 
 	"github.com/vishvananda/netlink"
 	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -18,6 +20,12 @@ const loopbackInterface = "lo"
 // Host default gateway name
 var defaultGateway = utils.Must(getDefaultGateway())
 
+// Host default IPv6 gateway name, it's empty when the IPv6 network of the sandboxes isn't configured
+var defaultGatewayIPv6 = utils.Must(getDefaultGatewayIPv6())
+
+// Host global IPv6 prefixes, they are blocked for the sandboxes like the private IPv4 ranges
+var hostIPv6Prefixes = utils.Must(getHostIPv6Prefixes())
+
 //	func getDefaultGateway() (string, error) {
 //		route, err := exec.Command(
 //			"sh",
@@ -53,3 +61,61 @@ func getDefaultGateway() (string, error) {
 
 	return "", fmt.Errorf("cannot find default gateway")
 }
+
+func getDefaultGatewayIPv6() (string, error) {
+	if ipv6NetworkCIDR == nil {
+		return "", nil
+	}
+
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V6)
+	if err != nil {
+		return "", fmt.Errorf("error fetching IPv6 routes: %w", err)
+	}
+
+	for _, route := range routes {
+		// ::/0
+		if (route.Dst == nil || route.Dst.String() == "::/0") && route.Gw != nil {
+			zap.L().Info("default IPv6 gateway", zap.String("gateway", route.Gw.String()))
+
+			link, linkErr := netlink.LinkByIndex(route.LinkIndex)
+			if linkErr != nil {
+				return "", fmt.Errorf("error fetching interface for default IPv6 gateway: %w", linkErr)
+			}
+
+			return link.Attrs().Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot find default IPv6 gateway")
+}
+
+func getHostIPv6Prefixes() ([]netip.Prefix, error) {
+	if ipv6NetworkCIDR == nil {
+		return nil, nil
+	}
+
+	addrs, err := netlink.AddrList(nil, netlink.FAMILY_V6)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching IPv6 addresses: %w", err)
+	}
+
+	var prefixes []netip.Prefix
+	for _, addr := range addrs {
+		// The link local addresses are in the blocked ranges already.
+		if addr.Scope != unix.RT_SCOPE_UNIVERSE {
+			continue
+		}
+
+		ip, ok := netip.AddrFromSlice(addr.IP)
+		if !ok {
+			continue
+		}
+
+		ones, _ := addr.Mask.Size()
+		prefixes = append(prefixes, netip.PrefixFrom(ip, ones).Masked())
+	}
+
+	zap.L().Info("host IPv6 prefixes", zap.Any("prefixes", prefixes))
+
+	return prefixes, nil
+}
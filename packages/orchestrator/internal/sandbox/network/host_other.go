@@ -3,5 +3,10 @@
 
 package network
 
+import "net/netip"
+
 // Host loopback interface name
 const loopbackInterface = "lo"
+
+// Host global IPv6 prefixes, they are blocked for the sandboxes like the private IPv4 ranges
+var hostIPv6Prefixes []netip.Prefix
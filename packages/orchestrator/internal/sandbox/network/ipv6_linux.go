@@ -0,0 +1,174 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/containernetworking/plugins/pkg/utils/sysctl"
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// ipv6NamespaceRules are the nat rules translating between the sandbox IPv6 address and the host IPv6 address of the slot in the slot namespace.
+func (s *Slot) ipv6NamespaceRules() (prerouting []string, postrouting []string) {
+	prerouting = []string{"-d", s.HostIPv6().String(), "-j", "DNAT", "--to-destination", s.NamespaceIPv6()}
+	postrouting = []string{"-o", s.VpeerName(), "-s", s.NamespaceIPv6(), "-j", "SNAT", "--to-source", s.HostIPv6().String()}
+
+	return prerouting, postrouting
+}
+
+// ipv6HostRules are the host forwarding rules of the IPv6 traffic of the slot.
+// The routed sandbox addresses are reachable from the internet, so only the replies to the connections opened by the sandbox are forwarded to it.
+func (s *Slot) ipv6HostRules() [][]string {
+	return [][]string{
+		{"-i", s.VethName(), "-o", defaultGatewayIPv6, "-j", "ACCEPT"},
+		{"-i", defaultGatewayIPv6, "-o", s.VethName(), "-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "ACCEPT"},
+		{"-i", defaultGatewayIPv6, "-o", s.VethName(), "-j", "DROP"},
+	}
+}
+
+// ipv6MasqueradeRule is the host postrouting rule of the slot when the IPv6 network isn't routed to the node.
+func (s *Slot) ipv6MasqueradeRule() []string {
+	return []string{"-s", s.HostIPv6().String() + "/128", "-o", defaultGatewayIPv6, "-j", "MASQUERADE"}
+}
+
+func (s *Slot) ipv6HostRoute(veth netlink.Link) *netlink.Route {
+	return &netlink.Route{
+		LinkIndex: veth.Attrs().Index,
+		Gw:        s.VpeerIPv6(),
+		Dst:       &net.IPNet{IP: s.HostIPv6(), Mask: net.CIDRMask(128, 128)},
+	}
+}
+
+// configureNamespaceIPv6 adds the IPv6 addresses and routes of the slot in its namespace, it has to be called in the namespace.
+func (s *Slot) configureNamespaceIPv6(vpeer netlink.Link, tap netlink.Link) error {
+	// The new namespaces don't forward IPv6 by default.
+	_, err := sysctl.Sysctl("net/ipv6/conf/all/forwarding", "1")
+	if err != nil {
+		return fmt.Errorf("error enabling IPv6 forwarding: %w", err)
+	}
+
+	// The duplicate address detection is skipped, the addresses are usable right away.
+	err = netlink.AddrAdd(vpeer, &netlink.Addr{
+		IPNet: &net.IPNet{IP: s.VpeerIPv6(), Mask: net.CIDRMask(64, 128)},
+		Flags: unix.IFA_F_NODAD,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding vpeer device IPv6 address: %w", err)
+	}
+
+	err = netlink.AddrAdd(tap, &netlink.Addr{
+		IPNet: &net.IPNet{IP: s.TapIPv6(), Mask: net.CIDRMask(s.TapIPv6Mask(), 128)},
+		Flags: unix.IFA_F_NODAD,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding tap device IPv6 address: %w", err)
+	}
+
+	err = netlink.RouteAdd(&netlink.Route{
+		LinkIndex: vpeer.Attrs().Index,
+		Gw:        s.VethIPv6(),
+		Dst:       &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)},
+	})
+	if err != nil {
+		return fmt.Errorf("error adding default IPv6 NS route: %w", err)
+	}
+
+	tables, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	if err != nil {
+		return fmt.Errorf("error initializing ip6tables: %w", err)
+	}
+
+	prerouting, postrouting := s.ipv6NamespaceRules()
+
+	err = tables.Append("nat", "POSTROUTING", postrouting...)
+	if err != nil {
+		return fmt.Errorf("error creating IPv6 postrouting rule to vpeer: %w", err)
+	}
+
+	err = tables.Append("nat", "PREROUTING", prerouting...)
+	if err != nil {
+		return fmt.Errorf("error creating IPv6 prerouting rule from vpeer: %w", err)
+	}
+
+	return nil
+}
+
+// configureHostIPv6 routes the host IPv6 address of the slot to its namespace, it has to be called in the host namespace.
+func (s *Slot) configureHostIPv6(veth netlink.Link) error {
+	err := netlink.AddrAdd(veth, &netlink.Addr{
+		IPNet: &net.IPNet{IP: s.VethIPv6(), Mask: net.CIDRMask(64, 128)},
+		Flags: unix.IFA_F_NODAD,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding veth device IPv6 address: %w", err)
+	}
+
+	err = netlink.RouteAdd(s.ipv6HostRoute(veth))
+	if err != nil {
+		return fmt.Errorf("error adding IPv6 route from host to FC: %w", err)
+	}
+
+	tables, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	if err != nil {
+		return fmt.Errorf("error initializing ip6tables: %w", err)
+	}
+
+	for _, rule := range s.ipv6HostRules() {
+		err = tables.Append("filter", "FORWARD", rule...)
+		if err != nil {
+			return fmt.Errorf("error creating IPv6 forwarding rule: %w", err)
+		}
+	}
+
+	if ipv6NetworkNAT {
+		err = tables.Append("nat", "POSTROUTING", s.ipv6MasqueradeRule()...)
+		if err != nil {
+			return fmt.Errorf("error creating IPv6 postrouting rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeHostIPv6 removes the host IPv6 rules and route of the slot, the namespace ones are removed with the namespace.
+func (s *Slot) removeHostIPv6() error {
+	var errs []error
+
+	tables, err := iptables.NewWithProtocol(iptables.ProtocolIPv6)
+	if err != nil {
+		return fmt.Errorf("error initializing ip6tables: %w", err)
+	}
+
+	for _, rule := range s.ipv6HostRules() {
+		err = tables.DeleteIfExists("filter", "FORWARD", rule...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting IPv6 forwarding rule: %w", err))
+		}
+	}
+
+	if ipv6NetworkNAT {
+		err = tables.DeleteIfExists("nat", "POSTROUTING", s.ipv6MasqueradeRule()...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting IPv6 postrouting rule: %w", err))
+		}
+	}
+
+	veth, err := netlink.LinkByName(s.VethName())
+	if err != nil {
+		// The route is removed with the veth.
+		return errors.Join(errs...)
+	}
+
+	err = netlink.RouteDel(s.ipv6HostRoute(veth))
+	if err != nil && !errors.Is(err, unix.ESRCH) {
+		errs = append(errs, fmt.Errorf("error deleting IPv6 route from host to FC: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -231,6 +231,13 @@ func (s *Slot) CreateNetwork() error {
 		return fmt.Errorf("error creating postrouting rule from vpeer: %w", err)
 	}
 
+	if s.IPv6Enabled() {
+		err = s.configureNamespaceIPv6(vpeer, tap)
+		if err != nil {
+			return fmt.Errorf("error configuring namespace IPv6: %w", err)
+		}
+	}
+
 	err = s.InitializeFirewall()
 	if err != nil {
 		return fmt.Errorf("error initializing slot firewall: %w", err)
@@ -268,17 +275,44 @@ func (s *Slot) CreateNetwork() error {
 		return fmt.Errorf("error creating postrouting rule: %w", err)
 	}
 
+	err = s.addTrafficCounters()
+	if err != nil {
+		return fmt.Errorf("error creating slot traffic counters: %w", err)
+	}
+
+	if s.IPv6Enabled() {
+		err = s.configureHostIPv6(vethInHost)
+		if err != nil {
+			return fmt.Errorf("error configuring host IPv6: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (s *Slot) RemoveNetwork() error {
 	var errs []error
 
-	err := s.CloseFirewall()
+	err := s.DetachTeamNetwork()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = s.closeDNSInterceptor()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error closing DNS interceptor: %w", err))
+	}
+
+	err = s.CloseFirewall()
 	if err != nil {
 		errs = append(errs, fmt.Errorf("error closing firewall: %w", err))
 	}
 
+	err = s.removeTrafficCounters()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error removing slot traffic counters: %w", err))
+	}
+
 	tables, err := iptables.New()
 	if err != nil {
 		errs = append(errs, fmt.Errorf("error initializing iptables: %w", err))
@@ -314,6 +348,13 @@ func (s *Slot) RemoveNetwork() error {
 		}
 	}
 
+	if s.IPv6Enabled() {
+		err = s.removeHostIPv6()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// Delete routing from host to FC namespace
 	err = netlink.RouteDel(&netlink.Route{
 		Gw:  s.VpeerIP(),
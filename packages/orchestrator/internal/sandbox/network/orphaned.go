@@ -0,0 +1,55 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// CleanupOrphanedSlots removes the networks of the slots left behind by a crashed process and releases the slots.
+// The slots still reserved in the storage by the crashed process are cleaned up together with the given ones,
+// the processes running in the slots' namespaces are killed.
+// It must be called before the pool is created, so none of the slots is used by this process.
+func CleanupOrphanedSlots(ctx context.Context, tracer trace.Tracer, clientID string, slots []*Slot) (int, error) {
+	slotStorage, err := NewStorage(vrtSlotsSize, clientID, tracer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create slot storage: %w", err)
+	}
+
+	orphaned, err := slotStorage.Orphaned(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get orphaned slots: %w", err)
+	}
+
+	slotsByIdx := make(map[int]*Slot, len(slots)+len(orphaned))
+	for _, slot := range slots {
+		slotsByIdx[slot.Idx] = slot
+	}
+
+	for _, slot := range orphaned {
+		slotsByIdx[slot.Idx] = slot
+	}
+
+	var errs []error
+	for _, slot := range slotsByIdx {
+		err = cleanupOrphanedSlot(slot)
+		if err != nil {
+			// Keep the slot reserved, so it isn't reused with the network in an unknown state.
+			errs = append(errs, fmt.Errorf("failed to cleanup slot '%d': %w", slot.Idx, err))
+
+			continue
+		}
+
+		err = slotStorage.Release(slot)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to release slot '%d': %w", slot.Idx, err))
+		}
+
+		zap.L().Info("Cleaned up orphaned network slot", zap.String("namespace", slot.NamespaceID()))
+	}
+
+	return len(slotsByIdx), errors.Join(errs...)
+}
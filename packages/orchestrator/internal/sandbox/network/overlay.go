@@ -0,0 +1,24 @@
+package network
+
+import (
+	"net"
+	"net/netip"
+)
+
+const (
+	overlayInterface = "e2b-teams"
+	overlayVNI       = 4242
+	overlayPort      = 4789
+	// overlayChain accepts the VXLAN traffic only from the nodes running the peers of the local sandboxes.
+	overlayChain = "E2B-OVERLAY"
+	// overlayOverhead is the size of the VXLAN encapsulation headers.
+	overlayOverhead = 50
+)
+
+// overlayNodeMAC derives the MAC address of the overlay device of the node from its address,
+// so the neighbor and forwarding entries of the other nodes don't need to be learned. The address must be an IPv4 address.
+func overlayNodeMAC(node netip.Addr) net.HardwareAddr {
+	addr := node.As4()
+
+	return net.HardwareAddr{0x02, 0xe2, addr[0], addr[1], addr[2], addr[3]}
+}
@@ -0,0 +1,322 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+// Overlay routes the team network addresses of the sandboxes on the other nodes through a VXLAN device.
+// Each route goes to the overlay device of the node running the sandbox, which forwards it to the slot of the sandbox.
+type Overlay struct {
+	mu     sync.Mutex
+	link   netlink.Link
+	local  netip.Addr
+	tables *iptables.IPTables
+
+	// routes maps the team network addresses to the addresses of the nodes running the sandboxes.
+	routes map[netip.Addr]netip.Addr
+	nodes  map[netip.Addr]struct{}
+}
+
+// NewOverlay creates the overlay device on the default gateway interface, the device left by the previous run is replaced.
+func NewOverlay() (*Overlay, error) {
+	parent, err := netlink.LinkByName(defaultGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error finding default gateway interface: %w", err)
+	}
+
+	addrs, err := netlink.AddrList(parent, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, fmt.Errorf("error listing default gateway addresses: %w", err)
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("default gateway interface %s has no IPv4 address", defaultGateway)
+	}
+
+	local, ok := netip.AddrFromSlice(addrs[0].IP.To4())
+	if !ok {
+		return nil, fmt.Errorf("invalid default gateway address %s", addrs[0].IP)
+	}
+
+	tables, err := newOverlayFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := netlink.LinkByName(overlayInterface); err == nil {
+		zap.L().Info("Removing the overlay device left by the previous run", zap.String("interface", overlayInterface))
+
+		err = netlink.LinkDel(existing)
+		if err != nil {
+			return nil, fmt.Errorf("error deleting previous overlay device: %w", err)
+		}
+	}
+
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = overlayInterface
+	attrs.MTU = parent.Attrs().MTU - overlayOverhead
+	attrs.HardwareAddr = overlayNodeMAC(local)
+
+	link := &netlink.Vxlan{
+		LinkAttrs:    attrs,
+		VxlanId:      overlayVNI,
+		VtepDevIndex: parent.Attrs().Index,
+		SrcAddr:      local.AsSlice(),
+		Port:         overlayPort,
+		Learning:     false,
+	}
+
+	err = netlink.LinkAdd(link)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error creating overlay device: %w", err), removeOverlayFilter(tables))
+	}
+
+	err = netlink.LinkSetUp(link)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error setting overlay device up: %w", err), netlink.LinkDel(link), removeOverlayFilter(tables))
+	}
+
+	return &Overlay{
+		link:   link,
+		local:  local,
+		tables: tables,
+		routes: make(map[netip.Addr]netip.Addr),
+		nodes:  make(map[netip.Addr]struct{}),
+	}, nil
+}
+
+// overlayFilterJump sends the VXLAN traffic received by the node to the overlay chain.
+func overlayFilterJump() []string {
+	return []string{"-p", "udp", "--dport", strconv.Itoa(overlayPort), "-j", overlayChain}
+}
+
+// overlayNodeRule accepts the VXLAN traffic of the node in the overlay chain.
+func overlayNodeRule(node netip.Addr) []string {
+	return []string{"-s", node.String(), "-j", "ACCEPT"}
+}
+
+// newOverlayFilter drops the VXLAN traffic from the unknown nodes, the chain left by the previous run is flushed.
+func newOverlayFilter() (*iptables.IPTables, error) {
+	tables, err := iptables.New()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	err = tables.ClearChain("filter", overlayChain)
+	if err != nil {
+		return nil, fmt.Errorf("error creating overlay chain: %w", err)
+	}
+
+	err = tables.Append("filter", overlayChain, "-j", "DROP")
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error creating overlay drop rule: %w", err), removeOverlayFilter(tables))
+	}
+
+	exists, err := tables.Exists("filter", "INPUT", overlayFilterJump()...)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error checking overlay jump rule: %w", err), removeOverlayFilter(tables))
+	}
+
+	// The rule must precede the rules accepting the traffic of the node.
+	if !exists {
+		err = tables.Insert("filter", "INPUT", 1, overlayFilterJump()...)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error creating overlay jump rule: %w", err), removeOverlayFilter(tables))
+		}
+	}
+
+	return tables, nil
+}
+
+func removeOverlayFilter(tables *iptables.IPTables) error {
+	err := tables.DeleteIfExists("filter", "INPUT", overlayFilterJump()...)
+	if err != nil {
+		return fmt.Errorf("error deleting overlay jump rule: %w", err)
+	}
+
+	err = tables.ClearAndDeleteChain("filter", overlayChain)
+	if err != nil {
+		return fmt.Errorf("error deleting overlay chain: %w", err)
+	}
+
+	return nil
+}
+
+// SetRoutes replaces the routes of the team network addresses to the nodes running the sandboxes, the routes to the node itself are skipped.
+// The VXLAN traffic is accepted only from the nodes with a route.
+func (o *Overlay) SetRoutes(routes map[netip.Addr]netip.Addr) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var errs []error
+
+	nodes := make(map[netip.Addr]struct{})
+	for _, node := range routes {
+		// The routes to the nodes without an IPv4 address are skipped, the overlay runs over IPv4.
+		if node != o.local && node.Is4() {
+			nodes[node] = struct{}{}
+		}
+	}
+
+	for node := range nodes {
+		if _, ok := o.nodes[node]; ok {
+			continue
+		}
+
+		err := o.addNode(node)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		o.nodes[node] = struct{}{}
+	}
+
+	for addr, node := range o.routes {
+		if routes[addr] == node {
+			continue
+		}
+
+		err := netlink.RouteDel(o.route(addr, node))
+		if err != nil && !errors.Is(err, unix.ESRCH) {
+			errs = append(errs, fmt.Errorf("error deleting overlay route to %s: %w", addr, err))
+
+			continue
+		}
+
+		delete(o.routes, addr)
+	}
+
+	for addr, node := range routes {
+		if _, ok := o.nodes[node]; !ok || o.routes[addr] == node {
+			continue
+		}
+
+		err := netlink.RouteReplace(o.route(addr, node))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error adding overlay route to %s: %w", addr, err))
+
+			continue
+		}
+
+		o.routes[addr] = node
+	}
+
+	for node := range o.nodes {
+		if _, ok := nodes[node]; ok {
+			continue
+		}
+
+		err := o.removeNode(node)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		delete(o.nodes, node)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (o *Overlay) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	err := netlink.LinkDel(o.link)
+	if err != nil {
+		return fmt.Errorf("error deleting overlay device: %w", err)
+	}
+
+	return removeOverlayFilter(o.tables)
+}
+
+// route sends the traffic of the address to the overlay device of the node, the node address is the on-link gateway.
+func (o *Overlay) route(addr, node netip.Addr) *netlink.Route {
+	return &netlink.Route{
+		LinkIndex: o.link.Attrs().Index,
+		Dst:       &net.IPNet{IP: addr.AsSlice(), Mask: net.CIDRMask(addr.BitLen(), addr.BitLen())},
+		Gw:        node.AsSlice(),
+		Flags:     int(netlink.FLAG_ONLINK),
+	}
+}
+
+// nodeNeighbors are the static neighbor entry of the node gateway and the forwarding entry sending its frames to the node.
+func (o *Overlay) nodeNeighbors(node netip.Addr) []*netlink.Neigh {
+	mac := overlayNodeMAC(node)
+
+	return []*netlink.Neigh{
+		{
+			LinkIndex:    o.link.Attrs().Index,
+			Family:       netlink.FAMILY_V4,
+			State:        netlink.NUD_PERMANENT,
+			IP:           node.AsSlice(),
+			HardwareAddr: mac,
+		},
+		{
+			LinkIndex:    o.link.Attrs().Index,
+			Family:       unix.AF_BRIDGE,
+			Flags:        netlink.NTF_SELF,
+			State:        netlink.NUD_PERMANENT,
+			IP:           node.AsSlice(),
+			HardwareAddr: mac,
+		},
+	}
+}
+
+func (o *Overlay) addNode(node netip.Addr) error {
+	exists, err := o.tables.Exists("filter", overlayChain, overlayNodeRule(node)...)
+	if err != nil {
+		return fmt.Errorf("error checking overlay rule of %s: %w", node, err)
+	}
+
+	// The rule must precede the drop of the traffic from the unknown nodes.
+	if !exists {
+		err = o.tables.Insert("filter", overlayChain, 1, overlayNodeRule(node)...)
+		if err != nil {
+			return fmt.Errorf("error creating overlay rule of %s: %w", node, err)
+		}
+	}
+
+	for _, neigh := range o.nodeNeighbors(node) {
+		err := netlink.NeighSet(neigh)
+		if err != nil {
+			return fmt.Errorf("error adding overlay neighbor %s: %w", node, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Overlay) removeNode(node netip.Addr) error {
+	var errs []error
+
+	for _, neigh := range o.nodeNeighbors(node) {
+		err := netlink.NeighDel(neigh)
+		if err != nil && !errors.Is(err, unix.ENOENT) {
+			errs = append(errs, fmt.Errorf("error deleting overlay neighbor %s: %w", node, err))
+		}
+	}
+
+	err := o.tables.DeleteIfExists("filter", overlayChain, overlayNodeRule(node)...)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error deleting overlay rule of %s: %w", node, err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,23 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+	"net/netip"
+)
+
+type Overlay struct{}
+
+func NewOverlay() (*Overlay, error) {
+	return nil, errors.New("platform does not support the team networks overlay")
+}
+
+func (o *Overlay) SetRoutes(_ map[netip.Addr]netip.Addr) error {
+	return nil
+}
+
+func (o *Overlay) Close() error {
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -121,7 +122,7 @@ func (p *Pool) populate(ctx context.Context) error {
 	}
 }
 
-func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool) (*Slot, error) {
+func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool, egressPolicy *orchestrator.SandboxEgressPolicy) (*Slot, error) {
 	var slot *Slot
 
 	select {
@@ -213,11 +214,19 @@ func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool)
 		}
 	}
 
-	err := slot.ConfigureInternet(ctx, tracer, allowInternet)
+	err := slot.ConfigureInternet(ctx, tracer, allowInternet, egressPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("error setting slot internet access: %w", err)
 	}
 
+	err = slot.ResetStats()
+	if err != nil {
+		// The stats of the sandbox include the traffic of the previous sandboxes in the slot.
+		zap.L().Warn("[network slot pool]: Failed to reset slot network stats",
+			zap.String("namespace", slot.NamespaceID()),
+			zap.Error(err))
+	}
+
 	return slot, nil
 }
 
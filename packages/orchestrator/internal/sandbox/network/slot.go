@@ -2,10 +2,15 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/netip"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"github.com/containernetworking/plugins/pkg/ns"
@@ -14,7 +19,9 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	netutils "k8s.io/utils/net"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 )
 
 const (
@@ -29,12 +36,26 @@ const (
 	tapInterfaceName = "tap0"
 	tapIp            = "169.254.0.22"
 	tapMAC           = "02:FC:00:00:00:05"
+
+	// The sandbox IPv6 addresses are the same in all slots like the IPv4 ones, so the snapshots can be resumed in any slot.
+	namespaceIPv6 = "fd00:e2b::21"
+	tapIPv6       = "fd00:e2b::22"
+	tapIPv6Mask   = 126
+
+	// The veth pair is routed through link-local addresses, so only the host address of the slot is taken from the IPv6 network.
+	vethIPv6  = "fe80::1"
+	vpeerIPv6 = "fe80::2"
 )
 
 var (
 	hostNetworkCIDR = getHostNetworkCIDR()
 	vrtNetworkCIDR  = getVrtNetworkCIDR()
 	vrtSlotsSize    = GetVrtSlotsSize()
+
+	// ipv6NetworkCIDR is the IPv6 network of the sandboxes on the node, the slots are IPv4 only when it's not configured.
+	ipv6NetworkCIDR = getIPv6NetworkCIDR()
+	// ipv6NetworkNAT is set when the IPv6 network is a unique local one, the egress is masqueraded instead of routed.
+	ipv6NetworkNAT = isUniqueLocalNetwork(ipv6NetworkCIDR)
 )
 
 // Slot network allocation
@@ -51,6 +72,9 @@ var (
 // By default, they are using 10.12.0.0/16 CIDR block, that can be configured via environment variable.
 // Vpeer receives the first IP in the block, and Veth receives the second IP. Block is calculated as (slot index * addresses per slot allocation).
 // Vrt address per slot is always 2, so we can allocate /31 CIDR block for each slot.
+//
+// With SANDBOXES_IPV6_NETWORK_CIDR configured, the slot also gets the host IPv6 address with the slot index in the network.
+// The sandbox traffic is translated to it in the namespace the same way as for the IPv4 host IP.
 type Slot struct {
 	Key string
 	Idx int
@@ -59,6 +83,18 @@ type Slot struct {
 
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
+	// dnsInterceptor resolves the allowed domains of the egress policy, nil when the policy has no domains.
+	dnsInterceptor *dnsInterceptor
+
+	teamNetworkMu sync.Mutex
+	// teamNetworkAddress is the address of the sandbox in its team network, it's invalid when the slot isn't attached to any.
+	teamNetworkAddress netip.Addr
+	// teamPeers are the addresses that can reach the sandbox in its team network.
+	teamPeers []netip.Addr
+
+	statsMu sync.Mutex
+	// statsBase are the counters of the slot when it was taken by the sandbox.
+	statsBase Stats
 
 	vPeerIp net.IP
 	vEthIp  net.IP
@@ -73,6 +109,9 @@ type Slot struct {
 	hostNet  *net.IPNet
 	hostCIDR string
 
+	// hostIPv6 is the IPv6 address of the sandbox from the host machine, it's nil when the IPv6 network isn't configured.
+	hostIPv6 net.IP
+
 	// nsHandle keeps the network namespace file descriptor open to prevent the namespace from being deleted.
 	// This is critical for OCI where the bind mount alone doesn't keep the namespace alive.
 	nsHandle netns.NsHandle
@@ -116,6 +155,14 @@ func NewSlot(key string, idx int) (*Slot, error) {
 		return nil, fmt.Errorf("failed to parse tap CIDR: %w", err)
 	}
 
+	var hostIPv6 net.IP
+	if ipv6NetworkCIDR != nil {
+		hostIPv6, err = netutils.GetIndexedIP(ipv6NetworkCIDR, idx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host IPv6: %w", err)
+		}
+	}
+
 	slot := &Slot{
 		Key: key,
 		Idx: idx,
@@ -130,6 +177,8 @@ func NewSlot(key string, idx int) (*Slot, error) {
 		hostIp:   hostIp,
 		hostNet:  hostNet,
 		hostCIDR: hostCIDR,
+
+		hostIPv6: hostIPv6,
 	}
 
 	return slot, nil
@@ -179,6 +228,35 @@ func (s *Slot) NamespaceIP() string {
 	return "169.254.0.21"
 }
 
+// IPv6Enabled returns true when the slot has the IPv6 network configured.
+func (s *Slot) IPv6Enabled() bool {
+	return s.hostIPv6 != nil
+}
+
+func (s *Slot) HostIPv6() net.IP {
+	return s.hostIPv6
+}
+
+func (s *Slot) NamespaceIPv6() string {
+	return namespaceIPv6
+}
+
+func (s *Slot) TapIPv6() net.IP {
+	return net.ParseIP(tapIPv6)
+}
+
+func (s *Slot) TapIPv6Mask() int {
+	return tapIPv6Mask
+}
+
+func (s *Slot) VethIPv6() net.IP {
+	return net.ParseIP(vethIPv6)
+}
+
+func (s *Slot) VpeerIPv6() net.IP {
+	return net.ParseIP(vpeerIPv6)
+}
+
 func (s *Slot) NamespaceID() string {
 	return fmt.Sprintf("ns-%d", s.Idx)
 }
@@ -239,20 +317,49 @@ func (s *Slot) CloseFirewall() error {
 	return nil
 }
 
-func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allowInternet bool) (e error) {
+func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allowInternet bool, egressPolicy *orchestrator.SandboxEgressPolicy) (e error) {
 	_, span := tracer.Start(ctx, "slot-internet-configure", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 		attribute.Bool("allow_internet", allowInternet),
+		attribute.Bool("egress_policy", egressPolicy != nil),
 	))
 	defer span.End()
 
-	if allowInternet {
+	allowedDomains := egressPolicy.GetAllowedDomains()
+
+	if allowInternet && len(egressPolicy.GetAllowedCidrs()) == 0 && len(egressPolicy.GetDeniedCidrs()) == 0 && len(allowedDomains) == 0 {
 		// Internet access is allowed by default.
 		return nil
 	}
 
 	s.firewallCustomRules.Store(true)
 
+	blocked := egressPolicy.GetDeniedCidrs()
+	if !allowInternet || len(egressPolicy.GetAllowedCidrs()) > 0 || len(allowedDomains) > 0 {
+		// Any allow rule denies all the other traffic.
+		blocked = append(slices.Clone(blocked), "0.0.0.0/0", "::/0")
+	} else if slices.Contains(blocked, "0.0.0.0/0") && !slices.Contains(blocked, "::/0") {
+		// Denying all the IPv4 traffic denies the IPv6 traffic too, the policies created before the sandboxes had IPv6 stay closed.
+		blocked = append(slices.Clone(blocked), "::/0")
+	}
+
+	if len(allowedDomains) > 0 {
+		interceptor := newDNSInterceptor(allowedDomains, dnsUpstream, s.allowAddresses)
+
+		err := interceptor.Start(net.JoinHostPort(s.VethIP().String(), strconv.Itoa(dnsPort)))
+		if err != nil {
+			return fmt.Errorf("failed to start DNS interceptor: %w", err)
+		}
+
+		s.dnsInterceptor = interceptor
+
+		defer func() {
+			if e != nil {
+				e = errors.Join(e, s.closeDNSInterceptor())
+			}
+		}()
+	}
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -260,11 +367,18 @@ func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allow
 	defer n.Close()
 
 	err = n.Do(func(_ ns.NetNS) error {
-		err = s.Firewall.AddBlockedIP("0.0.0.0/0")
+		err = s.Firewall.SetCustom(egressPolicy.GetAllowedCidrs(), blocked)
 		if err != nil {
 			return fmt.Errorf("error setting firewall rules: %w", err)
 		}
 
+		if len(allowedDomains) > 0 {
+			err = s.redirectDNS()
+			if err != nil {
+				return fmt.Errorf("error redirecting DNS: %w", err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -274,16 +388,50 @@ func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allow
 	return nil
 }
 
+// allowAddresses allows the addresses resolved by the DNS interceptor in the slot firewall until their TTL expires.
+func (s *Slot) allowAddresses(addrs []resolvedAddress) error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	return n.Do(func(_ ns.NetNS) error {
+		return s.Firewall.AllowResolved(addrs)
+	})
+}
+
+func (s *Slot) closeDNSInterceptor() error {
+	if s.dnsInterceptor == nil {
+		return nil
+	}
+
+	err := s.dnsInterceptor.Close()
+	s.dnsInterceptor = nil
+
+	return err
+}
+
 func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 	))
 	defer span.End()
 
+	err := s.DetachTeamNetwork()
+	if err != nil {
+		return err
+	}
+
 	if !s.firewallCustomRules.CompareAndSwap(true, false) {
 		return nil
 	}
 
+	err = s.closeDNSInterceptor()
+	if err != nil {
+		return fmt.Errorf("error closing DNS interceptor: %w", err)
+	}
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -296,6 +444,11 @@ func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 			return fmt.Errorf("error cleaning firewall rules: %w", err)
 		}
 
+		err = s.removeDNSRedirect()
+		if err != nil {
+			return fmt.Errorf("error removing DNS redirect: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -305,6 +458,116 @@ func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 	return nil
 }
 
+// AttachTeamNetwork routes the address of the sandbox in the team network to the slot.
+// The slot can reach only the peers set by SetTeamPeers in the team networks range.
+func (s *Slot) AttachTeamNetwork(ctx context.Context, tracer trace.Tracer, network *orchestrator.SandboxNetwork) error {
+	_, span := tracer.Start(ctx, "slot-team-network-attach", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.String("team_network.address", network.GetAddress()),
+	))
+	defer span.End()
+
+	address, err := parseTeamNetworkAddress(network.GetAddress())
+	if err != nil {
+		return err
+	}
+
+	s.teamNetworkMu.Lock()
+	defer s.teamNetworkMu.Unlock()
+
+	if s.teamNetworkAddress.IsValid() {
+		return fmt.Errorf("slot %s is already attached to a team network", s.Key)
+	}
+
+	err = s.attachTeamNetwork(address)
+	if err != nil {
+		return errors.Join(fmt.Errorf("error attaching team network: %w", err), s.detachTeamNetwork(address))
+	}
+
+	s.teamNetworkAddress = address
+
+	return nil
+}
+
+// SetTeamPeers replaces the addresses the slot can reach in the team network.
+// The peers are ignored if the slot isn't attached to the team network with the address anymore.
+func (s *Slot) SetTeamPeers(address netip.Addr, peers []netip.Addr) error {
+	s.teamNetworkMu.Lock()
+	defer s.teamNetworkMu.Unlock()
+
+	if !s.teamNetworkAddress.IsValid() || s.teamNetworkAddress != address {
+		return nil
+	}
+
+	return s.setTeamPeers(peers)
+}
+
+// DetachTeamNetwork removes the team network of the slot, it does nothing if the slot isn't attached to any.
+func (s *Slot) DetachTeamNetwork() error {
+	s.teamNetworkMu.Lock()
+	defer s.teamNetworkMu.Unlock()
+
+	if !s.teamNetworkAddress.IsValid() {
+		return nil
+	}
+
+	err := errors.Join(s.setTeamPeers(nil), s.detachTeamNetwork(s.teamNetworkAddress))
+	if err != nil {
+		return fmt.Errorf("error detaching team network: %w", err)
+	}
+
+	s.teamNetworkAddress = netip.Addr{}
+
+	return nil
+}
+
+func (s *Slot) setTeamPeers(peers []netip.Addr) error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		return s.Firewall.SetPeers(peers)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The peers are checked on the host too, so only they can reach the sandbox, whichever node they are on.
+	err = s.setTeamPeerRules(s.teamNetworkAddress, s.teamPeers, peers)
+	if err != nil {
+		// The rules of both the previous and the new peers may exist, the next replacement removes the ones left.
+		s.teamPeers = append(slices.Clone(s.teamPeers), peers...)
+
+		return err
+	}
+
+	s.teamPeers = peers
+
+	return nil
+}
+
+// parseTeamNetworkAddress parses the address of the sandbox in the team network, it must be in the team networks range.
+func parseTeamNetworkAddress(address string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid team network address '%s': %w", address, err)
+	}
+
+	prefix, err := netip.ParsePrefix(consts.TeamNetworksCIDR)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid team networks CIDR '%s': %w", consts.TeamNetworksCIDR, err)
+	}
+
+	if !prefix.Contains(addr) {
+		return netip.Addr{}, fmt.Errorf("team network address '%s' is not in %s", address, prefix)
+	}
+
+	return addr, nil
+}
+
 func getHostNetworkCIDR() *net.IPNet {
 	cidr := env.GetEnv("SANDBOXES_HOST_NETWORK_CIDR", defaultHostNetworkCIDR)
 
@@ -317,6 +580,36 @@ func getHostNetworkCIDR() *net.IPNet {
 	return subnet
 }
 
+func getIPv6NetworkCIDR() *net.IPNet {
+	cidr := env.GetEnv("SANDBOXES_IPV6_NETWORK_CIDR", "")
+	if cidr == "" {
+		return nil
+	}
+
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		log.Fatalf("Failed to parse IPv6 network CIDR %s: %v", cidr, err)
+	}
+
+	if subnet.IP.To4() != nil {
+		log.Fatalf("IPv6 network CIDR %s is not an IPv6 network", cidr)
+	}
+
+	if subnet.Contains(net.ParseIP(namespaceIPv6)) {
+		log.Fatalf("IPv6 network CIDR %s overlaps with the sandbox address %s", cidr, namespaceIPv6)
+	}
+
+	log.Printf("Using IPv6 network cidr %s", cidr)
+	return subnet
+}
+
+// isUniqueLocalNetwork returns true for the networks in fc00::/7, they aren't routed on the internet.
+func isUniqueLocalNetwork(network *net.IPNet) bool {
+	_, uniqueLocal, _ := net.ParseCIDR("fc00::/7")
+
+	return network != nil && uniqueLocal.Contains(network.IP)
+}
+
 func getVrtNetworkCIDR() *net.IPNet {
 	cidr := env.GetEnv("SANDBOXES_VRT_NETWORK_CIDR", defaultVrtNetworkCIDR)
 
@@ -0,0 +1,70 @@
+package network
+
+// Stats are the network traffic counters of the sandbox in the slot.
+// Rx is the internet traffic received by the sandbox and Tx is the internet traffic sent by it, the traffic of envd and client-proxy isn't counted.
+type Stats struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	// NewConnections is the number of the connections opened by the sandbox.
+	NewConnections uint64
+}
+
+// Sub returns the counters accumulated since the base, a counter lower than its base was reset and is returned as is.
+func (s Stats) Sub(base Stats) Stats {
+	return Stats{
+		RxBytes:        sub(s.RxBytes, base.RxBytes),
+		TxBytes:        sub(s.TxBytes, base.TxBytes),
+		RxPackets:      sub(s.RxPackets, base.RxPackets),
+		TxPackets:      sub(s.TxPackets, base.TxPackets),
+		NewConnections: sub(s.NewConnections, base.NewConnections),
+	}
+}
+
+// Add returns the sum of the counters, the counters of a resumed sandbox continue from the ones it was paused with.
+func (s Stats) Add(other Stats) Stats {
+	return Stats{
+		RxBytes:        s.RxBytes + other.RxBytes,
+		TxBytes:        s.TxBytes + other.TxBytes,
+		RxPackets:      s.RxPackets + other.RxPackets,
+		TxPackets:      s.TxPackets + other.TxPackets,
+		NewConnections: s.NewConnections + other.NewConnections,
+	}
+}
+
+func sub(value, base uint64) uint64 {
+	if value < base {
+		return value
+	}
+
+	return value - base
+}
+
+// ResetStats sets the current counters of the slot as the base of Stats, so the traffic of the previous sandboxes in the slot isn't counted.
+func (s *Slot) ResetStats() error {
+	stats, err := s.readStats()
+	if err != nil {
+		return err
+	}
+
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	s.statsBase = stats
+
+	return nil
+}
+
+// Stats returns the network traffic counters of the slot since the last ResetStats.
+func (s *Slot) Stats() (Stats, error) {
+	stats, err := s.readStats()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+
+	return stats.Sub(s.statsBase), nil
+}
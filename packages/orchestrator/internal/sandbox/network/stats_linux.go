@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+)
+
+// readStats reads the counters of the internet traffic of the slot and the new connections counter of the slot firewall.
+func (s *Slot) readStats() (Stats, error) {
+	tx, rx, err := s.readTraffic()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{
+		RxBytes:   rx.Bytes,
+		TxBytes:   tx.Bytes,
+		RxPackets: rx.Packets,
+		TxPackets: tx.Packets,
+	}
+
+	if s.Firewall == nil {
+		return stats, nil
+	}
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		connections, err := s.Firewall.NewConnections()
+		if err != nil {
+			return err
+		}
+
+		stats.NewConnections = connections
+
+		return nil
+	})
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+	}
+
+	return stats, nil
+}
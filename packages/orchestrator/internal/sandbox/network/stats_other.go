@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+)
+
+func (s *Slot) readStats() (Stats, error) {
+	return Stats{}, errors.New("platform does not support network stats")
+}
@@ -0,0 +1,31 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsSub(t *testing.T) {
+	base := Stats{RxBytes: 100, TxBytes: 200, RxPackets: 10, TxPackets: 20, NewConnections: 5}
+
+	t.Run("counters since base", func(t *testing.T) {
+		stats := Stats{RxBytes: 150, TxBytes: 260, RxPackets: 15, TxPackets: 26, NewConnections: 7}
+
+		assert.Equal(t, Stats{RxBytes: 50, TxBytes: 60, RxPackets: 5, TxPackets: 6, NewConnections: 2}, stats.Sub(base))
+	})
+
+	t.Run("reset counters", func(t *testing.T) {
+		// The counters were recreated, so they started from zero.
+		stats := Stats{RxBytes: 30, TxBytes: 40, RxPackets: 3, TxPackets: 4, NewConnections: 6}
+
+		assert.Equal(t, Stats{RxBytes: 30, TxBytes: 40, RxPackets: 3, TxPackets: 4, NewConnections: 1}, stats.Sub(base))
+	})
+}
+
+func TestStatsAdd(t *testing.T) {
+	paused := Stats{RxBytes: 100, TxBytes: 200, RxPackets: 10, TxPackets: 20, NewConnections: 5}
+	resumed := Stats{RxBytes: 30, TxBytes: 40, RxPackets: 3, TxPackets: 4, NewConnections: 1}
+
+	assert.Equal(t, Stats{RxBytes: 130, TxBytes: 240, RxPackets: 13, TxPackets: 24, NewConnections: 6}, resumed.Add(paused))
+}
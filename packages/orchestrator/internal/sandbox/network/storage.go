@@ -14,6 +14,8 @@ var localNamespaceStorageSwitch = os.Getenv("USE_LOCAL_NAMESPACE_STORAGE")
 type Storage interface {
 	Acquire(ctx context.Context) (*Slot, error)
 	Release(*Slot) error
+	// Orphaned returns the slots that are still reserved by a process that is not running anymore.
+	Orphaned(ctx context.Context) ([]*Slot, error)
 }
 
 // NewStorage creates a new slot storage based on the environment, we are ok with using a memory storage for local
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"os"
 	"slices"
+	"strconv"
+	"strings"
 
 	consulApi "github.com/hashicorp/consul/api"
 
@@ -57,6 +60,8 @@ func (s *StorageKV) Acquire(_ context.Context) (*Slot, error) {
 		status, _, err := kv.CAS(&consulApi.KVPair{
 			Key:         key,
 			ModifyIndex: 0,
+			// The pid of the owner allows releasing the slot when the owner crashes.
+			Value: []byte(strconv.Itoa(os.Getpid())),
 		}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to write to Consul KV: %w", err)
@@ -147,3 +152,41 @@ func (s *StorageKV) Release(ips *Slot) error {
 
 	return nil
 }
+
+// Orphaned returns the slots reserved by processes on this node that are not running anymore.
+// It is called before the process acquires any slot, so the slots reserved with its pid are from a previous process too.
+func (s *StorageKV) Orphaned(_ context.Context) ([]*Slot, error) {
+	kv := s.consulClient.KV()
+
+	pairs, _, err := kv.List(s.clientID+"/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Consul KV: %w", err)
+	}
+
+	var slots []*Slot
+	for _, pair := range pairs {
+		pid, err := strconv.Atoi(string(pair.Value))
+		if err != nil {
+			// The slot was reserved without the owner, it can't be safely released.
+			continue
+		}
+
+		if pid != os.Getpid() && utils.ProcessExists(pid) {
+			continue
+		}
+
+		slotIdx, err := strconv.Atoi(strings.TrimPrefix(pair.Key, s.clientID+"/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid slot key '%s': %w", pair.Key, err)
+		}
+
+		slot, err := NewSlot(pair.Key, slotIdx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create slot '%s': %w", pair.Key, err)
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots, nil
+}
@@ -110,6 +110,12 @@ func (s *StorageLocal) Release(ips *Slot) error {
 	return nil
 }
 
+// Orphaned returns no slots, the local storage doesn't outlive the process.
+// The namespaces left behind by a crashed process are skipped as foreign.
+func (s *StorageLocal) Orphaned(_ context.Context) ([]*Slot, error) {
+	return nil, nil
+}
+
 func isNamespaceAvailable(name string) (bool, error) {
 	nsPath := filepath.Join(netNamespacesDir, name)
 	_, err := os.Stat(nsPath)
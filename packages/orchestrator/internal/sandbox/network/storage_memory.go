@@ -47,6 +47,11 @@ func (s *StorageMemory) Release(ips *Slot) error {
 	return nil
 }
 
+// Orphaned returns no slots, the memory storage doesn't outlive the process.
+func (s *StorageMemory) Orphaned(_ context.Context) ([]*Slot, error) {
+	return nil, nil
+}
+
 func getMemoryKey(slotIdx int) string {
 	return strconv.Itoa(slotIdx)
 }
@@ -0,0 +1,215 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"slices"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/coreos/go-iptables/iptables"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+)
+
+// teamNetworkNamespaceRules are the nat rules translating between the sandbox IP and its team network address in the slot namespace.
+func (s *Slot) teamNetworkNamespaceRules(address netip.Addr) (prerouting []string, postrouting []string) {
+	prerouting = []string{"-i", s.VpeerName(), "-d", address.String(), "-j", "DNAT", "--to-destination", s.NamespaceIP()}
+	postrouting = []string{"-o", s.VpeerName(), "-s", s.NamespaceIP(), "-d", consts.TeamNetworksCIDR, "-j", "SNAT", "--to-source", address.String()}
+
+	return prerouting, postrouting
+}
+
+// teamNetworkHostRules are the host forwarding rules of the team network traffic of the slot.
+// The traffic to the slot is dropped unless it is accepted by the rule of one of the peers.
+func (s *Slot) teamNetworkHostRules(address netip.Addr) (outbound []string, inbound []string) {
+	outbound = []string{"-i", s.VethName(), "-s", address.String(), "-j", "ACCEPT"}
+	inbound = []string{"-o", s.VethName(), "-d", address.String(), "-j", "DROP"}
+
+	return outbound, inbound
+}
+
+// teamNetworkPeerRule is the host forwarding rule accepting the team network traffic of the peer to the slot.
+func (s *Slot) teamNetworkPeerRule(address, peer netip.Addr) []string {
+	return []string{"-o", s.VethName(), "-s", peer.String(), "-d", address.String(), "-j", "ACCEPT"}
+}
+
+func (s *Slot) teamNetworkRoute(address netip.Addr) *netlink.Route {
+	return &netlink.Route{
+		Gw:  s.VpeerIP(),
+		Dst: &net.IPNet{IP: address.AsSlice(), Mask: net.CIDRMask(address.BitLen(), address.BitLen())},
+	}
+}
+
+// attachTeamNetwork routes the team network address to the slot namespace, where it's translated to the sandbox IP.
+func (s *Slot) attachTeamNetwork(address netip.Addr) error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		tables, err := iptables.New()
+		if err != nil {
+			return fmt.Errorf("error initializing iptables: %w", err)
+		}
+
+		prerouting, postrouting := s.teamNetworkNamespaceRules(address)
+
+		err = tables.AppendUnique("nat", "PREROUTING", prerouting...)
+		if err != nil {
+			return fmt.Errorf("error creating team network prerouting rule: %w", err)
+		}
+
+		exists, err := tables.Exists("nat", "POSTROUTING", postrouting...)
+		if err != nil {
+			return fmt.Errorf("error checking team network postrouting rule: %w", err)
+		}
+
+		// The rule must precede the SNAT to the host IP.
+		if !exists {
+			err = tables.Insert("nat", "POSTROUTING", 1, postrouting...)
+			if err != nil {
+				return fmt.Errorf("error creating team network postrouting rule: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+	}
+
+	err = netlink.RouteReplace(s.teamNetworkRoute(address))
+	if err != nil {
+		return fmt.Errorf("error adding team network route: %w", err)
+	}
+
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	outbound, inbound := s.teamNetworkHostRules(address)
+
+	err = tables.AppendUnique("filter", "FORWARD", outbound...)
+	if err != nil {
+		return fmt.Errorf("error creating team network forwarding rule: %w", err)
+	}
+
+	exists, err := tables.Exists("filter", "FORWARD", inbound...)
+	if err != nil {
+		return fmt.Errorf("error checking team network forwarding rule: %w", err)
+	}
+
+	// The rule must precede the acceptance of the traffic from the other slots, the peer rules are inserted before it.
+	if !exists {
+		err = tables.Insert("filter", "FORWARD", 1, inbound...)
+		if err != nil {
+			return fmt.Errorf("error creating team network forwarding rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// setTeamPeerRules replaces the host forwarding rules accepting the team network traffic of the peers to the slot.
+func (s *Slot) setTeamPeerRules(address netip.Addr, previous []netip.Addr, peers []netip.Addr) error {
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	var errs []error
+
+	for _, peer := range previous {
+		if slices.Contains(peers, peer) {
+			continue
+		}
+
+		err = tables.DeleteIfExists("filter", "FORWARD", s.teamNetworkPeerRule(address, peer)...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting team network peer rule of %s: %w", peer, err))
+		}
+	}
+
+	for _, peer := range peers {
+		rule := s.teamNetworkPeerRule(address, peer)
+
+		exists, err := tables.Exists("filter", "FORWARD", rule...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error checking team network peer rule of %s: %w", peer, err))
+
+			continue
+		}
+
+		if exists {
+			continue
+		}
+
+		// The rule must precede the drop of the other traffic to the slot.
+		err = tables.Insert("filter", "FORWARD", 1, rule...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error creating team network peer rule of %s: %w", peer, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// detachTeamNetwork removes the routing of the team network address to the slot.
+func (s *Slot) detachTeamNetwork(address netip.Addr) error {
+	var errs []error
+
+	tables, err := iptables.New()
+	if err != nil {
+		return fmt.Errorf("error initializing iptables: %w", err)
+	}
+
+	outbound, inbound := s.teamNetworkHostRules(address)
+
+	for _, rule := range [][]string{outbound, inbound} {
+		err = tables.DeleteIfExists("filter", "FORWARD", rule...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error deleting team network forwarding rule: %w", err))
+		}
+	}
+
+	err = netlink.RouteDel(s.teamNetworkRoute(address))
+	if err != nil && !errors.Is(err, unix.ESRCH) {
+		errs = append(errs, fmt.Errorf("error deleting team network route: %w", err))
+	}
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err))...)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		tables, err := iptables.New()
+		if err != nil {
+			return fmt.Errorf("error initializing iptables: %w", err)
+		}
+
+		prerouting, postrouting := s.teamNetworkNamespaceRules(address)
+
+		return errors.Join(
+			tables.DeleteIfExists("nat", "PREROUTING", prerouting...),
+			tables.DeleteIfExists("nat", "POSTROUTING", postrouting...),
+		)
+	})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err))
+	}
+
+	return errors.Join(errs...)
+}
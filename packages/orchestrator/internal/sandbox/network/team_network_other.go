@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package network
+
+import (
+	"errors"
+	"net/netip"
+)
+
+func (s *Slot) attachTeamNetwork(_ netip.Addr) error {
+	return errors.New("platform does not support team networks")
+}
+
+func (s *Slot) detachTeamNetwork(_ netip.Addr) error {
+	return nil
+}
+
+func (s *Slot) setTeamPeerRules(_ netip.Addr, _ []netip.Addr, _ []netip.Addr) error {
+	return nil
+}
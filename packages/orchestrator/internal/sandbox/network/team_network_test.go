@@ -0,0 +1,26 @@
+package network
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTeamNetworkAddress(t *testing.T) {
+	addr, err := parseTeamNetworkAddress("10.100.1.2")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParseAddr("10.100.1.2"), addr)
+
+	_, err = parseTeamNetworkAddress("10.101.0.1")
+	require.Error(t, err)
+
+	_, err = parseTeamNetworkAddress("sandbox")
+	require.Error(t, err)
+}
+
+func TestOverlayNodeMAC(t *testing.T) {
+	assert.Equal(t, net.HardwareAddr{0x02, 0xe2, 10, 0, 0, 7}, overlayNodeMAC(netip.MustParseAddr("10.0.0.7")))
+}
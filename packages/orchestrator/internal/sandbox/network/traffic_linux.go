@@ -0,0 +1,183 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+)
+
+// trafficTableName is the host table counting the internet traffic of the slots.
+const trafficTableName = "slot-traffic"
+
+var trafficTable = &nftables.Table{
+	Name:   trafficTableName,
+	Family: nftables.TableFamilyINet,
+}
+
+// trafficChain runs after the filter chains, so only the traffic accepted by the host forwarding rules is counted.
+func trafficChain() *nftables.Chain {
+	acceptPolicy := nftables.ChainPolicyAccept
+
+	return &nftables.Chain{
+		Name:     "FORWARD",
+		Table:    trafficTable,
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookForward,
+		Priority: nftables.ChainPriorityRef(*nftables.ChainPriorityFilter + 10),
+		Policy:   &acceptPolicy,
+	}
+}
+
+// trafficGateways are the interfaces the internet traffic of the slots is forwarded through.
+func trafficGateways() []string {
+	gateways := []string{defaultGateway}
+	if defaultGatewayIPv6 != "" && defaultGatewayIPv6 != defaultGateway {
+		gateways = append(gateways, defaultGatewayIPv6)
+	}
+
+	return gateways
+}
+
+func (s *Slot) trafficCounter(direction string) *nftables.CounterObj {
+	return &nftables.CounterObj{
+		Table: trafficTable,
+		Name:  fmt.Sprintf("%s-%s", s.VethName(), direction),
+	}
+}
+
+// addTrafficCounters counts the traffic forwarded between the slot veth and the default gateways, it has to be called in the host namespace.
+// The traffic of envd and client-proxy ends on the host, so it isn't counted.
+func (s *Slot) addTrafficCounters() error {
+	conn, err := nftables.New()
+	if err != nil {
+		return fmt.Errorf("new nftables conn: %w", err)
+	}
+
+	conn.AddTable(trafficTable)
+	chain := conn.AddChain(trafficChain())
+
+	tx := conn.AddObj(s.trafficCounter("tx")).(*nftables.CounterObj)
+	rx := conn.AddObj(s.trafficCounter("rx")).(*nftables.CounterObj)
+
+	for _, gateway := range trafficGateways() {
+		conn.AddRule(&nftables.Rule{
+			Table:    trafficTable,
+			Chain:    chain,
+			Exprs:    countInterfaces(s.VethName(), gateway, tx.Name),
+			UserData: []byte(s.VethName()),
+		})
+
+		conn.AddRule(&nftables.Rule{
+			Table:    trafficTable,
+			Chain:    chain,
+			Exprs:    countInterfaces(gateway, s.VethName(), rx.Name),
+			UserData: []byte(s.VethName()),
+		})
+	}
+
+	err = conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush slot traffic counters: %w", err)
+	}
+
+	return nil
+}
+
+// removeTrafficCounters removes the rules and the counters of the slot, the missing ones are skipped.
+func (s *Slot) removeTrafficCounters() error {
+	conn, err := nftables.New()
+	if err != nil {
+		return fmt.Errorf("new nftables conn: %w", err)
+	}
+
+	rules, err := conn.GetRules(trafficTable, trafficChain())
+	if errors.Is(err, unix.ENOENT) {
+		// The table was never created.
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("get slot traffic rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if string(rule.UserData) != s.VethName() {
+			continue
+		}
+
+		err = conn.DelRule(rule)
+		if err != nil {
+			return fmt.Errorf("delete slot traffic rule: %w", err)
+		}
+	}
+
+	objs, err := conn.GetObjects(trafficTable)
+	if err != nil {
+		return fmt.Errorf("get slot traffic counters: %w", err)
+	}
+
+	names := []string{s.trafficCounter("tx").Name, s.trafficCounter("rx").Name}
+	for _, obj := range objs {
+		counter, ok := obj.(*nftables.CounterObj)
+		if ok && slices.Contains(names, counter.Name) {
+			conn.DeleteObject(counter)
+		}
+	}
+
+	err = conn.Flush()
+	if err != nil {
+		return fmt.Errorf("flush slot traffic counters removal: %w", err)
+	}
+
+	return nil
+}
+
+// readTraffic reads the counters of the internet traffic sent and received by the slot.
+func (s *Slot) readTraffic() (tx *nftables.CounterObj, rx *nftables.CounterObj, err error) {
+	conn, err := nftables.New()
+	if err != nil {
+		return nil, nil, fmt.Errorf("new nftables conn: %w", err)
+	}
+
+	tx, err = getCounter(conn, s.trafficCounter("tx"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get slot traffic tx counter: %w", err)
+	}
+
+	rx, err = getCounter(conn, s.trafficCounter("rx"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get slot traffic rx counter: %w", err)
+	}
+
+	return tx, rx, nil
+}
+
+func getCounter(conn *nftables.Conn, counter *nftables.CounterObj) (*nftables.CounterObj, error) {
+	obj, err := conn.GetObject(counter)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := obj.(*nftables.CounterObj)
+	if !ok {
+		return nil, fmt.Errorf("unexpected counter type %T", obj)
+	}
+
+	return current, nil
+}
+
+// countInterfaces counts the packets forwarded from the input to the output interface in the named counter.
+func countInterfaces(input, output, counter string) []expr.Any {
+	return []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: append([]byte(input), 0)},
+		&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: append([]byte(output), 0)},
+		&expr.Objref{Type: int(nftables.ObjTypeCounter), Name: counter},
+	}
+}
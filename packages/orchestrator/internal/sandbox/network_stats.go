@@ -0,0 +1,67 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// readPausedNetworkStats reads the network counters the sandbox was paused with in background, the sandboxes that were never paused start from zero.
+func readPausedNetworkStats(ctx context.Context, persistence storage.StorageProvider, files *storage.TemplateFiles) chan network.Stats {
+	statsCh := make(chan network.Stats, 1)
+
+	go func() {
+		stats, err := storage.ReadNetworkStats(ctx, persistence, files)
+		if errors.Is(err, storage.ErrorObjectNotExist) {
+			statsCh <- network.Stats{}
+
+			return
+		} else if err != nil {
+			zap.L().Warn("failed to read the network stats of the paused sandbox", zap.String("build_id", files.BuildId), zap.Error(err))
+			statsCh <- network.Stats{}
+
+			return
+		}
+
+		statsCh <- network.Stats{
+			RxBytes:        stats.RxBytes,
+			TxBytes:        stats.TxBytes,
+			RxPackets:      stats.RxPackets,
+			TxPackets:      stats.TxPackets,
+			NewConnections: stats.NewConnections,
+		}
+	}()
+
+	return statsCh
+}
+
+// NetworkStats returns the network counters of the sandbox, they continue from the ones the sandbox was paused with.
+func (s *Sandbox) NetworkStats() (network.Stats, error) {
+	stats, err := s.Slot.Stats()
+	if err != nil {
+		return network.Stats{}, err
+	}
+
+	return stats.Add(s.pausedNetworkStats), nil
+}
+
+// UploadNetworkStats stores the network counters of the paused sandbox next to its snapshot, so they are carried over to the resumed sandbox.
+func (s *Sandbox) UploadNetworkStats(ctx context.Context, persistence storage.StorageProvider, files *storage.TemplateFiles) error {
+	stats, err := s.NetworkStats()
+	if err != nil {
+		return fmt.Errorf("failed to get network stats: %w", err)
+	}
+
+	return storage.UploadNetworkStats(ctx, persistence, files, &storage.NetworkStats{
+		RxBytes:        stats.RxBytes,
+		TxBytes:        stats.TxBytes,
+		RxPackets:      stats.RxPackets,
+		TxPackets:      stats.TxPackets,
+		NewConnections: stats.NewConnections,
+	})
+}
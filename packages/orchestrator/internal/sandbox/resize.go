@@ -0,0 +1,126 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/cgroup"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+)
+
+// minRamMb is the smallest memory limit, the guest needs some memory for the kernel and envd.
+const minRamMb = 128
+
+var ErrInvalidResources = errors.New("invalid sandbox resources")
+
+// Resize limits the sandbox to the resources within the size of its VM, the VM itself can't grow.
+// The VM boots with the maximum resources of the team's tier, they are the headroom the sandbox can be resized up to.
+// The memory above the limit is held by the balloon and the CPU time is limited by the cgroup quota of the Firecracker process.
+func (s *Sandbox) Resize(ctx context.Context, resources *orchestrator.SandboxResources) error {
+	vcpu := resources.GetVcpu()
+	ramMb := resources.GetRamMb()
+
+	if vcpu < 1 || vcpu > s.Config.Vcpu {
+		return fmt.Errorf("%w: vCPU count must be between 1 and %d", ErrInvalidResources, s.Config.Vcpu)
+	}
+
+	if ramMb < minRamMb || ramMb > s.Config.RamMb {
+		return fmt.Errorf("%w: memory must be between %d and %d MiB", ErrInvalidResources, minRamMb, s.Config.RamMb)
+	}
+
+	s.resourcesMu.Lock()
+	defer s.resourcesMu.Unlock()
+
+	currentVcpu, currentRamMb := s.limits()
+
+	if ramMb != currentRamMb {
+		// The balloon is set to the new limit at once, so the memory is available to the guest right away.
+		// The balloon reclaimer shrinks the idle guest again later.
+		err := s.process.SetBalloon(ctx, s.Config.RamMb-ramMb)
+		if err != nil {
+			return fmt.Errorf("failed to resize memory, the sandbox may not have a balloon device: %w", err)
+		}
+	}
+
+	if vcpu != currentVcpu {
+		err := s.setCPULimit(vcpu)
+		if err != nil {
+			return fmt.Errorf("failed to resize vCPUs: %w", err)
+		}
+	}
+
+	s.appliedResources = &orchestrator.SandboxResources{
+		Vcpu:  vcpu,
+		RamMb: ramMb,
+	}
+	s.Config.Resources = s.appliedResources
+
+	return nil
+}
+
+// limits returns the resources the sandbox is limited to, the whole VM if it was not resized.
+func (s *Sandbox) limits() (vcpu int64, ramMb int64) {
+	if s.appliedResources == nil {
+		return s.Config.Vcpu, s.Config.RamMb
+	}
+
+	return s.appliedResources.Vcpu, s.appliedResources.RamMb
+}
+
+// setCPULimit sets the CPU quota of the Firecracker process, the cgroup is created on the first resize.
+func (s *Sandbox) setCPULimit(vcpu int64) error {
+	if s.cpu == nil {
+		pid, err := s.process.Pid()
+		if err != nil {
+			return err
+		}
+
+		// The execution ID is unique on the node, the sandbox ID is shared with the sandbox resumed after the pause.
+		cpu, err := cgroup.NewCPU(s.Config.ExecutionId)
+		if err != nil {
+			return err
+		}
+
+		err = cpu.Add(pid)
+		if err != nil {
+			return errors.Join(err, cpu.Remove())
+		}
+
+		s.cpu = cpu
+
+		s.cleanup.Add(func(ctx context.Context) error {
+			return cpu.Remove()
+		})
+	}
+
+	limit := vcpu
+	if vcpu == s.Config.Vcpu {
+		// The sandbox can use all the vCPUs of the VM.
+		limit = 0
+	}
+
+	return s.cpu.SetLimit(limit)
+}
+
+// applyResources sets the resources the sandbox was created with or limited to before it was paused.
+func (s *Sandbox) applyResources(ctx context.Context) {
+	resources := s.Config.GetResources()
+	if resources == nil {
+		return
+	}
+
+	err := s.Resize(ctx, resources)
+	if err != nil {
+		// The sandbox keeps running with the whole VM, so it can be still used.
+		sbxlogger.I(s).Warn("failed to apply the sandbox resources", zap.Error(err))
+
+		s.Config.Resources = s.appliedResources
+	}
+}
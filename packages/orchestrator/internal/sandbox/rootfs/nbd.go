@@ -114,6 +114,26 @@ func (o *NBDProvider) ExportDiff(
 	return m, nil
 }
 
+func (o *NBDProvider) SnapshotDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	childCtx, childSpan := o.tracer.Start(ctx, "cow-snapshot")
+	defer childSpan.End()
+
+	// The writes of the paused VM can be still in the page cache of the device.
+	err := o.flush(childCtx)
+	if err != nil {
+		return nil, fmt.Errorf("error flushing cow device: %w", err)
+	}
+
+	m, err := o.overlay.ExportToDiff(out)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting cache: %w", err)
+	}
+
+	telemetry.ReportEvent(childCtx, "cache exported")
+
+	return m, nil
+}
+
 func (o *NBDProvider) Close(ctx context.Context) error {
 	childCtx, childSpan := o.tracer.Start(ctx, "cow-close")
 	defer childSpan.End()
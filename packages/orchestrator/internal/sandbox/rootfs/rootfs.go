@@ -12,4 +12,6 @@ type Provider interface {
 	Close(ctx context.Context) error
 	Path() (string, error)
 	ExportDiff(ctx context.Context, out io.Writer, stopSandbox func(context.Context) error) (*header.DiffMetadata, error)
+	// SnapshotDiff exports the diff without stopping the sandbox, the VM has to be paused.
+	SnapshotDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error)
 }
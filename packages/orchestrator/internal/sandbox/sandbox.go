@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/cgroup"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
@@ -38,10 +40,6 @@ import (
 
 var defaultEnvdTimeout = utils.Must(time.ParseDuration(env.GetEnv("ENVD_TIMEOUT", "45s")))
 
-var httpClient = http.Client{
-	Timeout: 10 * time.Second,
-}
-
 const (
 	hostIfacePollInterval = 50 * time.Millisecond
 	hostIfaceReadyTimeout = 2 * time.Second
@@ -58,6 +56,9 @@ type Metadata struct {
 	Config    *orchestrator.SandboxConfig
 	StartedAt time.Time
 	EndAt     time.Time
+
+	// configMu guards the swap of the config when a warm sandbox is assigned, the checks read the config concurrently.
+	configMu sync.RWMutex
 }
 
 type Sandbox struct {
@@ -68,20 +69,47 @@ type Sandbox struct {
 	cleanup *Cleanup
 
 	process *fc.Process
+	// envdTransport is used for all the requests to envd, so the connections to it are reused.
+	envdTransport *http.Transport
 
 	template template.Template
 
-	Checks *Checks
+	Checks  *Checks
+	balloon *BalloonReclaimer
+
+	// snapshotMu prevents pausing the sandbox while its live snapshot is being taken.
+	snapshotMu sync.Mutex
+
+	// resourcesMu serializes the resizes with the balloon reclaimer.
+	resourcesMu      sync.Mutex
+	appliedResources *orchestrator.SandboxResources
+	cpu              *cgroup.CPU
+	// pausedNetworkStats are the network counters the sandbox was paused with.
+	pausedNetworkStats network.Stats
 }
 
 func (m *Metadata) LoggerMetadata() sbxlogger.SandboxMetadata {
+	config := m.config()
+
 	return sbxlogger.SandboxMetadata{
-		SandboxID:  m.Config.SandboxId,
-		TemplateID: m.Config.TemplateId,
-		TeamID:     m.Config.TeamId,
+		SandboxID:  config.SandboxId,
+		TemplateID: config.TemplateId,
+		TeamID:     config.TeamId,
 	}
 }
 
+// config returns the current config of the sandbox, it is used by the code running alongside the assignment of a warm sandbox.
+func (m *Metadata) config() *orchestrator.SandboxConfig {
+	m.configMu.RLock()
+	defer m.configMu.RUnlock()
+
+	return m.Config
+}
+
+// Recorder records the sandbox resources before the VM is started, so they can be cleaned up if the orchestrator crashes.
+// The sandbox is not started if the recording fails.
+type Recorder func(slot *network.Slot, hostFiles []string) error
+
 type networkSlotRes struct {
 	slot *network.Slot
 	err  error
@@ -92,12 +120,14 @@ func CreateSandbox(
 	tracer trace.Tracer,
 	networkPool *network.Pool,
 	devicePool *nbd.DevicePool,
+	volumeStorage storage.StorageProvider,
 	config *orchestrator.SandboxConfig,
 	template template.Template,
 	sandboxTimeout time.Duration,
 	rootfsCachePath string,
 	processOptions fc.ProcessOptions,
 	allowInternet bool,
+	record Recorder,
 ) (*Sandbox, *Cleanup, error) {
 	childCtx, childSpan := tracer.Start(ctx, "new-sandbox")
 	defer childSpan.End()
@@ -110,7 +140,7 @@ func CreateSandbox(
 
 	cleanup := NewCleanup()
 
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet, config.GetEgressPolicy(), config.GetNetwork())
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -171,6 +201,11 @@ func CreateSandbox(
 		return nil, cleanup, fmt.Errorf("failed to get memfile size: %w", err)
 	}
 
+	volumePaths, err := attachVolumes(childCtx, tracer, devicePool, cleanup, volumeStorage, sandboxFiles, config.Volumes)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to attach volumes: %w", err)
+	}
+
 	// / ==== END of resources initialization ====
 	rootfsPath, err := rootfsProvider.Path()
 	if err != nil {
@@ -229,11 +264,20 @@ func CreateSandbox(
 		zap.String("base_template_id", config.BaseTemplateId),
 		zap.String("build_id", config.BuildId),
 	)
+
+	if record != nil {
+		err = record(ips.slot, hostFiles(sandboxFiles, config))
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to record sandbox: %w", err)
+		}
+	}
+
 	fcHandle, err := fc.NewProcess(
 		childCtx,
 		tracer,
 		ips.slot,
 		sandboxFiles,
+		config.FirecrackerVersion,
 		rootfsPath,
 		config.BaseTemplateId,
 		config.BuildId,
@@ -269,6 +313,9 @@ func CreateSandbox(
 		config.Vcpu,
 		config.RamMb,
 		config.HugePages,
+		volumePaths,
+		config.DiskRateLimiter,
+		config.NetworkRateLimiter,
 		processOptions,
 	)
 	if err != nil {
@@ -295,8 +342,9 @@ func CreateSandbox(
 		Metadata:  metadata,
 
 		template: template,
-		files:    sandboxFiles,
-		process:  fcHandle,
+		files:         sandboxFiles,
+		process:       fcHandle,
+		envdTransport: newEnvdTransport(fcHandle.VsockPath()),
 
 		cleanup: cleanup,
 	}
@@ -306,6 +354,7 @@ func CreateSandbox(
 		return nil, cleanup, fmt.Errorf("failed to create health check: %w", err)
 	}
 	sbx.Checks = checks
+	sbx.balloon = NewBalloonReclaimer(sbx)
 
 	cleanup.AddPriority(func(ctx context.Context) error {
 		return sbx.Close(ctx, tracer)
@@ -323,7 +372,11 @@ func CreateSandbox(
 		}
 	}
 
+	// The VM boots with the maximum resources of the team's tier, the sandbox starts with less and can be resized up to them.
+	sbx.applyResources(ctx)
+
 	go sbx.Checks.Start()
+	go sbx.balloon.Start()
 
 	return sbx, cleanup, nil
 }
@@ -336,14 +389,17 @@ func ResumeSandbox(
 	tracer trace.Tracer,
 	networkPool *network.Pool,
 	templateCache *template.Cache,
+	persistence storage.StorageProvider,
 	config *orchestrator.SandboxConfig,
 	traceID string,
 	startedAt time.Time,
 	endAt time.Time,
 	baseTemplateID string,
 	devicePool *nbd.DevicePool,
+	volumeStorage storage.StorageProvider,
 	allowInternet,
 	useClickhouseMetrics bool,
+	record Recorder,
 ) (*Sandbox, *Cleanup, error) {
 	childCtx, childSpan := tracer.Start(ctx, "new-sandbox")
 	defer childSpan.End()
@@ -360,7 +416,14 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
 	}
 
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	firecrackerVersion, err := selectFirecracker(childCtx, t, config)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	pausedNetworkStatsCh := readPausedNetworkStats(childCtx, persistence, t.Files().TemplateFiles)
+
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet, config.GetEgressPolicy(), config.GetNetwork())
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -405,6 +468,8 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to get memfile: %w", err)
 	}
 
+	memfile = traceMemory(cleanup, persistence, t.Files().TemplateFiles, memfile, config.SandboxId)
+
 	fcUffdPath := sandboxFiles.SandboxUffdSocketPath()
 
 	fcUffd, err := serveMemory(
@@ -430,6 +495,11 @@ func ResumeSandbox(
 		cancelUffdStartCtx(fmt.Errorf("uffd process exited: %w", errors.Join(uffdWaitErr, context.Cause(uffdStartCtx))))
 	}()
 
+	volumePaths, err := attachVolumes(childCtx, tracer, devicePool, cleanup, volumeStorage, sandboxFiles, config.Volumes)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to attach volumes: %w", err)
+	}
+
 	// / ==== END of resources initialization ====
 	rootfsPath, err := rootfsOverlay.Path()
 	if err != nil {
@@ -439,11 +509,20 @@ func ResumeSandbox(
 	if ips.err != nil {
 		return nil, cleanup, fmt.Errorf("failed to get network slot: %w", err)
 	}
+
+	if record != nil {
+		err = record(ips.slot, hostFiles(sandboxFiles, config))
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to record sandbox: %w", err)
+		}
+	}
+
 	fcHandle, fcErr := fc.NewProcess(
 		uffdStartCtx,
 		tracer,
 		ips.slot,
 		sandboxFiles,
+		firecrackerVersion,
 		rootfsPath,
 		baseTemplateID,
 		readonlyRootfs.Header().Metadata.BaseBuildId.String(),
@@ -470,6 +549,7 @@ func ResumeSandbox(
 		fcUffdPath,
 		snapfile,
 		fcUffd.Ready(),
+		volumePaths,
 	)
 	if fcStartErr != nil {
 		return nil, cleanup, fmt.Errorf("failed to start FC: %w", fcStartErr)
@@ -477,6 +557,12 @@ func ResumeSandbox(
 
 	telemetry.ReportEvent(childCtx, "initialized FC")
 
+	// The snapshot keeps the limits of the paused sandbox, the current ones are applied on top of them.
+	err = fcHandle.SetRateLimiters(childCtx, config.DiskRateLimiter, config.NetworkRateLimiter)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to set FC rate limiters: %w", err)
+	}
+
 	resources := &Resources{
 		Slot:     ips.slot,
 		rootfs:   rootfsOverlay,
@@ -496,10 +582,13 @@ func ResumeSandbox(
 		Metadata:  metadata,
 
 		template: t,
-		files:    sandboxFiles,
-		process:  fcHandle,
+		files:         sandboxFiles,
+		process:       fcHandle,
+		envdTransport: newEnvdTransport(fcHandle.VsockPath()),
 
 		cleanup: cleanup,
+
+		pausedNetworkStats: <-pausedNetworkStatsCh,
 	}
 
 	// Part of the sandbox as we need to stop Checks before pausing the sandbox
@@ -510,6 +599,7 @@ func ResumeSandbox(
 	}
 
 	sbx.Checks = checks
+	sbx.balloon = NewBalloonReclaimer(sbx)
 
 	cleanup.AddPriority(func(ctx context.Context) error {
 		return sbx.Close(ctx, tracer)
@@ -524,7 +614,10 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to wait for sandbox start: %w", err)
 	}
 
+	sbx.applyResources(ctx)
+
 	go sbx.Checks.Start()
+	go sbx.balloon.Start()
 
 	return sbx, cleanup, nil
 }
@@ -544,6 +637,94 @@ func (s *Sandbox) Wait(ctx context.Context) error {
 	}
 }
 
+// UpdateRateLimiters changes the I/O limits of the running sandbox, nil limits are left unchanged.
+func (s *Sandbox) UpdateRateLimiters(ctx context.Context, disk *orchestrator.RateLimiter, network *orchestrator.RateLimiter) error {
+	err := s.process.SetRateLimiters(ctx, disk, network)
+	if err != nil {
+		return fmt.Errorf("failed to update rate limiters: %w", err)
+	}
+
+	if disk != nil {
+		s.Config.DiskRateLimiter = disk
+	}
+
+	if network != nil {
+		s.Config.NetworkRateLimiter = network
+	}
+
+	return nil
+}
+
+// Assign hands the sandbox booted for the warm pool over to its owner.
+// The config of the owner replaces the warm one, the identity of the sandbox is set in MMDS and envd is initialized with the env vars and the access token.
+func (s *Sandbox) Assign(
+	ctx context.Context,
+	tracer trace.Tracer,
+	config *orchestrator.SandboxConfig,
+	traceID string,
+	startedAt time.Time,
+	endAt time.Time,
+) error {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-assign")
+	defer childSpan.End()
+
+	err := s.process.SetMmds(ctx, &fc.MmdsMetadata{
+		SandboxId:            config.SandboxId,
+		TemplateId:           config.TemplateId,
+		LogsCollectorAddress: os.Getenv("LOGS_COLLECTOR_PUBLIC_IP"),
+		TraceId:              traceID,
+		TeamId:               config.TeamId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set mmds: %w", err)
+	}
+
+	err = s.process.SetRateLimiters(ctx, config.DiskRateLimiter, config.NetworkRateLimiter)
+	if err != nil {
+		return fmt.Errorf("failed to set FC rate limiters: %w", err)
+	}
+
+	initCtx, cancel := context.WithTimeout(ctx, defaultEnvdTimeout)
+	defer cancel()
+
+	err = s.initEnvd(initCtx, tracer, config.EnvVars, config.EnvdAccessToken, config.EnvdAccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to init envd: %w", err)
+	}
+
+	s.configMu.Lock()
+	s.Metadata.Config = config
+	s.StartedAt = startedAt
+	s.EndAt = endAt
+	s.configMu.Unlock()
+
+	// The warm VM boots with the size of the new sandboxes, the sandbox is limited to its resources now.
+	s.applyResources(ctx)
+
+	return nil
+}
+
+// HostFiles returns the paths of the sandbox files on the host, they are removed when the sandbox is cleaned up.
+func (s *Sandbox) HostFiles() []string {
+	return hostFiles(s.files, s.Config)
+}
+
+func hostFiles(files *storage.SandboxFiles, config *orchestrator.SandboxConfig) []string {
+	paths := []string{
+		files.SandboxCacheRootfsPath(),
+		files.SandboxCacheRootfsLinkPath(),
+		files.SandboxFirecrackerSocketPath(),
+		files.SandboxUffdSocketPath(),
+		files.SandboxRuntimeDir(),
+	}
+
+	for _, volume := range config.GetVolumes() {
+		paths = append(paths, files.SandboxCacheVolumePath(volume.GetVolumeId()))
+	}
+
+	return paths
+}
+
 // Stop starts the cleanup process for the sandbox.
 func (s *Sandbox) Stop(ctx context.Context) error {
 	err := s.cleanup.Run(ctx)
@@ -563,12 +744,15 @@ func (s *Sandbox) Close(ctx context.Context, tracer trace.Tracer) error {
 
 	// Stop the health checks before stopping the sandbox
 	s.Checks.Stop()
+	s.balloon.Stop()
 
 	fcStopErr := s.process.Stop()
 	if fcStopErr != nil {
 		errs = append(errs, fmt.Errorf("failed to stop FC: %w", fcStopErr))
 	}
 
+	s.envdTransport.CloseIdleConnections()
+
 	uffdStopErr := s.Resources.memory.Stop()
 	if uffdStopErr != nil {
 		errs = append(errs, fmt.Errorf("failed to stop uffd: %w", uffdStopErr))
@@ -590,8 +774,17 @@ func (s *Sandbox) Pause(
 		return nil, fmt.Errorf("failed to parse build id: %w", err)
 	}
 
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
 	// Stop the health check before pausing the VM
 	s.Checks.Stop()
+	s.balloon.Stop()
+
+	// Return the reclaimed memory to the guest, the pages stay unpopulated and are left out of the snapshot.
+	if err := s.process.DeflateBalloon(childCtx, tracer); err != nil {
+		sbxlogger.I(s).Warn("failed to deflate the balloon before pause", zap.Error(err))
+	}
 
 	if err := s.process.Pause(childCtx, tracer); err != nil {
 		return nil, fmt.Errorf("failed to pause VM: %w", err)
@@ -681,6 +874,166 @@ func (s *Sandbox) Pause(
 	}, nil
 }
 
+// LiveSnapshot snapshots the sandbox like Pause, but the sandbox keeps running after the snapshot.
+// The VM is paused only until the memory is dumped and the rootfs diff is exported.
+//
+// The memory pages that were never touched by the VM are read from the template during the snapshot,
+// so they stay populated in the running sandbox.
+func (s *Sandbox) LiveSnapshot(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	return s.liveSnapshot(ctx, tracer, snapshotTemplateFiles, false)
+}
+
+// MigrationSnapshot snapshots the sandbox like LiveSnapshot, but the VM stays paused after the snapshot,
+// so the sandbox doesn't diverge from the snapshot while it is migrated.
+// The VM is resumed if the snapshot fails, Unpause resumes it if the migration fails later.
+func (s *Sandbox) MigrationSnapshot(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	return s.liveSnapshot(ctx, tracer, snapshotTemplateFiles, true)
+}
+
+// Unpause resumes the VM paused by MigrationSnapshot.
+func (s *Sandbox) Unpause(ctx context.Context, tracer trace.Tracer) error {
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	err := s.process.Unpause(ctx, tracer)
+	if err != nil {
+		return fmt.Errorf("failed to resume VM: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Sandbox) liveSnapshot(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+	keepPaused bool,
+) (snapshot *Snapshot, e error) {
+	childCtx, childSpan := tracer.Start(ctx, "sandbox-live-snapshot")
+	defer childSpan.End()
+
+	buildID, err := uuid.Parse(snapshotTemplateFiles.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	originalMemfile, err := s.template.Memfile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original memfile: %w", err)
+	}
+	originalRootfs, err := s.template.Rootfs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+	}
+
+	memfile, err := storage.AcquireTmpMemfile(childCtx, buildID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire memfile snapshot: %w", err)
+	}
+	// Close the file even if an error occurs
+	defer memfile.Close()
+
+	if err := s.process.Pause(childCtx, tracer); err != nil {
+		return nil, fmt.Errorf("failed to pause VM: %w", err)
+	}
+
+	paused := true
+	unpause := func() error {
+		if !paused {
+			return nil
+		}
+
+		paused = false
+
+		return s.process.Unpause(childCtx, tracer)
+	}
+	defer func() {
+		if e == nil {
+			return
+		}
+
+		if err := unpause(); err != nil {
+			e = errors.Join(e, fmt.Errorf("failed to resume VM: %w", err))
+		}
+	}()
+
+	if err := s.memory.Track(); err != nil {
+		return nil, fmt.Errorf("failed to track memory: %w", err)
+	}
+
+	snapfile := template.NewLocalFileLink(snapshotTemplateFiles.CacheSnapfilePath())
+
+	err = s.process.CreateSnapshot(
+		childCtx,
+		tracer,
+		snapfile.Path(),
+		memfile.Path(),
+	)
+	s.memory.Untrack()
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error creating snapshot: %w", err), snapfile.Close())
+	}
+
+	// The rootfs is exported before the VM writes to it again.
+	rootfsDiff, rootfsDiffHeader, err := pauseProcessRootfs(
+		childCtx,
+		tracer,
+		buildID,
+		originalRootfs.Header(),
+		&RootfsSnapshotDiffCreator{rootfs: s.rootfs},
+	)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error while post processing: %w", err), snapfile.Close())
+	}
+
+	if !keepPaused {
+		err = unpause()
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to resume VM: %w", err), rootfsDiff.Close(), snapfile.Close())
+		}
+
+		telemetry.ReportEvent(childCtx, "resumed VM after snapshot")
+	}
+
+	memfileDiff, memfileDiffHeader, err := pauseProcessMemory(
+		childCtx,
+		tracer,
+		buildID,
+		originalMemfile.Header(),
+		&MemoryDiffCreator{
+			tracer:     tracer,
+			memfile:    memfile,
+			dirtyPages: s.memory.Dirty(),
+			blockSize:  originalMemfile.BlockSize(),
+			doneHook: func(ctx context.Context) error {
+				return memfile.Close()
+			},
+		},
+	)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error while post processing: %w", err), rootfsDiff.Close(), snapfile.Close())
+	}
+
+	return &Snapshot{
+		Snapfile:          snapfile,
+		MemfileDiff:       memfileDiff,
+		MemfileDiffHeader: memfileDiffHeader,
+		RootfsDiff:        rootfsDiff,
+		RootfsDiffHeader:  rootfsDiffHeader,
+	}, nil
+}
+
 type Snapshot struct {
 	MemfileDiff       build.Diff
 	MemfileDiffHeader *header.Header
@@ -826,6 +1179,8 @@ func getNetworkSlotAsync(
 	networkPool *network.Pool,
 	cleanup *Cleanup,
 	allowInternet bool,
+	egressPolicy *orchestrator.SandboxEgressPolicy,
+	teamNetwork *orchestrator.SandboxNetwork,
 ) chan networkSlotRes {
 	networkCtx, networkSpan := tracer.Start(ctx, "get-network-slot")
 	defer networkSpan.End()
@@ -835,7 +1190,7 @@ func getNetworkSlotAsync(
 	go func() {
 		defer close(r)
 
-		ips, err := networkPool.Get(networkCtx, tracer, allowInternet)
+		ips, err := networkPool.Get(networkCtx, tracer, allowInternet, egressPolicy)
 		if err != nil {
 			r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
 			return
@@ -856,6 +1211,15 @@ func getNetworkSlotAsync(
 			return nil
 		})
 
+		// The slot is detached from the team network when it's returned to the pool.
+		if teamNetwork != nil {
+			err = ips.AttachTeamNetwork(networkCtx, tracer, teamNetwork)
+			if err != nil {
+				r <- networkSlotRes{nil, fmt.Errorf("failed to attach team network: %w", err)}
+				return
+			}
+		}
+
 		r <- networkSlotRes{ips, nil}
 	}()
 
@@ -1023,13 +1387,22 @@ func (s *Sandbox) WaitForEnvd(
 		}
 	}
 
-	initErr := s.initEnvd(syncCtx, tracer, s.Metadata.Config.EnvVars, s.Metadata.Config.EnvdAccessToken)
+	// The envd of a fork still has the token of the forked sandbox, it is re-keyed to the token of the fork.
+	authToken := s.Metadata.Config.EnvdAccessToken
+	if s.Metadata.Config.PreviousEnvdAccessToken != nil {
+		authToken = s.Metadata.Config.PreviousEnvdAccessToken
+	}
+
+	initErr := s.initEnvd(syncCtx, tracer, s.Metadata.Config.EnvVars, s.Metadata.Config.EnvdAccessToken, authToken)
 	if initErr != nil {
 		return fmt.Errorf("failed to init new envd: %w", initErr)
 	} else {
 		telemetry.ReportEvent(syncCtx, fmt.Sprintf("[sandbox %s]: initialized new envd", s.Metadata.Config.SandboxId))
 	}
 
+	// The envd has the token of the config now, e.g. the snapshots of the sandbox are resumed with it.
+	s.Metadata.Config.PreviousEnvdAccessToken = nil
+
 	return nil
 }
 
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/chunkcache"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
@@ -26,6 +29,12 @@ const (
 	buildCacheMaxUsedPercentage = 75.0
 )
 
+var (
+	chunkCachePath = env.GetEnv("CHUNK_CACHE_PATH", chunkcache.DefaultPath)
+	// chunkCacheMaxSizeMB is the size limit of the node-wide chunk cache, 0 disables the cache.
+	chunkCacheMaxSizeMB, _ = env.GetEnvAsInt("CHUNK_CACHE_MAX_SIZE_MB", 0)
+)
+
 type Cache struct {
 	cache       *ttlcache.Cache[string, Template]
 	persistence storage.StorageProvider
@@ -33,7 +42,7 @@ type Cache struct {
 	buildStore  *build.DiffStore
 }
 
-func NewCache(ctx context.Context) (*Cache, error) {
+func NewCache(ctx context.Context, meterProvider metric.MeterProvider) (*Cache, error) {
 	cache := ttlcache.New(
 		ttlcache.WithTTL[string, Template](templateExpiration),
 	)
@@ -49,12 +58,23 @@ func NewCache(ctx context.Context) (*Cache, error) {
 
 	go cache.Start()
 
+	var chunks *chunkcache.Cache
+	if chunkCacheMaxSizeMB > 0 {
+		c, err := chunkcache.New(chunkCachePath, int64(chunkCacheMaxSizeMB)<<build.ToMBShift, meterProvider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create chunk cache: %w", err)
+		}
+
+		chunks = c
+	}
+
 	buildStore, err := build.NewDiffStore(
 		ctx,
 		build.DefaultCachePath,
 		buildCacheTTL,
 		buildCacheDelayEviction,
 		buildCacheMaxUsedPercentage,
+		chunks,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create build store: %w", err)
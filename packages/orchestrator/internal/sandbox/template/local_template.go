@@ -44,6 +44,10 @@ func (t *LocalTemplate) Snapfile() (File, error) {
 	return &NoopSnapfile{}, nil
 }
 
+func (t *LocalTemplate) SnapshotMetadata() (*storage.SnapshotMetadata, error) {
+	return &storage.SnapshotMetadata{FirecrackerVersion: t.files.FirecrackerVersion}, nil
+}
+
 type NoopSnapfile struct{}
 
 func (n *NoopSnapfile) Close() error {
@@ -2,6 +2,7 @@ package template
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -18,6 +19,7 @@ type storageTemplate struct {
 	memfile  *utils.SetOnce[block.ReadonlyDevice]
 	rootfs   *utils.SetOnce[block.ReadonlyDevice]
 	snapfile *utils.SetOnce[File]
+	metadata *utils.SetOnce[*storage.SnapshotMetadata]
 
 	memfileHeader *header.Header
 	rootfsHeader  *header.Header
@@ -55,6 +57,7 @@ func newTemplateFromStorage(
 		memfile:       utils.NewSetOnce[block.ReadonlyDevice](),
 		rootfs:        utils.NewSetOnce[block.ReadonlyDevice](),
 		snapfile:      utils.NewSetOnce[File](),
+		metadata:      utils.NewSetOnce[*storage.SnapshotMetadata](),
 	}, nil
 }
 
@@ -83,6 +86,28 @@ func (t *storageTemplate) Fetch(ctx context.Context, buildStore *build.DiffStore
 		return t.snapfile.SetValue(snapfile)
 	}()
 
+	wg.Add(1)
+	go func() error {
+		defer wg.Done()
+
+		// The local snapshot was created on this node by the Firecracker version of the template.
+		if t.localSnapfile != nil {
+			return t.metadata.SetValue(&storage.SnapshotMetadata{FirecrackerVersion: t.files.FirecrackerVersion})
+		}
+
+		metadata, metadataErr := storage.ReadSnapshotMetadata(ctx, t.persistence, t.files.TemplateFiles)
+		if errors.Is(metadataErr, storage.ErrorObjectNotExist) {
+			// The build was uploaded before the snapshot metadata was introduced.
+			return t.metadata.SetValue(&storage.SnapshotMetadata{FirecrackerVersion: t.files.FirecrackerVersion})
+		}
+
+		if metadataErr != nil {
+			return t.metadata.SetError(metadataErr)
+		}
+
+		return t.metadata.SetValue(metadata)
+	}()
+
 	wg.Add(1)
 	go func() error {
 		defer wg.Done()
@@ -148,3 +173,7 @@ func (t *storageTemplate) Rootfs() (block.ReadonlyDevice, error) {
 func (t *storageTemplate) Snapfile() (File, error) {
 	return t.snapfile.Wait()
 }
+
+func (t *storageTemplate) SnapshotMetadata() (*storage.SnapshotMetadata, error) {
+	return t.metadata.Wait()
+}
@@ -14,6 +14,8 @@ type Template interface {
 	Memfile() (block.ReadonlyDevice, error)
 	Rootfs() (block.ReadonlyDevice, error)
 	Snapfile() (File, error)
+	// SnapshotMetadata describes the snapfile, the builds without the stored metadata are described by the Firecracker version of the template.
+	SnapshotMetadata() (*storage.SnapshotMetadata, error)
 	Close() error
 }
 
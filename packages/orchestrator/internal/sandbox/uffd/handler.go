@@ -51,6 +51,14 @@ func (u *Uffd) Disable() error {
 	return u.memfile.Disable()
 }
 
+func (u *Uffd) Track() error {
+	return u.memfile.Track()
+}
+
+func (u *Uffd) Untrack() {
+	u.memfile.Untrack()
+}
+
 func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
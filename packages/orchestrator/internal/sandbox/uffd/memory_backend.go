@@ -4,6 +4,8 @@ import "github.com/bits-and-blooms/bitset"
 
 type MemoryBackend interface {
 	Disable() error
+	Track() error
+	Untrack()
 	Dirty() *bitset.BitSet
 
 	Start(sandboxId string) error
@@ -30,6 +30,12 @@ func (m *NoopMemory) Disable() error {
 	return nil
 }
 
+func (m *NoopMemory) Track() error {
+	return nil
+}
+
+func (m *NoopMemory) Untrack() {}
+
 func (m *NoopMemory) Dirty() *bitset.BitSet {
 	return m.dirty
 }
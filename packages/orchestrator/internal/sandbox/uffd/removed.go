@@ -0,0 +1,63 @@
+package uffd
+
+import (
+	"sync"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// removedPages tracks the guest memory pages released by UFFD_EVENT_REMOVE (madvise(MADV_DONTNEED) done by the balloon device).
+// The next fault on a removed page must be served with a zero page instead of the memfile content.
+type removedPages struct {
+	mu    sync.Mutex
+	pages bitset.BitSet
+}
+
+// remove marks the pages in the memfile range [start, end) as removed.
+func (r *removedPages) remove(start, end, pageSize int64) {
+	if end <= start {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for idx := start / pageSize; idx < (end+pageSize-1)/pageSize; idx++ {
+		r.pages.Set(uint(idx))
+	}
+}
+
+// take reports whether the page at the memfile offset was removed and clears the mark,
+// because the page is populated again once the fault is served.
+func (r *removedPages) take(offset, pageSize int64) bool {
+	idx := uint(offset / pageSize)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.pages.Test(idx) {
+		return false
+	}
+
+	r.pages.Clear(idx)
+
+	return true
+}
+
+// removeMapped marks the pages of the host virtual address range [start, end) as removed in all the mappings it overlaps.
+func (r *removedPages) removeMapped(start, end uintptr, mappings []GuestRegionUffdMapping) {
+	for _, m := range mappings {
+		from := max(start, m.BaseHostVirtAddr)
+		to := min(end, m.BaseHostVirtAddr+m.Size)
+
+		if from >= to {
+			continue
+		}
+
+		r.remove(
+			int64(m.Offset+from-m.BaseHostVirtAddr),
+			int64(m.Offset+to-m.BaseHostVirtAddr),
+			int64(m.PageSize),
+		)
+	}
+}
@@ -0,0 +1,38 @@
+package uffd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemovedPages_TakeClearsThePage(t *testing.T) {
+	var r removedPages
+
+	r.remove(testBlockSize, 3*testBlockSize, testBlockSize)
+
+	assert.False(t, r.take(0, testBlockSize))
+	assert.True(t, r.take(testBlockSize, testBlockSize))
+	assert.True(t, r.take(2*testBlockSize+100, testBlockSize))
+	assert.False(t, r.take(3*testBlockSize, testBlockSize))
+
+	// The page is populated again after the fault is served.
+	assert.False(t, r.take(testBlockSize, testBlockSize))
+}
+
+func TestRemovedPages_RemoveMappedTranslatesAddresses(t *testing.T) {
+	var r removedPages
+
+	mappings := []GuestRegionUffdMapping{
+		{BaseHostVirtAddr: 0x10000, Size: 4 * testBlockSize, Offset: 0, PageSize: testBlockSize},
+		{BaseHostVirtAddr: 0x40000, Size: 4 * testBlockSize, Offset: 4 * testBlockSize, PageSize: testBlockSize},
+	}
+
+	// The range spans the end of the first mapping and the gap between the mappings.
+	r.removeMapped(0x10000+3*testBlockSize, 0x40000+testBlockSize, mappings)
+
+	assert.False(t, r.take(2*testBlockSize, testBlockSize))
+	assert.True(t, r.take(3*testBlockSize, testBlockSize))
+	assert.True(t, r.take(4*testBlockSize, testBlockSize))
+	assert.False(t, r.take(5*testBlockSize, testBlockSize))
+}
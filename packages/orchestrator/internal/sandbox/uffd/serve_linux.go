@@ -20,6 +20,17 @@ import (
 
 var ErrUnexpectedEventType = errors.New("unexpected event type")
 
+// uffdEventRemove is UFFD_EVENT_REMOVE from linux/userfaultfd.h, it is not exported by the userfaultfd package.
+// Firecracker enables the event when the VM has a balloon device and expects the handler to serve
+// the faults in the removed ranges with zero pages.
+const uffdEventRemove = 0x15
+
+// UffdRemove is the uffd_msg.arg.remove struct.
+type UffdRemove struct {
+	Start uint64
+	End   uint64
+}
+
 type GuestRegionUffdMapping struct {
 	BaseHostVirtAddr uintptr `json:"base_host_virt_addr"`
 	Size             uintptr `json:"size"`
@@ -55,6 +66,8 @@ func Serve(
 
 	var eg errgroup.Group
 
+	var removed removedPages
+
 outerLoop:
 	for {
 		if _, err := unix.Poll(
@@ -134,6 +147,15 @@ outerLoop:
 		}
 
 		msg := (*(*constants.UffdMsg)(unsafe.Pointer(&buf[0])))
+		if constants.GetMsgEvent(&msg) == uffdEventRemove {
+			arg := constants.GetMsgArg(&msg)
+			remove := (*(*UffdRemove)(unsafe.Pointer(&arg[0])))
+
+			removed.removeMapped(uintptr(remove.Start), uintptr(remove.End), mappings)
+
+			continue
+		}
+
 		if constants.GetMsgEvent(&msg) != constants.UFFD_EVENT_PAGEFAULT {
 			zap.L().Error("UFFD serve unexpected event type", logger.WithSandboxID(sandboxId), zap.Any("event_type", constants.GetMsgEvent(&msg)))
 
@@ -163,7 +185,13 @@ outerLoop:
 				}
 			}()
 
-			b, err := src.Slice(offset, pagesize)
+			var b []byte
+			var err error
+			if removed.take(offset, pagesize) {
+				b = src.Empty(offset)
+			} else {
+				b, err = src.Slice(offset, pagesize)
+			}
 			if err != nil {
 
 				stop()
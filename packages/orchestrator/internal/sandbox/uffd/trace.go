@@ -0,0 +1,176 @@
+package uffd
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+)
+
+const faultTraceVersion = 1
+
+type faultTraceMetadata struct {
+	Version   uint64
+	BlockSize uint64
+	Count     uint64
+}
+
+// FaultTrace records the order in which the memfile blocks are faulted in during the first moments after a resume.
+type FaultTrace struct {
+	BlockSize int64
+	Offsets   []int64
+
+	mu    sync.Mutex
+	seen  map[int64]struct{}
+	until time.Time
+}
+
+func NewFaultTrace(blockSize int64, duration time.Duration) *FaultTrace {
+	return &FaultTrace{
+		BlockSize: blockSize,
+		seen:      make(map[int64]struct{}),
+		until:     time.Now().Add(duration),
+	}
+}
+
+// Record adds the block containing the offset to the trace, the repeated and late faults are ignored.
+func (t *FaultTrace) Record(off int64) {
+	if time.Now().After(t.until) {
+		return
+	}
+
+	off -= off % t.BlockSize
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.seen[off]; ok {
+		return
+	}
+
+	t.seen[off] = struct{}{}
+	t.Offsets = append(t.Offsets, off)
+}
+
+// Len returns the number of the recorded blocks.
+func (t *FaultTrace) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.Offsets)
+}
+
+// Device returns the device that records the faulted blocks before serving them from the memfile.
+func (t *FaultTrace) Device(memfile block.ReadonlyDevice) block.ReadonlyDevice {
+	return &tracedDevice{
+		ReadonlyDevice: memfile,
+		trace:          t,
+	}
+}
+
+func (t *FaultTrace) Serialize() (io.Reader, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var buf bytes.Buffer
+
+	err := binary.Write(&buf, binary.LittleEndian, &faultTraceMetadata{
+		Version:   faultTraceVersion,
+		BlockSize: uint64(t.BlockSize),
+		Count:     uint64(len(t.Offsets)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write fault trace metadata: %w", err)
+	}
+
+	err = binary.Write(&buf, binary.LittleEndian, t.Offsets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write fault trace offsets: %w", err)
+	}
+
+	return &buf, nil
+}
+
+func DeserializeFaultTrace(in io.WriterTo) (*FaultTrace, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	var metadata faultTraceMetadata
+
+	err = binary.Read(&buf, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fault trace metadata: %w", err)
+	}
+
+	if metadata.Version != faultTraceVersion {
+		return nil, fmt.Errorf("unsupported fault trace version: %d", metadata.Version)
+	}
+
+	if metadata.Count*8 != uint64(buf.Len()) {
+		return nil, fmt.Errorf("fault trace has %d bytes of offsets, expected %d", buf.Len(), metadata.Count*8)
+	}
+
+	offsets := make([]int64, metadata.Count)
+
+	err = binary.Read(&buf, binary.LittleEndian, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fault trace offsets: %w", err)
+	}
+
+	return &FaultTrace{
+		BlockSize: int64(metadata.BlockSize),
+		Offsets:   offsets,
+	}, nil
+}
+
+// Prefetch reads the traced blocks from the memfile in the recorded order, so they are fetched before the guest faults on them.
+func (t *FaultTrace) Prefetch(ctx context.Context, memfile block.ReadonlyDevice, workers int) error {
+	if t.BlockSize != memfile.BlockSize() {
+		return fmt.Errorf("fault trace block size %d does not match memfile block size %d", t.BlockSize, memfile.BlockSize())
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(workers)
+
+	for _, off := range t.Offsets {
+		select {
+		case <-ctx.Done():
+			return eg.Wait()
+		default:
+		}
+
+		eg.Go(func() error {
+			_, err := memfile.Slice(off, t.BlockSize)
+			if err != nil {
+				return fmt.Errorf("failed to prefetch block at %d: %w", off, err)
+			}
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+type tracedDevice struct {
+	block.ReadonlyDevice
+
+	trace *FaultTrace
+}
+
+func (d *tracedDevice) Slice(off, length int64) ([]byte, error) {
+	d.trace.Record(off)
+
+	return d.ReadonlyDevice.Slice(off, length)
+}
@@ -0,0 +1,93 @@
+package uffd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = 4096
+
+type sliceRecorder struct {
+	mu      sync.Mutex
+	offsets []int64
+}
+
+func (d *sliceRecorder) Slice(off, length int64) ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.offsets = append(d.offsets, off)
+
+	return make([]byte, length), nil
+}
+
+func (d *sliceRecorder) ReadAt(p []byte, off int64) (int, error) { return len(p), nil }
+func (d *sliceRecorder) Close() error                            { return nil }
+func (d *sliceRecorder) Size() (int64, error)                    { return 16 * testBlockSize, nil }
+func (d *sliceRecorder) BlockSize() int64                        { return testBlockSize }
+func (d *sliceRecorder) Header() *header.Header                  { return nil }
+
+func TestFaultTrace_RecordsFirstFaultOfEachBlock(t *testing.T) {
+	trace := NewFaultTrace(testBlockSize, time.Minute)
+	device := trace.Device(&sliceRecorder{})
+
+	for _, off := range []int64{3 * testBlockSize, testBlockSize + 100, 3 * testBlockSize, 0} {
+		_, err := device.Slice(off, testBlockSize)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int64{3 * testBlockSize, testBlockSize, 0}, trace.Offsets)
+}
+
+func TestFaultTrace_IgnoresFaultsAfterDuration(t *testing.T) {
+	trace := NewFaultTrace(testBlockSize, 0)
+
+	time.Sleep(time.Millisecond)
+	trace.Record(testBlockSize)
+
+	assert.Equal(t, 0, trace.Len())
+}
+
+func TestFaultTrace_SerializeRoundTrip(t *testing.T) {
+	trace := NewFaultTrace(testBlockSize, time.Minute)
+	trace.Record(5 * testBlockSize)
+	trace.Record(2 * testBlockSize)
+
+	serialized, err := trace.Serialize()
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(serialized)
+	require.NoError(t, err)
+
+	deserialized, err := DeserializeFaultTrace(bytes.NewBuffer(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(testBlockSize), deserialized.BlockSize)
+	assert.Equal(t, trace.Offsets, deserialized.Offsets)
+
+	_, err = DeserializeFaultTrace(bytes.NewBuffer(data[:len(data)-1]))
+	assert.Error(t, err)
+}
+
+func TestFaultTrace_Prefetch(t *testing.T) {
+	trace := &FaultTrace{
+		BlockSize: testBlockSize,
+		Offsets:   []int64{4 * testBlockSize, testBlockSize},
+	}
+
+	memfile := &sliceRecorder{}
+	require.NoError(t, trace.Prefetch(context.Background(), memfile, 1))
+	assert.Equal(t, trace.Offsets, memfile.offsets)
+
+	mismatched := &FaultTrace{BlockSize: 2 * testBlockSize}
+	assert.Error(t, mismatched.Prefetch(context.Background(), memfile, 1))
+}
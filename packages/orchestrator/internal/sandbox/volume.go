@@ -0,0 +1,196 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/ext4"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// volumeSyncInterval is how often the data written to the attached volumes is uploaded to the storage.
+const volumeSyncInterval = time.Minute
+
+// attachVolumes exposes the sandbox's persistent volumes as NBD devices and returns their paths in the order of the config.
+// The volumes are synced back to the storage in the cleanup, after the VM is stopped.
+func attachVolumes(
+	ctx context.Context,
+	tracer trace.Tracer,
+	devicePool *nbd.DevicePool,
+	cleanup *Cleanup,
+	volumeStorage storage.StorageProvider,
+	sandboxFiles *storage.SandboxFiles,
+	volumes []*orchestrator.SandboxVolumeMount,
+) ([]string, error) {
+	if len(volumes) == 0 {
+		return nil, nil
+	}
+
+	childCtx, childSpan := tracer.Start(ctx, "attach-volumes")
+	defer childSpan.End()
+
+	if len(volumes) > fc.MaxVolumes {
+		return nil, fmt.Errorf("too many volumes: %d, at most %d volumes can be attached", len(volumes), fc.MaxVolumes)
+	}
+
+	if volumeStorage == nil {
+		return nil, errors.New("volume storage is not configured")
+	}
+
+	paths := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		path, err := attachVolume(childCtx, tracer, devicePool, cleanup, volumeStorage, sandboxFiles, volume)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach volume %s: %w", volume.GetVolumeId(), err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	telemetry.ReportEvent(childCtx, "attached volumes")
+
+	return paths, nil
+}
+
+func attachVolume(
+	ctx context.Context,
+	tracer trace.Tracer,
+	devicePool *nbd.DevicePool,
+	cleanup *Cleanup,
+	volumeStorage storage.StorageProvider,
+	sandboxFiles *storage.SandboxFiles,
+	volume *orchestrator.SandboxVolumeMount,
+) (string, error) {
+	// The chunks are fetched for the whole life of the sandbox, not only during the creation.
+	device, err := block.NewVolume(
+		context.WithoutCancel(ctx),
+		volumeStorage,
+		volume.GetVolumeId(),
+		volume.GetSizeMb()<<20,
+		header.RootfsBlockSize,
+		sandboxFiles.SandboxCacheVolumePath(volume.GetVolumeId()),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to open volume: %w", err)
+	}
+
+	mnt := nbd.NewDirectPathMount(tracer, device, devicePool)
+
+	syncCtx, stopSync := context.WithCancel(context.WithoutCancel(ctx))
+	syncDone := make(chan struct{})
+
+	go func() {
+		defer close(syncDone)
+
+		syncPeriodically(syncCtx, volume.GetVolumeId(), device)
+	}()
+
+	var path string
+	cleanup.Add(func(ctx context.Context) error {
+		childCtx, span := tracer.Start(ctx, "volume-close")
+		defer span.End()
+
+		stopSync()
+		<-syncDone
+
+		return closeVolume(childCtx, mnt, path, device)
+	})
+
+	deviceIndex, err := mnt.Open(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to open volume device: %w", err)
+	}
+
+	path = nbd.GetDevicePath(deviceIndex)
+
+	if device.IsEmpty() {
+		err = ext4.MakeVolume(ctx, tracer, path, header.RootfsBlockSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to create volume filesystem: %w", err)
+		}
+	}
+
+	return path, nil
+}
+
+// syncPeriodically uploads the chunks written by the sandbox while the volume is attached,
+// so a crash of the orchestrator loses at most the writes of the last interval.
+func syncPeriodically(ctx context.Context, volumeID string, device *block.Volume) {
+	ticker := time.NewTicker(volumeSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := device.Sync(ctx)
+			if err != nil && ctx.Err() == nil {
+				zap.L().Warn("failed to sync volume, retrying in the next interval", zap.String("volume_id", volumeID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// closeVolume releases the volume's NBD device and uploads the data written by the sandbox to the storage.
+// If the upload fails, the local cache with the data is kept.
+func closeVolume(ctx context.Context, mnt *nbd.DirectPathMount, path string, device *block.Volume) error {
+	var errs []error
+
+	if path != "" {
+		err := flushDevice(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to flush volume device: %w", err))
+		}
+	}
+
+	err := mnt.Close(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to close volume device: %w", err))
+	}
+
+	err = device.Sync(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = device.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to close volume: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// flushDevice writes the block device's buffers to the device.
+func flushDevice(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = unix.IoctlSetInt(int(file.Fd()), unix.BLKFLSBUF, 0)
+	if err != nil {
+		return fmt.Errorf("ioctl BLKFLSBUF failed: %w", err)
+	}
+
+	return syscall.Fsync(int(file.Fd()))
+}
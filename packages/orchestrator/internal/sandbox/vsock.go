@@ -0,0 +1,130 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// vsockHandshakeTimeout limits the handshake with Firecracker when the context does not have a deadline.
+	vsockHandshakeTimeout = 5 * time.Second
+	// vsockMaxHandshakeLine is the maximum length of the Firecracker's handshake response ("OK <host port>\n").
+	vsockMaxHandshakeLine = 32
+
+	envdRequestTimeout = 10 * time.Second
+)
+
+// dialVsock opens a connection to the guest port through the unix socket of the Firecracker vsock device.
+// See https://github.com/firecracker-microvm/firecracker/blob/main/docs/vsock.md#host-initiated-connections
+func dialVsock(ctx context.Context, udsPath string, port uint32) (net.Conn, error) {
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "unix", udsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to vsock socket: %w", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(vsockHandshakeTimeout)
+	}
+
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("error setting vsock handshake deadline: %w", err)
+	}
+
+	_, err = fmt.Fprintf(conn, "CONNECT %d\n", port)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("error sending vsock connect request: %w", err)
+	}
+
+	// The response is read byte by byte, so no data sent by the guest after the handshake is consumed.
+	line := make([]byte, 0, vsockMaxHandshakeLine)
+	b := make([]byte, 1)
+	for {
+		_, err = conn.Read(b)
+		if err != nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("error reading vsock connect response: %w", err)
+		}
+
+		if b[0] == '\n' {
+			break
+		}
+
+		if len(line) == vsockMaxHandshakeLine {
+			conn.Close()
+
+			return nil, errors.New("vsock connect response too long")
+		}
+
+		line = append(line, b[0])
+	}
+
+	if !strings.HasPrefix(string(line), "OK ") {
+		conn.Close()
+
+		return nil, fmt.Errorf("vsock connection to port %d refused: %q", port, string(line))
+	}
+
+	err = conn.SetDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("error resetting vsock deadline: %w", err)
+	}
+
+	return conn, nil
+}
+
+// newEnvdTransport returns a transport that reaches the guest through the vsock device, independently of the sandbox network.
+// Sandboxes resumed from snapshots created without the vsock device are reached through the network.
+func newEnvdTransport(udsPath string) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{}
+
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if _, err := os.Stat(udsPath); err != nil {
+			return dialer.DialContext(ctx, network, addr)
+		}
+
+		_, portStr, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+
+		port, err := strconv.ParseUint(portStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", portStr, err)
+		}
+
+		return dialVsock(ctx, udsPath, uint32(port))
+	}
+
+	return transport
+}
+
+// EnvdTransport returns the transport for the requests to the sandbox's envd.
+func (s *Sandbox) EnvdTransport() http.RoundTripper {
+	return s.envdTransport
+}
+
+func (s *Sandbox) envdClient() *http.Client {
+	return &http.Client{
+		Timeout:   envdRequestTimeout,
+		Transport: s.envdTransport,
+	}
+}
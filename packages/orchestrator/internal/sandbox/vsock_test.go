@@ -0,0 +1,130 @@
+package sandbox
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveFakeVsock emulates the host side of the Firecracker vsock device,
+// the connections to the accepted port are forwarded to the handler.
+func serveFakeVsock(t *testing.T, port uint32, handler http.Handler) string {
+	t.Helper()
+
+	udsPath := filepath.Join(t.TempDir(), "vsock.sock")
+
+	listener, err := net.Listen("unix", udsPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	guest := &fakeGuestListener{conns: make(chan net.Conn), done: make(chan struct{}), addr: listener.Addr()}
+	server := &http.Server{Handler: handler}
+	go server.Serve(guest)
+	t.Cleanup(func() { server.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				line, err := bufio.NewReader(io.LimitReader(conn, int64(len("CONNECT 4294967295\n")))).ReadString('\n')
+				if err != nil || strings.TrimSpace(line) != fmt.Sprintf("CONNECT %d", port) {
+					conn.Close()
+
+					return
+				}
+
+				fmt.Fprintf(conn, "OK 1073741824\n")
+
+				select {
+				case guest.conns <- conn:
+				case <-guest.done:
+					conn.Close()
+				}
+			}()
+		}
+	}()
+
+	return udsPath
+}
+
+type fakeGuestListener struct {
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+	addr  net.Addr
+}
+
+func (l *fakeGuestListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *fakeGuestListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+
+	return nil
+}
+
+func (l *fakeGuestListener) Addr() net.Addr {
+	return l.addr
+}
+
+func TestDialVsock_RefusedPort(t *testing.T) {
+	udsPath := serveFakeVsock(t, 49983, http.NotFoundHandler())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := dialVsock(ctx, udsPath, 1234)
+	require.Error(t, err)
+}
+
+func TestEnvdTransport_UsesVsock(t *testing.T) {
+	udsPath := serveFakeVsock(t, 49983, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	client := &http.Client{Transport: newEnvdTransport(udsPath)}
+
+	// The host part of the address is not reachable, the request is routed by the port only.
+	response, err := client.Get("http://192.0.2.1:49983/health")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestEnvdTransport_FallsBackToNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newEnvdTransport(filepath.Join(t.TempDir(), "vsock.sock"))}
+
+	response, err := client.Get(server.URL + "/health")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
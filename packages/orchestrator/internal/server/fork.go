@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) Fork(ctx context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	// A volume can be attached to a single sandbox only.
+	if len(sbx.Config.Volumes) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "sandbox with volumes cannot be forked")
+	}
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		in.TemplateId,
+		in.BuildId,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating template files", err)
+
+		return nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
+	}
+
+	snapshot, err := sbx.LiveSnapshot(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	telemetry.ReportEvent(ctx, "snapshotted running sandbox")
+
+	// The forks are resumed from the cached snapshot, without waiting for the upload.
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
+
+		return nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+	}
+
+	// The snapshots of the forks reference this snapshot, so it is uploaded for them to be resumable on other nodes.
+	go s.uploadSnapshotInBackground(sbx, snapshot, snapshotTemplateFiles)
+
+	return &orchestrator.SandboxForkResponse{
+		Sandbox: sbx.Config,
+	}, nil
+}
@@ -10,7 +10,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/grpcserver"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/migration"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/recovery"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
@@ -35,7 +37,16 @@ type server struct {
 	pauseMu       sync.Mutex
 	devicePool    *nbd.DevicePool
 	persistence   storage.StorageProvider
+	volumeStorage storage.StorageProvider
 	featureFlags  *featureflags.Client
+	journal       *recovery.Journal
+	migrations    *migration.Snapshots
+	warmPool      *warmPool
+	teamNetworks  teamNetworks
+	// migrating are the paused source sandboxes of the migrations waiting for the target node.
+	migrating *smap.Map[*migratingSandbox]
+	// recovered are the sandboxes killed by the recovery after the orchestrator crashed.
+	recovered *smap.Map[recovery.Entry]
 }
 
 type Service struct {
@@ -62,10 +73,12 @@ func New(
 	proxy *proxy.SandboxProxy,
 	sandboxes *smap.Map[*sandbox.Sandbox],
 	featureFlags *featureflags.Client,
+	journal *recovery.Journal,
+	recovered []recovery.Entry,
 ) (*Service, error) {
 	srv := &Service{info: info}
 
-	templateCache, err := template.NewCache(ctx)
+	templateCache, err := template.NewCache(ctx, tel.MeterProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create template cache: %w", err)
 	}
@@ -79,6 +92,19 @@ func New(
 
 	srv.persistence = persistence
 
+	volumeStorage, err := storage.GetVolumeStorageProvider(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume storage provider: %w", err)
+	}
+
+	// The volume data left unsynced by the previous run is uploaded before any sandbox can attach the volumes again.
+	synced, err := recovery.SyncVolumeCaches(ctx, volumeStorage, storage.SandboxCacheDir())
+	if err != nil {
+		zap.L().Error("failed to sync all volume caches", zap.Int("synced", synced), zap.Error(err))
+	} else if synced > 0 {
+		zap.L().Info("synced volume caches", zap.Int("synced", synced))
+	}
+
 	srv.server = &server{
 		info:          info,
 		tracer:        tracer,
@@ -88,7 +114,18 @@ func New(
 		templateCache: templateCache,
 		devicePool:    devicePool,
 		persistence:   persistence,
+		volumeStorage: volumeStorage,
 		featureFlags:  featureFlags,
+		journal:       journal,
+		migrations:    migration.NewSnapshots(),
+		migrating:     smap.New[*migratingSandbox](),
+		recovered:     smap.New[recovery.Entry](),
+	}
+
+	srv.server.warmPool = newWarmPool(srv.server.bootWarmSandbox, srv.server.stopWarmSandbox)
+
+	for _, entry := range recovered {
+		srv.server.recovered.Insert(entry.SandboxID, entry)
 	}
 
 	meter := tel.MeterProvider.Meter("orchestrator.sandbox")
@@ -105,3 +142,8 @@ func New(
 
 	return srv, nil
 }
+
+// Close waits until the snapshots uploaded by the sandbox service are replicated to the other regions.
+func (srv *Service) Close(ctx context.Context) error {
+	return storage.WaitForPendingWrites(ctx, srv.persistence)
+}
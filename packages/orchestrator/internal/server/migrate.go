@@ -0,0 +1,293 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/migration"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// migrationRequestTimeout is the timeout of creating a migrated sandbox, it includes streaming the snapshot from the source node.
+const migrationRequestTimeout = 5 * time.Minute
+
+func (s *server) Migrate(ctx context.Context, in *orchestrator.SandboxMigrateRequest) (*orchestrator.SandboxMigrateResponse, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-migrate")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	s.pauseMu.Lock()
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		s.pauseMu.Unlock()
+
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	if _, ok := s.migrating.Get(in.SandboxId); ok {
+		s.pauseMu.Unlock()
+
+		return nil, status.Error(codes.FailedPrecondition, "sandbox is already being migrated")
+	}
+
+	// The volumes can be attached only after the sandbox is stopped and their data is synced.
+	if len(sbx.Config.Volumes) > 0 {
+		s.pauseMu.Unlock()
+
+		return nil, status.Error(codes.FailedPrecondition, "sandbox with volumes cannot be migrated")
+	}
+
+	s.pauseMu.Unlock()
+
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		in.TemplateId,
+		in.BuildId,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating template files", err)
+
+		return nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
+	}
+
+	// The VM stays paused until the target node confirms the migration, so the sandbox doesn't diverge from the snapshot.
+	snapshot, err := sbx.MigrationSnapshot(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	// The snapshot is cached on this node too, so the sandbox can be resumed here if the target node fails to start it.
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
+
+		unpauseErr := sbx.Unpause(context.Background(), s.tracer)
+		if unpauseErr != nil {
+			sbxlogger.I(sbx).Error("error resuming sandbox after failed migration", logger.WithSandboxID(in.SandboxId), zap.Error(unpauseErr))
+		}
+
+		return nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+	}
+
+	// The target node streams the snapshot from this node while it is uploaded, it is faster than reading it from the storage.
+	err = s.migrations.Add(in.BuildId, snapshot)
+	if err != nil {
+		sbxlogger.I(sbx).Warn("error serving snapshot, the target node reads it from the storage", zap.Error(err))
+	}
+
+	m := &migratingSandbox{
+		sbx:     sbx,
+		buildID: in.BuildId,
+	}
+	// The sandbox is stopped if the migration is not finished, it can be still resumed from the uploaded snapshot.
+	m.timer = time.AfterFunc(migrationRequestTimeout+migrationFinishTimeout, func() {
+		if s.takeMigratingSandbox(in.SandboxId, m) {
+			sbxlogger.I(sbx).Warn("migration was not finished, stopping sandbox", logger.WithSandboxID(in.SandboxId))
+
+			s.stopMigratedSandbox(in.SandboxId, m)
+		}
+	})
+	s.migrating.Insert(in.SandboxId, m)
+
+	go func() {
+		ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-migrate-upload")
+		defer childSpan.End()
+
+		err := s.uploadSnapshot(sbx, snapshot, snapshotTemplateFiles)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error uploading snapshot", err)
+			sbxlogger.I(sbx).Error("error uploading migration snapshot", logger.WithSandboxID(in.SandboxId), zap.Error(err))
+
+			return
+		}
+
+		err = sbx.UploadNetworkStats(ctx, s.persistence, snapshotTemplateFiles.TemplateFiles)
+		if err != nil {
+			sbxlogger.I(sbx).Error("error uploading sandbox network stats", zap.Error(err))
+		}
+
+		telemetry.ReportEvent(ctx, "uploaded snapshot")
+	}()
+
+	return &orchestrator.SandboxMigrateResponse{
+		Sandbox: sbx.Config,
+	}, nil
+}
+
+// migratingSandbox is the paused source sandbox of a migration, it waits for the target node to start the sandbox.
+type migratingSandbox struct {
+	sbx     *sandbox.Sandbox
+	buildID string
+	timer   *time.Timer
+}
+
+// migrationFinishTimeout is the time left to the API to finish the migration after the sandbox is created on the target node.
+const migrationFinishTimeout = time.Minute
+
+func (s *server) MigrateFinish(ctx context.Context, in *orchestrator.SandboxMigrateFinishRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-migrate-finish")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.Bool("migrated", in.Migrated),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	m, ok := s.migrating.Get(in.SandboxId)
+	if !ok || m.buildID != in.BuildId || !s.takeMigratingSandbox(in.SandboxId, m) {
+		return nil, status.Error(codes.NotFound, "migrating sandbox not found")
+	}
+
+	if in.Migrated {
+		s.stopMigratedSandbox(in.SandboxId, m)
+
+		return &emptypb.Empty{}, nil
+	}
+
+	// The target node can't stream the snapshot anymore, the snapshot is only kept for resuming the sandbox later.
+	s.migrations.Remove(m.buildID)
+
+	err := m.sbx.Unpause(ctx, s.tracer)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error resuming sandbox", err)
+
+		s.stopMigratedSandbox(in.SandboxId, m)
+
+		return nil, status.Errorf(codes.Internal, "error resuming sandbox '%s': %s", in.SandboxId, err)
+	}
+
+	telemetry.ReportEvent(ctx, "resumed sandbox")
+
+	return &emptypb.Empty{}, nil
+}
+
+// takeMigratingSandbox removes the migrating sandbox, it returns false if the migration was already finished.
+func (s *server) takeMigratingSandbox(sandboxID string, m *migratingSandbox) bool {
+	taken := s.migrating.RemoveCb(sandboxID, func(_ string, v *migratingSandbox, exists bool) bool {
+		return exists && v == m
+	})
+	if taken {
+		m.timer.Stop()
+	}
+
+	return taken
+}
+
+// stopMigratedSandbox removes the paused source sandbox from the node and stops it.
+func (s *server) stopMigratedSandbox(sandboxID string, m *migratingSandbox) {
+	s.migrations.Remove(m.buildID)
+
+	s.pauseMu.Lock()
+	s.sandboxes.RemoveCb(sandboxID, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		return exists && v == m.sbx
+	})
+	s.pauseMu.Unlock()
+
+	// sbx.Stop sometimes blocks for several seconds, so the sandbox is stopped after it was removed from the cache and proxy.
+	go func() {
+		ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-migrate-stop")
+		defer childSpan.End()
+
+		err := m.sbx.Stop(ctx)
+		if err != nil {
+			sbxlogger.I(m.sbx).Error("error stopping sandbox after migration", logger.WithSandboxID(sandboxID), zap.Error(err))
+		}
+	}()
+}
+
+func (s *server) ReadSnapshotFile(ctx context.Context, in *orchestrator.SnapshotFileRequest) (*orchestrator.SnapshotFileResponse, error) {
+	data, size, err := s.migrations.Read(in.BuildId, in.Name, in.Offset, in.Length)
+	if errors.Is(err, migration.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		telemetry.ReportError(ctx, "error reading snapshot file", err)
+
+		return nil, status.Errorf(codes.Internal, "error reading snapshot file: %s", err)
+	}
+
+	return &orchestrator.SnapshotFileResponse{
+		Data: data,
+		Size: size,
+	}, nil
+}
+
+// fetchMigratedSnapshot streams the snapshot of the migrated sandbox from the source node to the template cache.
+// If the streaming fails, the snapshot is read from the storage after the source node uploads it.
+func (s *server) fetchMigratedSnapshot(ctx context.Context, config *orchestrator.SandboxConfig, source string) error {
+	ctx, childSpan := s.tracer.Start(ctx, "fetch-migrated-snapshot")
+	defer childSpan.End()
+
+	files, err := storage.NewTemplateFiles(
+		config.TemplateId,
+		config.BuildId,
+		config.KernelVersion,
+		config.FirecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		return fmt.Errorf("failed to create template files: %w", err)
+	}
+
+	snapshot, err := migration.Fetch(ctx, source, files)
+	if err == nil {
+		telemetry.ReportEvent(ctx, "streamed snapshot from the source node")
+
+		return s.templateCache.AddSnapshot(
+			files.TemplateId,
+			files.BuildId,
+			files.KernelVersion,
+			files.FirecrackerVersion,
+			snapshot.MemfileDiffHeader,
+			snapshot.RootfsDiffHeader,
+			snapshot.Snapfile,
+			snapshot.MemfileDiff,
+			snapshot.RootfsDiff,
+		)
+	}
+
+	zap.L().Warn("failed to stream snapshot from the source node, waiting for the storage upload",
+		logger.WithSandboxID(config.SandboxId),
+		logger.WithBuildID(config.BuildId),
+		zap.String("source", source),
+		zap.Error(err),
+	)
+
+	return migration.WaitForUpload(ctx, s.persistence, config.BuildId)
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// teamNetworks keeps the membership of the team networks pushed by the API and applies it to the sandboxes on the node.
+type teamNetworks struct {
+	mu       sync.Mutex
+	networks []*orchestrator.TeamNetwork
+	// overlay is created when the first sandbox on another node has to be reached.
+	overlay *network.Overlay
+}
+
+func (s *server) ConfigureNetworks(ctx context.Context, in *orchestrator.NetworksConfigureRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "networks-configure")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(attribute.Int("networks.count", len(in.GetNetworks())))
+
+	s.teamNetworks.mu.Lock()
+	s.teamNetworks.networks = in.GetNetworks()
+	s.teamNetworks.mu.Unlock()
+
+	err := s.teamNetworks.apply(s.sandboxes.Items())
+	if err != nil {
+		// The membership is applied again on the next configuration, the sandboxes are not affected by the failed ones.
+		telemetry.ReportError(ctx, "error applying team networks", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// applyTeamNetworks sets the peers of the sandboxes attached to the team networks, it is called when a sandbox with a team network is created.
+func (s *server) applyTeamNetworks() {
+	err := s.teamNetworks.apply(s.sandboxes.Items())
+	if err != nil {
+		zap.L().Error("error applying team networks", zap.Error(err))
+	}
+}
+
+func (t *teamNetworks) apply(sandboxes map[string]*sandbox.Sandbox) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	local := make(map[netip.Addr]string)
+	slots := make(map[netip.Addr]*network.Slot)
+
+	for _, sbx := range sandboxes {
+		config := sbx.Config.GetNetwork()
+		if config == nil || sbx.Slot == nil {
+			continue
+		}
+
+		address, err := netip.ParseAddr(config.GetAddress())
+		if err != nil {
+			continue
+		}
+
+		local[address] = config.GetNetworkId()
+		slots[address] = sbx.Slot
+	}
+
+	peers, routes := networkRoutes(t.networks, local)
+
+	var errs []error
+
+	for address, slot := range slots {
+		err := slot.SetTeamPeers(address, peers[address])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error setting team peers of %s: %w", address, err))
+		}
+	}
+
+	if t.overlay == nil && len(routes) > 0 {
+		overlay, err := network.NewOverlay()
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("error creating team networks overlay: %w", err))...)
+		}
+
+		t.overlay = overlay
+	}
+
+	if t.overlay != nil {
+		err := t.overlay.SetRoutes(routes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error setting team networks routes: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// networkRoutes returns the peers of the local sandboxes, keyed by their team network addresses,
+// and the routes of the addresses of the peers on the other nodes to the addresses of the nodes.
+// Only the networks with a local sandbox are considered, local maps the addresses of the local sandboxes to their networks.
+func networkRoutes(networks []*orchestrator.TeamNetwork, local map[netip.Addr]string) (map[netip.Addr][]netip.Addr, map[netip.Addr]netip.Addr) {
+	peers := make(map[netip.Addr][]netip.Addr)
+	routes := make(map[netip.Addr]netip.Addr)
+
+	for _, teamNetwork := range networks {
+		var members []netip.Addr
+		var attached []netip.Addr
+		remote := make(map[netip.Addr]netip.Addr)
+
+		for _, member := range teamNetwork.GetMembers() {
+			address, err := netip.ParseAddr(member.GetAddress())
+			if err != nil {
+				zap.L().Warn("invalid team network member address", zap.String("network_id", teamNetwork.GetNetworkId()), zap.String("address", member.GetAddress()))
+
+				continue
+			}
+
+			networkID, isLocal := local[address]
+			if !isLocal {
+				// The overlay runs over IPv4, the address of the node's overlay device is derived from its IPv4 address.
+				node, err := netip.ParseAddr(member.GetNodeAddress())
+				if err != nil || !node.Unmap().Is4() {
+					zap.L().Warn("invalid team network member node address", zap.String("network_id", teamNetwork.GetNetworkId()), zap.String("node_address", member.GetNodeAddress()))
+
+					continue
+				}
+
+				remote[address] = node.Unmap()
+			}
+
+			members = append(members, address)
+
+			if isLocal && networkID == teamNetwork.GetNetworkId() {
+				attached = append(attached, address)
+			}
+		}
+
+		if len(attached) == 0 {
+			continue
+		}
+
+		for _, address := range attached {
+			for _, member := range members {
+				if member != address {
+					peers[address] = append(peers[address], member)
+				}
+			}
+		}
+
+		for address, node := range remote {
+			routes[address] = node
+		}
+	}
+
+	return peers, routes
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestNetworkRoutes(t *testing.T) {
+	networks := []*orchestrator.TeamNetwork{
+		{
+			NetworkId: "team-a/default",
+			Members: []*orchestrator.TeamNetworkMember{
+				{Address: "10.100.0.1", NodeAddress: "192.168.0.1"},
+				{Address: "10.100.0.2", NodeAddress: "192.168.0.1"},
+				{Address: "10.100.0.3", NodeAddress: "192.168.0.2"},
+				{Address: "invalid", NodeAddress: "192.168.0.2"},
+				// The overlay runs over IPv4 only.
+				{Address: "10.100.0.6", NodeAddress: "fd00::1"},
+				{Address: "10.100.0.7", NodeAddress: "::ffff:192.168.0.4"},
+			},
+		},
+		{
+			// No sandbox of the network runs on the node.
+			NetworkId: "team-b/default",
+			Members: []*orchestrator.TeamNetworkMember{
+				{Address: "10.100.0.4", NodeAddress: "192.168.0.2"},
+			},
+		},
+		{
+			// The local address belongs to another network.
+			NetworkId: "team-c/default",
+			Members: []*orchestrator.TeamNetworkMember{
+				{Address: "10.100.0.1", NodeAddress: "192.168.0.1"},
+				{Address: "10.100.0.5", NodeAddress: "192.168.0.3"},
+			},
+		},
+	}
+
+	local := map[netip.Addr]string{
+		netip.MustParseAddr("10.100.0.1"): "team-a/default",
+		netip.MustParseAddr("10.100.0.2"): "team-a/default",
+	}
+
+	peers, routes := networkRoutes(networks, local)
+
+	assert.Equal(t, map[netip.Addr][]netip.Addr{
+		netip.MustParseAddr("10.100.0.1"): {netip.MustParseAddr("10.100.0.2"), netip.MustParseAddr("10.100.0.3"), netip.MustParseAddr("10.100.0.7")},
+		netip.MustParseAddr("10.100.0.2"): {netip.MustParseAddr("10.100.0.1"), netip.MustParseAddr("10.100.0.3"), netip.MustParseAddr("10.100.0.7")},
+	}, peers)
+
+	assert.Equal(t, map[netip.Addr]netip.Addr{
+		netip.MustParseAddr("10.100.0.3"): netip.MustParseAddr("192.168.0.2"),
+		netip.MustParseAddr("10.100.0.7"): netip.MustParseAddr("192.168.0.4"),
+	}, routes)
+}
@@ -20,9 +20,12 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/recovery"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/compact"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
@@ -41,7 +44,12 @@ const (
 )
 
 func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreateRequest) (*orchestrator.SandboxCreateResponse, error) {
-	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	timeout := requestTimeout
+	if req.GetMigrationSource() != "" {
+		timeout = migrationRequestTimeout
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctxConn, timeout, fmt.Errorf("request timed out"))
 	defer cancel()
 
 	childCtx, childSpan := s.tracer.Start(ctx, "sandbox-create")
@@ -66,110 +74,194 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		zap.L().Error("soft failing during metrics write feature flag receive", zap.Error(flagErr))
 	}
 
-	var sbx *sandbox.Sandbox
+	if req.Sandbox.Snapshot && req.GetMigrationSource() != "" {
+		err := s.fetchMigratedSnapshot(childCtx, req.Sandbox, req.GetMigrationSource())
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to fetch migrated snapshot", err)
+
+			return nil, status.Errorf(codes.Internal, "failed to fetch migrated snapshot: %s", err)
+		}
+	}
+
+	traceID := childSpan.SpanContext().TraceID().String()
+
+	// The cleanup of the warm sandbox is already awaited since the sandbox was booted.
 	var cleanup *sandbox.Cleanup
-	var err error
 
+	sbx := s.assignWarmSandbox(childCtx, req, traceID)
+	warm := sbx != nil
+	if !warm {
+		var err error
+
+		sbx, cleanup, err = s.bootSandbox(childCtx, req.Sandbox, req.StartTime.AsTime(), req.EndTime.AsTime(), traceID, metricsWriteFlag)
+		if err != nil {
+			zap.L().Error("failed to create sandbox, cleaning up", zap.Error(err))
+			cleanupErr := cleanup.Run(ctx)
+			s.removeFromJournal(req.Sandbox)
+
+			if errors.Is(err, fc.ErrIncompatibleSnapshot) {
+				telemetry.ReportError(ctx, "incompatible sandbox snapshot", errors.Join(err, cleanupErr))
+
+				return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+			}
+
+			err := errors.Join(err, context.Cause(ctx), cleanupErr)
+			telemetry.ReportCriticalError(ctx, "failed to cleanup sandbox", err)
+
+			return nil, status.Errorf(codes.Internal, "failed to cleanup sandbox: %s", err)
+		}
+	}
+
+	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
+
+	if req.Sandbox.GetNetwork() != nil {
+		s.applyTeamNetworks()
+	}
+
+	if cleanup != nil {
+		go s.waitForSandbox(sbx, cleanup)
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId: s.info.ClientId,
+		Warm:     warm,
+	}, nil
+}
+
+// bootSandbox starts the sandbox from the snapshot, or boots it from the template when the config is not a snapshot.
+// IMPORTANT: The cleanup has to be run even if there is an error.
+func (s *server) bootSandbox(
+	ctx context.Context,
+	sandboxConfig *orchestrator.SandboxConfig,
+	startTime time.Time,
+	endTime time.Time,
+	traceID string,
+	metricsWriteFlag bool,
+) (*sandbox.Sandbox, *sandbox.Cleanup, error) {
 	// OCI POC: Use snapshot-based boot if Snapshot flag is true, otherwise fresh boot
-	if req.Sandbox.Snapshot {
-		sbx, cleanup, err = sandbox.ResumeSandbox(
-			childCtx,
+	if sandboxConfig.Snapshot {
+		return sandbox.ResumeSandbox(
+			ctx,
 			s.tracer,
 			s.networkPool,
 			s.templateCache,
-			req.Sandbox,
-			childSpan.SpanContext().TraceID().String(),
-			req.StartTime.AsTime(),
-			req.EndTime.AsTime(),
-			req.Sandbox.BaseTemplateId,
+			s.persistence,
+			sandboxConfig,
+			traceID,
+			startTime,
+			endTime,
+			sandboxConfig.BaseTemplateId,
 			s.devicePool,
+			s.volumeStorage,
 			config.AllowSandboxInternet,
 			metricsWriteFlag,
+			s.recorder(sandboxConfig),
 		)
-	} else {
-		// Fresh boot without snapshot (for POC without snapshot files)
-		t, getTemplateErr := s.templateCache.GetTemplate(
-			req.Sandbox.TemplateId,
-			req.Sandbox.BuildId,
-			req.Sandbox.KernelVersion,
-			req.Sandbox.FirecrackerVersion,
-		)
-		if getTemplateErr != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get template: %s", getTemplateErr)
-		}
+	}
 
-		// Use empty/default values for fresh boot
-		debugVMLogs := env.GetEnv("SANDBOX_DEBUG_VM_LOGS", "false") == "true"
-		processOptions := fc.ProcessOptions{
-			InitScriptPath:      "/sbin/init", // Fixed: was pointing to .conf file
-			KernelLogs:          debugVMLogs,
-			SystemdToKernelLogs: debugVMLogs,
-			Stdout:              os.Stdout,
-			Stderr:              os.Stderr,
-		}
+	// Fresh boot without snapshot (for POC without snapshot files)
+	t, err := s.templateCache.GetTemplate(
+		sandboxConfig.TemplateId,
+		sandboxConfig.BuildId,
+		sandboxConfig.KernelVersion,
+		sandboxConfig.FirecrackerVersion,
+	)
+	if err != nil {
+		return nil, sandbox.NewCleanup(), fmt.Errorf("failed to get template: %w", err)
+	}
 
-		sbx, cleanup, err = sandbox.CreateSandbox(
-			childCtx,
-			s.tracer,
-			s.networkPool,
-			s.devicePool,
-			req.Sandbox,
-			t,
-			req.EndTime.AsTime().Sub(req.StartTime.AsTime()),
-			"", // Empty = use NBDProvider (production path)
-			processOptions,
-			config.AllowSandboxInternet,
-		)
+	// Use empty/default values for fresh boot
+	debugVMLogs := env.GetEnv("SANDBOX_DEBUG_VM_LOGS", "false") == "true"
+	processOptions := fc.ProcessOptions{
+		InitScriptPath:      "/sbin/init", // Fixed: was pointing to .conf file
+		KernelLogs:          debugVMLogs,
+		SystemdToKernelLogs: debugVMLogs,
+		Stdout:              os.Stdout,
+		Stderr:              os.Stderr,
 	}
-	if err != nil {
-		zap.L().Error("failed to create sandbox, cleaning up", zap.Error(err))
-		cleanupErr := cleanup.Run(ctx)
 
-		err := errors.Join(err, context.Cause(ctx), cleanupErr)
-		telemetry.ReportCriticalError(ctx, "failed to cleanup sandbox", err)
+	return sandbox.CreateSandbox(
+		ctx,
+		s.tracer,
+		s.networkPool,
+		s.devicePool,
+		s.volumeStorage,
+		sandboxConfig,
+		t,
+		endTime.Sub(startTime),
+		"", // Empty = use NBDProvider (production path)
+		processOptions,
+		config.AllowSandboxInternet,
+		s.recorder(sandboxConfig),
+	)
+}
 
-		return nil, status.Errorf(codes.Internal, "failed to cleanup sandbox: %s", err)
+// recorder returns the recorder of the sandbox resources, so they can be cleaned up if the orchestrator crashes.
+func (s *server) recorder(sandboxConfig *orchestrator.SandboxConfig) sandbox.Recorder {
+	return func(slot *network.Slot, hostFiles []string) error {
+		return s.journal.Record(recovery.Entry{
+			SandboxID:   sandboxConfig.SandboxId,
+			TemplateID:  sandboxConfig.TemplateId,
+			TeamID:      sandboxConfig.TeamId,
+			ExecutionID: sandboxConfig.ExecutionId,
+			SlotKey:     slot.Key,
+			SlotIdx:     slot.Idx,
+			Files:       hostFiles,
+		})
 	}
+}
 
-	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
-	go func() {
-		ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-create-stop")
-		defer childSpan.End()
+// removeFromJournal removes the sandbox from the journal after its resources were cleaned up.
+func (s *server) removeFromJournal(sandboxConfig *orchestrator.SandboxConfig) {
+	err := s.journal.Remove(sandboxConfig.ExecutionId)
+	if err != nil {
+		zap.L().Error("failed to remove sandbox from the journal", logger.WithSandboxID(sandboxConfig.SandboxId), zap.Error(err))
+	}
+}
 
-		waitErr := sbx.Wait(ctx)
-		if waitErr != nil {
-			sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
-		}
+// waitForSandbox cleans up the sandbox after it exits.
+// The identity of the sandbox is read after the exit, the warm sandboxes get it when they are handed out.
+func (s *server) waitForSandbox(sbx *sandbox.Sandbox, cleanup *sandbox.Cleanup) {
+	ctx, childSpan := s.tracer.Start(context.Background(), "sandbox-create-stop")
+	defer childSpan.End()
 
-		cleanupErr := cleanup.Run(ctx)
-		if cleanupErr != nil {
-			sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
-		}
+	waitErr := sbx.Wait(ctx)
+	if waitErr != nil {
+		sbxlogger.I(sbx).Error("failed to wait for sandbox, cleaning up", zap.Error(waitErr))
+	}
 
-		// Remove the sandbox from cache only if the cleanup IDs match.
-		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
-		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
-		s.sandboxes.RemoveCb(req.Sandbox.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
-			if !exists {
-				return false
-			}
+	cleanupErr := cleanup.Run(ctx)
+	if cleanupErr != nil {
+		sbxlogger.I(sbx).Error("failed to cleanup sandbox, will remove from cache", zap.Error(cleanupErr))
+	}
 
-			if v == nil {
-				return false
-			}
+	// The sandbox exited before it was handed out.
+	s.warmPool.Remove(sbx)
 
-			return sbx.Config.ExecutionId == v.Config.ExecutionId
-		})
+	journalErr := s.journal.Remove(sbx.Config.ExecutionId)
+	if journalErr != nil {
+		sbxlogger.I(sbx).Error("failed to remove sandbox from the journal", zap.Error(journalErr))
+	}
 
-		// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
-		s.proxy.RemoveFromPool(sbx.Config.ExecutionId)
+	// Remove the sandbox from cache only if the cleanup IDs match.
+	// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
+	// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
+	s.sandboxes.RemoveCb(sbx.Config.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		if !exists {
+			return false
+		}
 
-		sbxlogger.E(sbx).Info("Sandbox killed")
-	}()
+		if v == nil {
+			return false
+		}
 
-	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.info.ClientId,
-	}, nil
+		return sbx.Config.ExecutionId == v.Config.ExecutionId
+	})
+
+	// Remove the proxies assigned to the sandbox from the pool to prevent them from being reused.
+	s.proxy.RemoveFromPool(sbx.Config.ExecutionId)
+
+	sbxlogger.E(sbx).Info("Sandbox killed")
 }
 
 func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
@@ -188,7 +280,29 @@ func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	item.EndAt = req.EndTime.AsTime()
+	if req.EndTime != nil {
+		item.EndAt = req.EndTime.AsTime()
+	}
+
+	if req.DiskRateLimiter != nil || req.NetworkRateLimiter != nil {
+		err := item.UpdateRateLimiters(ctx, req.DiskRateLimiter, req.NetworkRateLimiter)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to update rate limiters", err)
+
+			return nil, status.Errorf(codes.Internal, "failed to update rate limiters: %s", err)
+		}
+	}
+
+	if req.Resources != nil {
+		err := item.Resize(ctx, req.Resources)
+		if errors.Is(err, sandbox.ErrInvalidResources) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to resize sandbox", err)
+
+			return nil, status.Errorf(codes.Internal, "failed to resize sandbox: %s", err)
+		}
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -237,6 +351,13 @@ func (s *server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteR
 
 	sbx, ok := s.sandboxes.Get(in.SandboxId)
 	if !ok {
+		// The sandbox was already killed by the recovery after the orchestrator crashed.
+		if _, recovered := s.recovered.Get(in.SandboxId); recovered {
+			s.recovered.Remove(in.SandboxId)
+
+			return &emptypb.Empty{}, nil
+		}
+
 		telemetry.ReportCriticalError(ctx, "sandbox not found", nil, telemetry.WithSandboxID(in.SandboxId))
 
 		return nil, status.Errorf(codes.NotFound, "sandbox '%s' not found", in.SandboxId)
@@ -275,6 +396,13 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
+	// The volumes are not part of the snapshot, they can't be attached to a different sandbox while this one is paused.
+	if len(sbx.Config.Volumes) > 0 {
+		s.pauseMu.Unlock()
+
+		return nil, status.Error(codes.FailedPrecondition, "sandbox with volumes cannot be paused")
+	}
+
 	s.sandboxes.Remove(in.SandboxId)
 
 	s.pauseMu.Unlock()
@@ -331,60 +459,87 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
 
-	go func() {
-		var memfilePath *string
+	// The counters are uploaded before the pause returns, so they are there when the sandbox is resumed.
+	err = sbx.UploadNetworkStats(ctx, s.persistence, snapshotTemplateFiles.TemplateFiles)
+	if err != nil {
+		sbxlogger.I(sbx).Error("error uploading sandbox network stats", zap.Error(err))
+	}
 
-		switch r := snapshot.MemfileDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			memfileLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting memfile diff path", zap.Error(err))
+	go s.uploadSnapshotInBackground(sbx, snapshot, snapshotTemplateFiles)
 
-				return
-			}
+	return &emptypb.Empty{}, nil
+}
 
-			memfilePath = &memfileLocalPath
-		}
+// uploadSnapshotInBackground uploads the snapshot like uploadSnapshot, the upload errors are only logged.
+func (s *server) uploadSnapshotInBackground(sbx *sandbox.Sandbox, snapshot *sandbox.Snapshot, snapshotTemplateFiles *storage.TemplateCacheFiles) {
+	err := s.uploadSnapshot(sbx, snapshot, snapshotTemplateFiles)
+	if err != nil {
+		sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+	}
+}
 
-		var rootfsPath *string
+// uploadSnapshot uploads the snapshot files to the storage and queues the build for compaction if needed.
+func (s *server) uploadSnapshot(sbx *sandbox.Sandbox, snapshot *sandbox.Snapshot, snapshotTemplateFiles *storage.TemplateCacheFiles) error {
+	var memfilePath *string
 
-		switch r := snapshot.RootfsDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			rootfsLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting rootfs diff path", zap.Error(err))
+	switch r := snapshot.MemfileDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		memfileLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting memfile diff path: %w", err)
+		}
 
-				return
-			}
+		memfilePath = &memfileLocalPath
+	}
 
-			rootfsPath = &rootfsLocalPath
+	var rootfsPath *string
+
+	switch r := snapshot.RootfsDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		rootfsLocalPath, err := r.CachePath()
+		if err != nil {
+			return fmt.Errorf("error getting rootfs diff path: %w", err)
 		}
 
-		b := storage.NewTemplateBuild(
-			snapshot.MemfileDiffHeader,
-			snapshot.RootfsDiffHeader,
-			s.persistence,
-			snapshotTemplateFiles.TemplateFiles,
-		)
+		rootfsPath = &rootfsLocalPath
+	}
 
-		err = <-b.Upload(
-			context.Background(),
-			snapshot.Snapfile.Path(),
-			memfilePath,
-			rootfsPath,
-		)
-		if err != nil {
-			sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+	b := storage.NewTemplateBuild(
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		s.persistence,
+		snapshotTemplateFiles.TemplateFiles,
+	)
 
-			return
+	// The snapshot without the version can be still resumed by the same Firecracker version.
+	metadata, err := fc.NewSnapshotMetadata(context.Background(), snapshotTemplateFiles.FirecrackerVersion)
+	if err != nil {
+		sbxlogger.I(sbx).Warn("error getting snapshot version", zap.Error(err))
+	}
+
+	err = <-b.Upload(
+		context.Background(),
+		snapshot.Snapfile.Path(),
+		metadata,
+		memfilePath,
+		rootfsPath,
+	)
+	if err != nil {
+		return fmt.Errorf("error uploading snapshot: %w", err)
+	}
+
+	if compact.ShouldCompact(snapshot.MemfileDiffHeader) || compact.ShouldCompact(snapshot.RootfsDiffHeader) {
+		err = compact.Enqueue(context.Background(), s.persistence, snapshotTemplateFiles.BuildId)
+		if err != nil {
+			sbxlogger.I(sbx).Error("error queueing sandbox snapshot for compaction", zap.Error(err))
 		}
-	}()
+	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *server) Exec(ctx context.Context, req *orchestrator.SandboxExecRequest) (*orchestrator.SandboxExecResponse, error) {
@@ -433,7 +588,8 @@ func (s *server) Exec(ctx context.Context, req *orchestrator.SandboxExecRequest)
 
 	baseURL := fmt.Sprintf("http://%s:%d", slot.HostIPString(), consts.DefaultEnvdServerPort)
 
-	httpClient := &http.Client{}
+	// The process stream is not limited by the client timeout, the context deadline is used instead.
+	httpClient := &http.Client{Transport: sbx.EnvdTransport()}
 
 	// Apply default timeout if not specified (60 seconds should be enough for most commands)
 	// This prevents infinite hangs if the stream doesn't close or End event is never sent
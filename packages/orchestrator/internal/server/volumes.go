@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) DeleteVolume(ctxConn context.Context, in *orchestrator.VolumeDeleteRequest) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "volume-delete")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("volume.id", in.VolumeId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	for _, sbx := range s.sandboxes.Items() {
+		for _, volume := range sbx.Config.Volumes {
+			if volume.GetVolumeId() == in.VolumeId {
+				return nil, status.Errorf(codes.FailedPrecondition, "volume '%s' is attached to sandbox '%s'", in.VolumeId, sbx.Config.SandboxId)
+			}
+		}
+	}
+
+	err := s.volumeStorage.DeleteObjectsWithPrefix(ctx, storage.VolumePrefix(in.VolumeId))
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error deleting volume", err)
+
+		return nil, status.Errorf(codes.Internal, "error deleting volume '%s': %s", in.VolumeId, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
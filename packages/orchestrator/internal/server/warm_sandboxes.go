@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (s *server) ConfigureWarmPool(ctx context.Context, in *orchestrator.WarmPoolConfigureRequest) (*orchestrator.WarmPoolConfigureResponse, error) {
+	_, childSpan := s.tracer.Start(ctx, "warm-pool-configure")
+	defer childSpan.End()
+
+	s.warmPool.Configure(in.GetTemplates())
+
+	return &orchestrator.WarmPoolConfigureResponse{
+		Builds: s.warmPool.Available(),
+	}, nil
+}
+
+// bootWarmSandbox boots the sandbox for the warm pool, the sandbox is not listed on the node until it is handed out.
+func (s *server) bootWarmSandbox(ctx context.Context, sandboxConfig *orchestrator.SandboxConfig) (*sandbox.Sandbox, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, requestTimeout, fmt.Errorf("warm sandbox boot timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "warm-sandbox-boot")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithTemplateID(sandboxConfig.TemplateId),
+		telemetry.WithBuildID(sandboxConfig.BuildId),
+		telemetry.WithSandboxID(sandboxConfig.SandboxId),
+	)
+
+	now := time.Now()
+
+	sbx, cleanup, err := s.bootSandbox(ctx, sandboxConfig, now, now, childSpan.SpanContext().TraceID().String(), featureflags.MetricsWriteDefault)
+	if err != nil {
+		cleanupErr := cleanup.Run(ctx)
+		s.removeFromJournal(sandboxConfig)
+
+		return nil, errors.Join(err, context.Cause(ctx), cleanupErr)
+	}
+
+	go s.waitForSandbox(sbx, cleanup)
+
+	return sbx, nil
+}
+
+func (s *server) stopWarmSandbox(sbx *sandbox.Sandbox) {
+	ctx, childSpan := s.tracer.Start(context.Background(), "warm-sandbox-stop")
+	defer childSpan.End()
+
+	err := sbx.Stop(ctx)
+	if err != nil {
+		sbxlogger.I(sbx).Error("error stopping warm sandbox", zap.Error(err))
+	}
+}
+
+// assignWarmSandbox hands out a warm sandbox for the request, it returns nil if there is no warm sandbox for the build.
+func (s *server) assignWarmSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest, traceID string) *sandbox.Sandbox {
+	sbx := s.warmPool.Take(req.Sandbox)
+	if sbx == nil {
+		return nil
+	}
+
+	warmExecutionID := sbx.Config.ExecutionId
+
+	err := sbx.Assign(ctx, s.tracer, req.Sandbox, traceID, req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to assign warm sandbox, booting a new one", zap.Error(err))
+
+		go s.stopWarmSandbox(sbx)
+
+		return nil
+	}
+
+	// The sandbox is recorded again with the identity of the owner, the warm entry is kept until the owner entry is written.
+	err = s.recorder(sbx.Config)(sbx.Slot, sbx.HostFiles())
+	if err != nil {
+		sbxlogger.I(sbx).Warn("failed to record assigned warm sandbox, booting a new one", zap.Error(err))
+
+		// The sandbox already has the identity of the owner, so the warm entry is removed after the sandbox is stopped.
+		go func() {
+			s.stopWarmSandbox(sbx)
+
+			journalErr := s.journal.Remove(warmExecutionID)
+			if journalErr != nil {
+				sbxlogger.I(sbx).Error("failed to remove warm sandbox from the journal", zap.Error(journalErr))
+			}
+		}()
+
+		return nil
+	}
+
+	journalErr := s.journal.Remove(warmExecutionID)
+	if journalErr != nil {
+		sbxlogger.I(sbx).Error("failed to remove warm sandbox from the journal", zap.Error(journalErr))
+	}
+
+	telemetry.ReportEvent(ctx, "assigned warm sandbox")
+
+	return sbx
+}
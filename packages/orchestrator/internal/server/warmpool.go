@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// warmSandboxPrefix marks the IDs of the sandboxes booted for the warm pool, the sandbox gets the ID of its owner when it is handed out.
+const warmSandboxPrefix = "warm-"
+
+type warmBuild struct {
+	// config is the template of the warm sandboxes, it doesn't have any identity set.
+	config  *orchestrator.SandboxConfig
+	size    int
+	booting bool
+	ready   []*sandbox.Sandbox
+}
+
+// warmPool keeps booted sandboxes that are not assigned to anyone yet, so they can be handed out on create without waiting for the boot.
+type warmPool struct {
+	mu     sync.Mutex
+	builds map[string]*warmBuild
+
+	boot func(ctx context.Context, config *orchestrator.SandboxConfig) (*sandbox.Sandbox, error)
+	stop func(sbx *sandbox.Sandbox)
+}
+
+func newWarmPool(
+	boot func(ctx context.Context, config *orchestrator.SandboxConfig) (*sandbox.Sandbox, error),
+	stop func(sbx *sandbox.Sandbox),
+) *warmPool {
+	return &warmPool{
+		builds: make(map[string]*warmBuild),
+		boot:   boot,
+		stop:   stop,
+	}
+}
+
+// Configure replaces the templates kept in the pool.
+// The warm sandboxes of the builds no longer in the pool, or above the new size, are stopped.
+func (p *warmPool) Configure(templates []*orchestrator.WarmPoolTemplate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	configured := make(map[string]struct{}, len(templates))
+	for _, t := range templates {
+		buildID := t.GetSandbox().GetBuildId()
+		configured[buildID] = struct{}{}
+
+		b, ok := p.builds[buildID]
+		if !ok {
+			b = &warmBuild{}
+			p.builds[buildID] = b
+		}
+
+		b.config = t.GetSandbox()
+		b.size = int(t.GetSize())
+	}
+
+	for buildID, b := range p.builds {
+		if _, ok := configured[buildID]; !ok {
+			b.size = 0
+		}
+
+		for len(b.ready) > b.size {
+			sbx := b.ready[len(b.ready)-1]
+			b.ready = b.ready[:len(b.ready)-1]
+
+			go p.stop(sbx)
+		}
+
+		if b.size == 0 && !b.booting {
+			delete(p.builds, buildID)
+
+			continue
+		}
+
+		p.fill(buildID, b)
+	}
+}
+
+// Take removes a warm sandbox matching the config from the pool, it returns nil if there is none.
+// Only new sandboxes without volumes, egress policy and team network can be served from the pool, the resource limits are applied on assign.
+func (p *warmPool) Take(config *orchestrator.SandboxConfig) *sandbox.Sandbox {
+	if config.GetSnapshot() || len(config.GetVolumes()) > 0 || config.GetEgressPolicy() != nil || config.GetNetwork() != nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b, ok := p.builds[config.GetBuildId()]
+	if !ok || len(b.ready) == 0 || !b.matches(config) {
+		return nil
+	}
+
+	sbx := b.ready[0]
+	b.ready = b.ready[1:]
+
+	p.fill(config.GetBuildId(), b)
+
+	return sbx
+}
+
+// Remove removes the sandbox from the pool if it exits before being handed out.
+func (p *warmPool) Remove(sbx *sandbox.Sandbox) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for buildID, b := range p.builds {
+		for i, ready := range b.ready {
+			if ready != sbx {
+				continue
+			}
+
+			b.ready = append(b.ready[:i], b.ready[i+1:]...)
+			p.fill(buildID, b)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Available returns the number of the warm sandboxes ready for each build and the resources the warm sandboxes of the build hold.
+func (p *warmPool) Available() []*orchestrator.WarmPoolBuild {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	builds := make([]*orchestrator.WarmPoolBuild, 0, len(p.builds))
+	for buildID, b := range p.builds {
+		// The booting sandbox already holds the resources of its VM.
+		vms := int64(len(b.ready))
+		if b.booting {
+			vms++
+		}
+
+		builds = append(builds, &orchestrator.WarmPoolBuild{
+			BuildId:   buildID,
+			Available: int32(len(b.ready)),
+			Vcpu:      vms * b.config.GetVcpu(),
+			RamMb:     vms * b.config.GetRamMb(),
+		})
+	}
+
+	return builds
+}
+
+// fill boots the next warm sandbox of the build if the pool is not full.
+// The sandboxes are booted one by one, so refilling the pool doesn't compete with the sandboxes being created on the node.
+func (p *warmPool) fill(buildID string, b *warmBuild) {
+	if b.booting || len(b.ready) >= b.size {
+		return
+	}
+
+	b.booting = true
+
+	config := proto.Clone(b.config).(*orchestrator.SandboxConfig)
+	config.SandboxId = warmSandboxPrefix + id.Generate()
+	config.ExecutionId = uuid.New().String()
+	config.Snapshot = false
+
+	go func() {
+		sbx, err := p.boot(context.Background(), config)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		b.booting = false
+
+		if err != nil {
+			// The pool is refilled on the next configure, so a broken template is not booted in a loop.
+			zap.L().Error("failed to boot warm sandbox", logger.WithBuildID(buildID), zap.Error(err))
+
+			if b.size == 0 && p.builds[buildID] == b {
+				delete(p.builds, buildID)
+			}
+
+			return
+		}
+
+		if p.builds[buildID] != b || len(b.ready) >= b.size {
+			if b.size == 0 && p.builds[buildID] == b {
+				delete(p.builds, buildID)
+			}
+
+			go p.stop(sbx)
+
+			return
+		}
+
+		b.ready = append(b.ready, sbx)
+
+		p.fill(buildID, b)
+	}()
+}
+
+// matches checks that the warm sandboxes have the same resources as the requested sandbox.
+func (b *warmBuild) matches(config *orchestrator.SandboxConfig) bool {
+	return b.config.GetTemplateId() == config.GetTemplateId() &&
+		b.config.GetBaseTemplateId() == config.GetBaseTemplateId() &&
+		b.config.GetKernelVersion() == config.GetKernelVersion() &&
+		b.config.GetFirecrackerVersion() == config.GetFirecrackerVersion() &&
+		b.config.GetEnvdVersion() == config.GetEnvdVersion() &&
+		b.config.GetVcpu() == config.GetVcpu() &&
+		b.config.GetRamMb() == config.GetRamMb() &&
+		b.config.GetHugePages() == config.GetHugePages()
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+type fakeWarmSandboxes struct {
+	mu      sync.Mutex
+	booted  []*orchestrator.SandboxConfig
+	stopped []*sandbox.Sandbox
+}
+
+func (f *fakeWarmSandboxes) boot(_ context.Context, config *orchestrator.SandboxConfig) (*sandbox.Sandbox, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.booted = append(f.booted, config)
+
+	return &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: config}}, nil
+}
+
+func (f *fakeWarmSandboxes) stop(sbx *sandbox.Sandbox) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stopped = append(f.stopped, sbx)
+}
+
+func (f *fakeWarmSandboxes) stoppedCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.stopped)
+}
+
+func available(p *warmPool, buildID string) int32 {
+	for _, b := range p.Available() {
+		if b.GetBuildId() == buildID {
+			return b.GetAvailable()
+		}
+	}
+
+	return 0
+}
+
+func warmTemplate(buildID string, size int32) *orchestrator.WarmPoolTemplate {
+	return &orchestrator.WarmPoolTemplate{
+		Sandbox: &orchestrator.SandboxConfig{
+			TemplateId: "template",
+			BuildId:    buildID,
+			Vcpu:       2,
+			RamMb:      512,
+		},
+		Size: size,
+	}
+}
+
+func TestWarmPool_FillAndTake(t *testing.T) {
+	fake := &fakeWarmSandboxes{}
+	pool := newWarmPool(fake.boot, fake.stop)
+
+	pool.Configure([]*orchestrator.WarmPoolTemplate{warmTemplate("build", 2)})
+
+	require.Eventually(t, func() bool { return available(pool, "build") == 2 }, time.Second, time.Millisecond)
+
+	for _, config := range fake.booted {
+		assert.True(t, strings.HasPrefix(config.GetSandboxId(), warmSandboxPrefix))
+		assert.NotEmpty(t, config.GetExecutionId())
+	}
+
+	request := &orchestrator.SandboxConfig{
+		TemplateId: "template",
+		BuildId:    "build",
+		SandboxId:  "sandbox",
+		Vcpu:       2,
+		RamMb:      512,
+	}
+
+	sbx := pool.Take(request)
+	require.NotNil(t, sbx)
+	assert.Equal(t, "build", sbx.Config.GetBuildId())
+
+	// The taken sandbox is replaced.
+	require.Eventually(t, func() bool { return available(pool, "build") == 2 }, time.Second, time.Millisecond)
+}
+
+func TestWarmPool_TakeMismatch(t *testing.T) {
+	fake := &fakeWarmSandboxes{}
+	pool := newWarmPool(fake.boot, fake.stop)
+
+	pool.Configure([]*orchestrator.WarmPoolTemplate{warmTemplate("build", 1)})
+
+	require.Eventually(t, func() bool { return available(pool, "build") == 1 }, time.Second, time.Millisecond)
+
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{TemplateId: "template", BuildId: "other", Vcpu: 2, RamMb: 512}))
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{TemplateId: "template", BuildId: "build", Vcpu: 4, RamMb: 512}))
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{TemplateId: "template", BuildId: "build", Vcpu: 2, RamMb: 512, Snapshot: true}))
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{
+		TemplateId: "template",
+		BuildId:    "build",
+		Vcpu:       2,
+		RamMb:      512,
+		Volumes:    []*orchestrator.SandboxVolumeMount{{VolumeId: "volume"}},
+	}))
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{
+		TemplateId:   "template",
+		BuildId:      "build",
+		Vcpu:         2,
+		RamMb:        512,
+		EgressPolicy: &orchestrator.SandboxEgressPolicy{DeniedCidrs: []string{"0.0.0.0/0"}},
+	}))
+	assert.Nil(t, pool.Take(&orchestrator.SandboxConfig{
+		TemplateId: "template",
+		BuildId:    "build",
+		Vcpu:       2,
+		RamMb:      512,
+		Network:    &orchestrator.SandboxNetwork{NetworkId: "network", Address: "10.100.0.1"},
+	}))
+
+	assert.Equal(t, int32(1), available(pool, "build"))
+}
+
+func TestWarmPool_ConfigureShrinks(t *testing.T) {
+	fake := &fakeWarmSandboxes{}
+	pool := newWarmPool(fake.boot, fake.stop)
+
+	pool.Configure([]*orchestrator.WarmPoolTemplate{warmTemplate("build", 3)})
+
+	require.Eventually(t, func() bool { return available(pool, "build") == 3 }, time.Second, time.Millisecond)
+
+	pool.Configure([]*orchestrator.WarmPoolTemplate{warmTemplate("build", 1)})
+
+	assert.Equal(t, int32(1), available(pool, "build"))
+	require.Eventually(t, func() bool { return fake.stoppedCount() == 2 }, time.Second, time.Millisecond)
+
+	// Only the resources of the sandboxes kept in the pool are reported.
+	builds := pool.Available()
+	require.Len(t, builds, 1)
+	assert.Equal(t, int64(2), builds[0].GetVcpu())
+	assert.Equal(t, int64(512), builds[0].GetRamMb())
+
+	// The builds no longer configured are removed from the pool.
+	pool.Configure(nil)
+
+	assert.Empty(t, pool.Available())
+	require.Eventually(t, func() bool { return fake.stoppedCount() == 3 }, time.Second, time.Millisecond)
+}
+
+func TestWarmPool_Remove(t *testing.T) {
+	fake := &fakeWarmSandboxes{}
+	pool := newWarmPool(fake.boot, fake.stop)
+
+	pool.Configure([]*orchestrator.WarmPoolTemplate{warmTemplate("build", 1)})
+
+	require.Eventually(t, func() bool { return available(pool, "build") == 1 }, time.Second, time.Millisecond)
+
+	sbx := pool.Take(&orchestrator.SandboxConfig{TemplateId: "template", BuildId: "build", Vcpu: 2, RamMb: 512})
+	require.NotNil(t, sbx)
+
+	// The sandbox handed out is not in the pool anymore.
+	assert.False(t, pool.Remove(sbx))
+
+	require.Eventually(t, func() bool { return available(pool, "build") == 1 }, time.Second, time.Millisecond)
+
+	fake.mu.Lock()
+	last := fake.booted[len(fake.booted)-1]
+	fake.mu.Unlock()
+
+	var ready *sandbox.Sandbox
+	pool.mu.Lock()
+	ready = pool.builds["build"].ready[0]
+	pool.mu.Unlock()
+
+	assert.Equal(t, last, ready.Config)
+	assert.True(t, pool.Remove(ready))
+}
@@ -56,6 +56,31 @@ func Make(ctx context.Context, tracer trace.Tracer, rootfsPath string, sizeMb in
 	return cmd.Run()
 }
 
+// MakeVolume creates the filesystem of a persistent sandbox volume on the device.
+// Unlike the rootfs, the volume keeps the journal, as it is not snapshotted together with the VM memory.
+func MakeVolume(ctx context.Context, tracer trace.Tracer, devicePath string, blockSize int64) error {
+	ctx, mkfsSpan := tracer.Start(ctx, "make-ext4-volume")
+	defer mkfsSpan.End()
+
+	cmd := exec.CommandContext(ctx,
+		"mkfs.ext4",
+		"-q",
+		"-F",
+		"-b", strconv.FormatInt(blockSize, 10),
+		"-m", strconv.FormatInt(reservedBlocksPercentage, 10),
+		// Don't discard the device, the volume is already empty.
+		"-E", "nodiscard",
+		devicePath,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error making volume filesystem: %w: %s", err, string(output))
+	}
+
+	return nil
+}
+
 func Mount(ctx context.Context, tracer trace.Tracer, rootfsPath string, mountPoint string) error {
 	ctx, mountSpan := tracer.Start(ctx, "mount-ext4")
 	defer mountSpan.End()
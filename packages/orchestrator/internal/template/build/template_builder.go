@@ -273,6 +273,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 		b.tracer,
 		b.networkPool,
 		b.devicePool,
+		nil,
 		template.ToSandboxConfig(envdVersion),
 		localTemplate,
 		sbxTimeout,
@@ -283,6 +284,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 			SystemdToKernelLogs: false,
 		},
 		config.AllowSandboxInternet,
+		nil,
 	)
 	defer func() {
 		cleanupErr := cleanup.Run(ctx)
@@ -539,9 +541,16 @@ func (b *TemplateBuilder) uploadTemplate(
 
 		snapfilePath := snapshot.Snapfile.Path()
 
+		metadata, err := fc.NewSnapshotMetadata(ctx, templateFiles.FirecrackerVersion)
+		if err != nil {
+			errCh <- fmt.Errorf("error getting snapshot metadata: %w", err)
+			return
+		}
+
 		uploadErrCh := templateBuild.Upload(
 			ctx,
 			snapfilePath,
+			metadata,
 			&memfileDiffPath,
 			&rootfsDiffPath,
 		)
@@ -635,6 +644,7 @@ func (b *TemplateBuilder) provisionSandbox(
 		b.tracer,
 		b.networkPool,
 		b.devicePool,
+		nil,
 		template.ToSandboxConfig(envdVersion),
 		localTemplate,
 		provisionTimeout,
@@ -652,6 +662,7 @@ func (b *TemplateBuilder) provisionSandbox(
 		},
 		// Allow sandbox internet access during provisioning
 		true,
+		nil,
 	)
 	defer func() {
 		cleanupErr := cleanup.Run(ctx)
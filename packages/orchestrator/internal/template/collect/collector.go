@@ -0,0 +1,77 @@
+package collect
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const collectionInterval = time.Hour
+
+// Collector periodically removes the deduplicated chunks that are no longer referenced by any build.
+type Collector struct {
+	logger      *zap.Logger
+	persistence *storage.DedupStorageProvider
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCollector returns nil if the storage does not deduplicate the build data.
+func NewCollector(logger *zap.Logger, persistence storage.StorageProvider) *Collector {
+	dedup, ok := persistence.(*storage.DedupStorageProvider)
+	if !ok {
+		return nil
+	}
+
+	return &Collector{
+		logger:      logger,
+		persistence: dedup,
+	}
+}
+
+// Start runs the collection loop in the background until the collector is closed.
+func (c *Collector) Start(ctx context.Context) {
+	if c == nil {
+		return
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(collectionInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				deleted, err := c.persistence.CollectChunks(ctx)
+				if err != nil {
+					c.logger.Error("failed to collect unreferenced chunks", zap.Int("deleted", deleted), zap.Error(err))
+
+					continue
+				}
+
+				c.logger.Info("collected unreferenced chunks", zap.Int("deleted", deleted))
+			}
+		}
+	}()
+}
+
+// Close stops the collection loop and waits until it exits.
+func (c *Collector) Close() {
+	if c == nil || c.done == nil {
+		return
+	}
+
+	c.cancel()
+	<-c.done
+}
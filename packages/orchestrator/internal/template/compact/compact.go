@@ -0,0 +1,275 @@
+package compact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// The diff store is only used for a single compaction, the cached ancestors are removed with the cache directory afterward.
+	diffStoreTTL   = time.Hour
+	diffStoreDelay = 0
+	// Disable the disk space based eviction, the cache is removed after the compaction anyway.
+	diffStoreMaxUsedPercentage = 100
+)
+
+// Compact rewrites the build's diff of the given type, so it no longer references data of its ancestors.
+//
+// The build's own data stays at the same storage offsets and the ancestor data is appended after it,
+// so the descendants that reference the build keep working.
+// The data object is uploaded before the header, so the header never points past the end of the uploaded data.
+// The diff is compacted under a lease, it returns ErrCompactionInProgress if another orchestrator is compacting it.
+//
+// It returns nil if the diff is already self-contained.
+func Compact(
+	ctx context.Context,
+	tracer trace.Tracer,
+	persistence storage.StorageProvider,
+	buildID string,
+	fileType build.DiffType,
+	cachePath string,
+) (*header.Header, error) {
+	ctx, span := tracer.Start(ctx, "compact-diff", trace.WithAttributes(
+		attribute.String("build.id", buildID),
+		attribute.String("diff.type", string(fileType)),
+	))
+	defer span.End()
+
+	dataPath := fmt.Sprintf("%s/%s", buildID, fileType)
+	headerPath := dataPath + storage.HeaderSuffix
+
+	headerObject, err := persistence.OpenObject(ctx, headerPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open header: %w", err)
+	}
+
+	h, err := header.Deserialize(headerObject)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		// Builds without a header are always self-contained.
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize header: %w", err)
+	}
+
+	if IsSelfContained(h) {
+		return nil, nil
+	}
+
+	span.SetAttributes(attribute.Int64("diff.generation", int64(h.Metadata.Generation)))
+
+	l, err := acquireLease(ctx, persistence, buildID, fileType)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		releaseErr := l.release()
+		if releaseErr != nil {
+			zap.L().Error("failed to release compaction lease", zap.String("build_id", buildID), zap.Error(releaseErr))
+		}
+	}()
+
+	// The header could be compacted by another orchestrator before the lease was acquired.
+	h, err = header.Deserialize(headerObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize header: %w", err)
+	}
+
+	if IsSelfContained(h) {
+		return nil, nil
+	}
+
+	cachePath = filepath.Join(cachePath, fmt.Sprintf("compact-%s-%s-%s", buildID, fileType, id.Generate()))
+	defer os.RemoveAll(cachePath)
+
+	store, err := build.NewDiffStore(ctx, cachePath, diffStoreTTL, diffStoreDelay, diffStoreMaxUsedPercentage, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create diff store: %w", err)
+	}
+	defer store.Close()
+
+	compactedPath := filepath.Join(cachePath, "compacted")
+
+	compacted, err := writeCompacted(ctx, persistence, h, build.NewFile(h, store, fileType, persistence), dataPath, compactedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.ReportEvent(ctx, "compacted data written")
+
+	dataObject, err := persistence.OpenObject(ctx, dataPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data object: %w", err)
+	}
+
+	err = dataObject.WriteFromFileSystem(compactedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload compacted data: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "compacted data uploaded")
+
+	serialized, err := header.Serialize(compacted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize header: %w", err)
+	}
+
+	err = l.verify()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = headerObject.ReadFrom(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload compacted header: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "compacted header uploaded")
+
+	return compacted, nil
+}
+
+// IsSelfContained returns true if the header only references the build's own data.
+func IsSelfContained(h *header.Header) bool {
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId != uuid.Nil && mapping.BuildId != h.Metadata.BuildId {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeCompacted writes the build's own data followed by the data of the ancestor mappings to the destination path
+// and returns the header describing it.
+func writeCompacted(
+	ctx context.Context,
+	persistence storage.StorageProvider,
+	h *header.Header,
+	source io.ReaderAt,
+	dataPath string,
+	destinationPath string,
+) (*header.Header, error) {
+	dataObject, err := persistence.OpenObject(ctx, dataPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data object: %w", err)
+	}
+
+	dst, err := os.Create(destinationPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compacted file: %w", err)
+	}
+	defer dst.Close()
+
+	// Copy the stored data as it is, so the already stored frames stay valid.
+	stored, err := dataObject.WriteTo(dst)
+	if err != nil && !errors.Is(err, storage.ErrorObjectNotExist) {
+		return nil, fmt.Errorf("failed to copy build data: %w", err)
+	}
+
+	var storageMetadata *header.StorageMetadata
+
+	ownSize := uint64(stored)
+	if h.Storage != nil {
+		storageMetadata = &header.StorageMetadata{
+			Compression: h.Storage.Compression,
+			Frames:      append([]*header.StorageMap(nil), h.Storage.Frames...),
+		}
+
+		ownSize = uint64(h.Storage.Size())
+	}
+
+	var ancestors []io.Reader
+
+	mappings := make([]*header.BuildMap, 0, len(h.Mapping))
+	offset := ownSize
+
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == uuid.Nil || mapping.BuildId == h.Metadata.BuildId {
+			mappings = appendMapping(mappings, &header.BuildMap{
+				Offset:             mapping.Offset,
+				Length:             mapping.Length,
+				BuildId:            mapping.BuildId,
+				BuildStorageOffset: mapping.BuildStorageOffset,
+			})
+
+			continue
+		}
+
+		ancestors = append(ancestors, io.NewSectionReader(source, int64(mapping.Offset), int64(mapping.Length)))
+
+		mappings = appendMapping(mappings, &header.BuildMap{
+			Offset:             mapping.Offset,
+			Length:             mapping.Length,
+			BuildId:            h.Metadata.BuildId,
+			BuildStorageOffset: offset,
+		})
+
+		offset += mapping.Length
+	}
+
+	if storageMetadata != nil {
+		err = storageMetadata.AppendFrames(io.MultiReader(ancestors...), dst)
+	} else {
+		_, err = io.Copy(dst, io.MultiReader(ancestors...))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy ancestor data: %w", err)
+	}
+
+	err = dst.Sync()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync compacted file: %w", err)
+	}
+
+	// Only the mappings are flattened, the snapshots of the build keep referencing the rootfs drive path of the base build,
+	// so BaseBuildId must stay the same. The generation counts the diffs to resolve, there are none left.
+	metadata := *h.Metadata
+	metadata.Generation = 0
+
+	err = header.ValidateMappings(mappings, metadata.Size, metadata.BlockSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid compacted mappings: %w", err)
+	}
+
+	compacted := header.NewHeader(&metadata, mappings)
+	compacted.Storage = storageMetadata
+
+	return compacted, nil
+}
+
+// appendMapping appends the mapping, joining it with the previous one if it continues it in the same build's storage.
+func appendMapping(mappings []*header.BuildMap, mapping *header.BuildMap) []*header.BuildMap {
+	if len(mappings) > 0 {
+		last := mappings[len(mappings)-1]
+
+		if last.BuildId == mapping.BuildId &&
+			last.Offset+last.Length == mapping.Offset &&
+			(mapping.BuildId == uuid.Nil || last.BuildStorageOffset+last.Length == mapping.BuildStorageOffset) {
+			last.Length += mapping.Length
+
+			return mappings
+		}
+	}
+
+	return append(mappings, mapping)
+}
@@ -0,0 +1,211 @@
+package compact
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const blockSize = header.RootfsBlockSize
+
+func blocks(values ...byte) []byte {
+	data := make([]byte, 0, len(values)*blockSize)
+	for _, v := range values {
+		data = append(data, bytes.Repeat([]byte{v}, blockSize)...)
+	}
+
+	return data
+}
+
+func upload(t *testing.T, persistence storage.StorageProvider, h *header.Header, data []byte) {
+	t.Helper()
+
+	ctx := context.Background()
+	dataPath := h.Metadata.BuildId.String() + "/" + string(build.Rootfs)
+
+	if h.Storage != nil {
+		var compressed bytes.Buffer
+
+		frames, err := header.CompressFrames(h.Storage.Compression, bytes.NewReader(data), &compressed)
+		require.NoError(t, err)
+
+		h.Storage = frames
+		data = compressed.Bytes()
+	}
+
+	obj, err := persistence.OpenObject(ctx, dataPath)
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	serialized, err := header.Serialize(h)
+	require.NoError(t, err)
+
+	obj, err = persistence.OpenObject(ctx, dataPath+storage.HeaderSuffix)
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(serialized)
+	require.NoError(t, err)
+}
+
+func readAll(t *testing.T, persistence storage.StorageProvider, h *header.Header) []byte {
+	t.Helper()
+
+	store, err := build.NewDiffStore(context.Background(), t.TempDir(), time.Hour, 0, 100, nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	data := make([]byte, h.Metadata.Size)
+
+	_, err = build.NewFile(h, store, build.Rootfs, persistence).ReadAt(data, 0)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestCompact(t *testing.T) {
+	for _, framed := range []bool{false, true} {
+		t.Run(map[bool]string{false: "raw", true: "framed"}[framed], func(t *testing.T) {
+			basePath := t.TempDir()
+			persistence, err := storage.NewFileSystemStorageProvider(basePath)
+			require.NoError(t, err)
+
+			baseID := uuid.New()
+			base := header.NewHeader(header.NewTemplateMetadata(baseID, blockSize, 8*blockSize), nil)
+			upload(t, persistence, base, blocks(1, 2, 3, 4, 5, 6, 7, 8))
+
+			diffID := uuid.New()
+			metadata := base.Metadata.NextGeneration(diffID)
+
+			diff := header.NewHeader(metadata, []*header.BuildMap{
+				{Offset: 0, Length: 2 * blockSize, BuildId: diffID, BuildStorageOffset: 0},
+				{Offset: 2 * blockSize, Length: 2 * blockSize, BuildId: uuid.Nil},
+				{Offset: 4 * blockSize, Length: 2 * blockSize, BuildId: baseID, BuildStorageOffset: 4 * blockSize},
+				{Offset: 6 * blockSize, Length: 2 * blockSize, BuildId: baseID, BuildStorageOffset: 6 * blockSize},
+			})
+
+			if framed {
+				metadata.Version = header.VersionFramed
+				diff.Storage = &header.StorageMetadata{Compression: header.CompressionZstd}
+			}
+
+			upload(t, persistence, diff, blocks(9, 10))
+
+			expected := blocks(9, 10, 0, 0, 5, 6, 7, 8)
+			require.Equal(t, expected, readAll(t, persistence, diff))
+
+			compacted, err := Compact(context.Background(), noop.NewTracerProvider().Tracer(""), persistence, diffID.String(), build.Rootfs, t.TempDir())
+			require.NoError(t, err)
+			require.NotNil(t, compacted)
+
+			assert.True(t, IsSelfContained(compacted))
+			assert.Equal(t, uint64(0), compacted.Metadata.Generation)
+			assert.Equal(t, baseID, compacted.Metadata.BaseBuildId)
+			assert.Equal(t, []*header.BuildMap{
+				{Offset: 0, Length: 2 * blockSize, BuildId: diffID, BuildStorageOffset: 0},
+				{Offset: 2 * blockSize, Length: 2 * blockSize, BuildId: uuid.Nil},
+				{Offset: 4 * blockSize, Length: 4 * blockSize, BuildId: diffID, BuildStorageOffset: 2 * blockSize},
+			}, compacted.Mapping)
+
+			// Remove the base, the compacted build must not need it anymore.
+			require.NoError(t, os.RemoveAll(filepath.Join(basePath, baseID.String())))
+
+			obj, err := persistence.OpenObject(context.Background(), diffID.String()+"/"+string(build.Rootfs)+storage.HeaderSuffix)
+			require.NoError(t, err)
+
+			stored, err := header.Deserialize(obj)
+			require.NoError(t, err)
+			assert.Equal(t, compacted.Mapping, stored.Mapping)
+			assert.Equal(t, expected, readAll(t, persistence, stored))
+
+			resumeFromCompacted(t, persistence, diffID, baseID, expected)
+
+			// Compacting again is a no-op.
+			again, err := Compact(context.Background(), noop.NewTracerProvider().Tracer(""), persistence, diffID.String(), build.Rootfs, t.TempDir())
+			require.NoError(t, err)
+			assert.Nil(t, again)
+		})
+	}
+}
+
+// resumeFromCompacted opens the compacted rootfs the same way a sandbox resume does.
+func resumeFromCompacted(t *testing.T, persistence storage.StorageProvider, buildID, baseID uuid.UUID, expected []byte) {
+	t.Helper()
+
+	store, err := build.NewDiffStore(context.Background(), t.TempDir(), time.Hour, 0, 100, nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	rootfs, err := template.NewStorage(context.Background(), store, buildID.String(), build.Rootfs, nil, persistence)
+	require.NoError(t, err)
+
+	// The snapshot's rootfs drive points to the path of the base build, the resumed sandbox must provide the same path.
+	snapshotDrive := storage.NewTemplateFiles("template", baseID.String(), "kernel", "firecracker").SandboxRootfsPath()
+	resumeDrive := storage.NewTemplateFiles("template", rootfs.Header().Metadata.BaseBuildId.String(), "kernel", "firecracker").SandboxRootfsPath()
+	assert.Equal(t, snapshotDrive, resumeDrive)
+
+	data := make([]byte, len(expected))
+	_, err = rootfs.ReadAt(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+}
+
+func TestCompact_SkipsBuildLeasedByAnotherOrchestrator(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	buildID := uuid.NewString()
+
+	held, err := acquireLease(ctx, persistence, buildID, build.Rootfs)
+	require.NoError(t, err)
+
+	_, err = acquireLease(ctx, persistence, buildID, build.Rootfs)
+	require.ErrorIs(t, err, ErrCompactionInProgress)
+
+	// The lease of another diff type is independent.
+	memfile, err := acquireLease(ctx, persistence, buildID, build.Memfile)
+	require.NoError(t, err)
+	require.NoError(t, memfile.release())
+
+	require.NoError(t, held.verify())
+	require.NoError(t, held.release())
+
+	again, err := acquireLease(ctx, persistence, buildID, build.Rootfs)
+	require.NoError(t, err)
+	require.NoError(t, again.release())
+}
+
+func TestCompact_TakesOverExpiredLease(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	buildID := uuid.NewString()
+
+	crashed, err := persistence.OpenObject(ctx, leasePrefix+buildID+"/"+string(build.Rootfs))
+	require.NoError(t, err)
+	_, err = crashed.ReadFrom(strings.NewReader(leaseContent("crashed", time.Now().Add(-time.Minute))))
+	require.NoError(t, err)
+
+	l, err := acquireLease(ctx, persistence, buildID, build.Rootfs)
+	require.NoError(t, err)
+	require.NoError(t, l.verify())
+
+	// The compaction must not write the header once its lease is taken over.
+	l.expires = time.Now().Add(-time.Second)
+	require.Error(t, l.verify())
+}
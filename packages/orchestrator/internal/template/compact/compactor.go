@@ -0,0 +1,166 @@
+package compact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// queuePrefix is the storage prefix of the markers for builds waiting for compaction, the markers are not replicated.
+	queuePrefix = storage.CompactionQueuePrefix
+
+	compactionInterval = time.Minute
+)
+
+// generationThreshold is the snapshot generation from which the builds are queued for compaction, 0 disables it.
+var generationThreshold, _ = env.GetEnvAsInt("COMPACTION_GENERATION_THRESHOLD", 20)
+
+// ShouldCompact returns true if the diff chain of the build is deep enough to be compacted.
+func ShouldCompact(h *header.Header) bool {
+	return generationThreshold > 0 && h.Metadata.Generation >= uint64(generationThreshold) && !IsSelfContained(h)
+}
+
+// Enqueue marks the build for compaction by the template manager.
+func Enqueue(ctx context.Context, persistence storage.StorageProvider, buildID string) error {
+	marker, err := persistence.OpenObject(ctx, queuePrefix+buildID)
+	if err != nil {
+		return fmt.Errorf("failed to open compaction marker: %w", err)
+	}
+
+	_, err = marker.ReadFrom(strings.NewReader(time.Now().UTC().Format(time.RFC3339)))
+	if err != nil {
+		return fmt.Errorf("failed to write compaction marker: %w", err)
+	}
+
+	return nil
+}
+
+// Compactor periodically compacts the builds queued with Enqueue.
+type Compactor struct {
+	tracer      trace.Tracer
+	logger      *zap.Logger
+	persistence storage.StorageProvider
+	cachePath   string
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewCompactor(tracer trace.Tracer, logger *zap.Logger, persistence storage.StorageProvider, cachePath string) *Compactor {
+	return &Compactor{
+		tracer:      tracer,
+		logger:      logger,
+		persistence: persistence,
+		cachePath:   cachePath,
+	}
+}
+
+// Start runs the compaction loop in the background until the compactor is closed.
+func (c *Compactor) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(compactionInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.compactQueued(ctx)
+			}
+		}
+	}()
+}
+
+// Close stops the compaction loop and waits until it exits.
+// The interrupted compaction keeps its marker and is retried later.
+func (c *Compactor) Close() {
+	if c.done == nil {
+		return
+	}
+
+	c.cancel()
+	<-c.done
+}
+
+func (c *Compactor) compactQueued(ctx context.Context) {
+	markers, err := c.persistence.ListObjectsWithPrefix(ctx, queuePrefix)
+	if err != nil {
+		c.logger.Error("failed to list builds queued for compaction", zap.Error(err))
+
+		return
+	}
+
+	for _, marker := range markers {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		buildID := strings.TrimPrefix(marker, queuePrefix)
+
+		err := c.CompactBuild(ctx, buildID)
+		if errors.Is(err, ErrCompactionInProgress) {
+			// The orchestrator holding the lease removes the marker.
+			c.logger.Info("build is being compacted by another orchestrator", zap.String("build_id", buildID))
+
+			continue
+		}
+
+		if err != nil {
+			// The marker is kept, so the compaction is retried on the next run.
+			c.logger.Error("failed to compact build", zap.String("build_id", buildID), zap.Error(err))
+
+			continue
+		}
+
+		markerObject, err := c.persistence.OpenObject(ctx, marker)
+		if err != nil {
+			c.logger.Error("failed to open compaction marker", zap.String("build_id", buildID), zap.Error(err))
+
+			continue
+		}
+
+		err = markerObject.Delete()
+		if err != nil {
+			c.logger.Error("failed to delete compaction marker", zap.String("build_id", buildID), zap.Error(err))
+		}
+	}
+}
+
+// CompactBuild compacts both the memfile and rootfs diffs of the build.
+func (c *Compactor) CompactBuild(ctx context.Context, buildID string) error {
+	for _, fileType := range []build.DiffType{build.Memfile, build.Rootfs} {
+		h, err := Compact(ctx, c.tracer, c.persistence, buildID, fileType, c.cachePath)
+		if err != nil {
+			return fmt.Errorf("failed to compact %s: %w", fileType, err)
+		}
+
+		if h == nil {
+			c.logger.Info("build diff is already self-contained", zap.String("build_id", buildID), zap.String("diff_type", string(fileType)))
+
+			continue
+		}
+
+		c.logger.Info("compacted build diff", zap.String("build_id", buildID), zap.String("diff_type", string(fileType)), zap.Int("mappings", len(h.Mapping)))
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package compact
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	// leasePrefix is the storage prefix of the leases of the builds being compacted.
+	leasePrefix = "compaction-leases/"
+
+	// leaseTTL bounds a single diff compaction, the lease of a crashed compaction is taken over after it expires.
+	leaseTTL = time.Hour
+)
+
+var ErrCompactionInProgress = errors.New("the build is being compacted by another orchestrator")
+
+// lease prevents two orchestrators from compacting and overwriting the same build diff at once.
+type lease struct {
+	object  storage.StorageObjectProvider
+	owner   string
+	expires time.Time
+}
+
+func leaseContent(owner string, expires time.Time) string {
+	return owner + "\n" + expires.UTC().Format(time.RFC3339Nano)
+}
+
+func parseLease(content string) (string, time.Time, error) {
+	owner, expiresValue, ok := strings.Cut(content, "\n")
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("invalid lease %q", content)
+	}
+
+	expires, err := time.Parse(time.RFC3339Nano, expiresValue)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid lease expiration: %w", err)
+	}
+
+	return owner, expires, nil
+}
+
+// acquireLease takes the compaction lease of the build diff, it returns ErrCompactionInProgress if another orchestrator holds it.
+func acquireLease(ctx context.Context, persistence storage.StorageProvider, buildID string, fileType build.DiffType) (*lease, error) {
+	object, err := persistence.OpenObject(ctx, fmt.Sprintf("%s%s/%s", leasePrefix, buildID, fileType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open compaction lease: %w", err)
+	}
+
+	l := &lease{
+		object:  object,
+		owner:   uuid.NewString(),
+		expires: time.Now().Add(leaseTTL),
+	}
+
+	// The second attempt is done after removing an expired lease.
+	for range 2 {
+		_, err = object.ReadFromIfNotExist(strings.NewReader(leaseContent(l.owner, l.expires)))
+		if err == nil {
+			return l, nil
+		}
+
+		if !errors.Is(err, storage.ErrorObjectAlreadyExist) {
+			return nil, fmt.Errorf("failed to write compaction lease: %w", err)
+		}
+
+		_, expires, err := l.read()
+		if errors.Is(err, storage.ErrorObjectNotExist) {
+			continue
+		}
+
+		if err == nil && time.Now().Before(expires) {
+			return nil, ErrCompactionInProgress
+		}
+
+		// The lease is left by a crashed compaction.
+		err = object.Delete()
+		if err != nil && !errors.Is(err, storage.ErrorObjectNotExist) {
+			return nil, fmt.Errorf("failed to remove expired compaction lease: %w", err)
+		}
+	}
+
+	return nil, ErrCompactionInProgress
+}
+
+func (l *lease) read() (string, time.Time, error) {
+	var buf bytes.Buffer
+
+	_, err := l.object.WriteTo(&buf)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return parseLease(buf.String())
+}
+
+// verify returns an error if the lease expired or was taken over, the compaction must not write the header then.
+func (l *lease) verify() error {
+	owner, _, err := l.read()
+	if err != nil {
+		return fmt.Errorf("failed to read compaction lease: %w", err)
+	}
+
+	if owner != l.owner || time.Now().After(l.expires) {
+		return errors.New("compaction lease expired")
+	}
+
+	return nil
+}
+
+// release removes the lease if it is still held.
+func (l *lease) release() error {
+	if l.verify() != nil {
+		return nil
+	}
+
+	err := l.object.Delete()
+	if err != nil && !errors.Is(err, storage.ErrorObjectNotExist) {
+		return fmt.Errorf("failed to remove compaction lease: %w", err)
+	}
+
+	return nil
+}
@@ -14,10 +14,13 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/grpcserver"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	sandboxbuild "github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/cache"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/collect"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/compact"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/template"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -37,6 +40,9 @@ type ServerStore struct {
 	artifactsregistry artifactsregistry.ArtifactsRegistry
 	healthStatus      templatemanager.HealthState
 	wg                *sync.WaitGroup // wait group for running builds
+	compactor         *compact.Compactor
+	collector         *collect.Collector
+	persistence       storage.StorageProvider
 }
 
 func New(
@@ -88,8 +94,14 @@ func New(
 		templateStorage:   templateStorage,
 		healthStatus:      templatemanager.HealthState_Healthy,
 		wg:                &sync.WaitGroup{},
+		compactor:         compact.NewCompactor(tracer, logger, persistence, sandboxbuild.DefaultCachePath),
+		collector:         collect.NewCollector(logger, persistence),
+		persistence:       persistence,
 	}
 
+	store.compactor.Start(ctx)
+	store.collector.Start(ctx)
+
 	templatemanager.RegisterTemplateServiceServer(grpc.GRPCServer(), store)
 
 	return store, nil
@@ -112,6 +124,18 @@ func (s *ServerStore) Close(ctx context.Context) error {
 		s.logger.Info("waiting for all jobs to finish")
 		s.wg.Wait()
 
+		s.logger.Info("stopping build compaction")
+		s.compactor.Close()
+
+		s.logger.Info("stopping chunk collection")
+		s.collector.Close()
+
+		s.logger.Info("waiting for template replication")
+		err := storage.WaitForPendingWrites(ctx, s.persistence)
+		if err != nil {
+			s.logger.Error("failed to wait for template replication", zap.Error(err))
+		}
+
 		if !env.IsLocal() {
 			// give some time so all connected services can check build status
 			s.logger.Info("waiting before shutting down server")
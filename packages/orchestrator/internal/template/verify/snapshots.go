@@ -0,0 +1,143 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// metadataReadConcurrency limits the snapshot metadata read from the storage at once.
+const metadataReadConcurrency = 16
+
+type SnapshotStatus string
+
+const (
+	SnapshotCompatible   SnapshotStatus = "compatible"
+	SnapshotIncompatible SnapshotStatus = "incompatible"
+	// SnapshotUnknown is used for the builds without the snapshot version, they break once their Firecracker version is removed.
+	SnapshotUnknown SnapshotStatus = "unknown"
+)
+
+type Snapshot struct {
+	BuildID            string         `json:"build_id"`
+	FirecrackerVersion string         `json:"firecracker_version,omitempty"`
+	SnapshotVersion    string         `json:"snapshot_version,omitempty"`
+	Status             SnapshotStatus `json:"status"`
+}
+
+type SnapshotsReport struct {
+	// SnapshotVersion is the snapshot version supported by the proposed Firecracker.
+	SnapshotVersion string `json:"snapshot_version"`
+	OK              bool   `json:"ok"`
+	Total           int    `json:"total"`
+	// Snapshots are the snapshots that would break or may break with the proposed Firecracker.
+	Snapshots []*Snapshot `json:"snapshots"`
+}
+
+// Snapshots checks which stored snapshots can't be loaded by the Firecracker supporting the snapshot version.
+// The builds are found by their snapfile, the prefix limits the checked builds.
+func Snapshots(ctx context.Context, persistence storage.StorageProvider, prefix string, supported *semver.Version) (*SnapshotsReport, error) {
+	objects, err := persistence.ListObjectsWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list builds: %w", err)
+	}
+
+	var buildIDs []string
+	for _, object := range objects {
+		buildID, name, ok := strings.Cut(object, "/")
+		if ok && name == storage.SnapfileName {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+
+	sort.Strings(buildIDs)
+
+	report := &SnapshotsReport{
+		SnapshotVersion: supported.Original(),
+		Total:           len(buildIDs),
+		Snapshots:       make([]*Snapshot, 0),
+	}
+
+	var mu sync.Mutex
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(metadataReadConcurrency)
+
+	for _, buildID := range buildIDs {
+		eg.Go(func() error {
+			snapshot, err := checkSnapshot(ctx, persistence, buildID, supported)
+			if err != nil {
+				return err
+			}
+
+			if snapshot.Status == SnapshotCompatible {
+				return nil
+			}
+
+			mu.Lock()
+			report.Snapshots = append(report.Snapshots, snapshot)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(report.Snapshots, func(i, j int) bool {
+		return report.Snapshots[i].BuildID < report.Snapshots[j].BuildID
+	})
+
+	report.OK = len(report.Snapshots) == 0
+
+	return report, nil
+}
+
+func checkSnapshot(ctx context.Context, persistence storage.StorageProvider, buildID string, supported *semver.Version) (*Snapshot, error) {
+	files := storage.NewTemplateFiles("", buildID, "", "")
+
+	metadata, err := storage.ReadSnapshotMetadata(ctx, persistence, files)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		return &Snapshot{BuildID: buildID, Status: SnapshotUnknown}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		BuildID:            buildID,
+		FirecrackerVersion: metadata.FirecrackerVersion,
+		SnapshotVersion:    metadata.SnapshotVersion,
+		Status:             SnapshotUnknown,
+	}
+
+	if metadata.SnapshotVersion == "" {
+		return snapshot, nil
+	}
+
+	version, err := semver.NewVersion(metadata.SnapshotVersion)
+	if err != nil {
+		// The version can't be compared, so the snapshot is reported for a manual check.
+		return snapshot, nil
+	}
+
+	snapshot.Status = SnapshotIncompatible
+	if fc.CanLoadSnapshot(supported, version) {
+		snapshot.Status = SnapshotCompatible
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,62 @@
+package verify
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func uploadObject(t *testing.T, persistence storage.StorageProvider, path string, data string) {
+	t.Helper()
+
+	obj, err := persistence.OpenObject(context.Background(), path)
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(strings.NewReader(data))
+	require.NoError(t, err)
+}
+
+func TestSnapshots(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	for buildID, metadata := range map[string]string{
+		"compatible":   `{"firecracker_version":"v1.10.1_b","snapshot_version":"v2.0.0"}`,
+		"incompatible": `{"firecracker_version":"v1.12.0_c","snapshot_version":"v3.0.0"}`,
+		"unversioned":  `{"firecracker_version":"v1.10.1_b"}`,
+		"legacy":       "",
+	} {
+		uploadObject(t, persistence, buildID+"/"+storage.SnapfileName, "snapfile")
+
+		if metadata != "" {
+			uploadObject(t, persistence, buildID+"/"+storage.SnapshotMetadataName, metadata)
+		}
+	}
+
+	// The builds without the snapfile are not snapshots.
+	uploadObject(t, persistence, "nosnapfile/"+storage.SnapshotMetadataName, `{"firecracker_version":"v1.12.0_c","snapshot_version":"v3.0.0"}`)
+
+	report, err := Snapshots(context.Background(), persistence, "", semver.MustParse("v2.1.0"))
+	require.NoError(t, err)
+
+	assert.False(t, report.OK)
+	assert.Equal(t, 4, report.Total)
+	assert.Equal(t, "v2.1.0", report.SnapshotVersion)
+	assert.Equal(t, []*Snapshot{
+		{BuildID: "incompatible", FirecrackerVersion: "v1.12.0_c", SnapshotVersion: "v3.0.0", Status: SnapshotIncompatible},
+		{BuildID: "legacy", Status: SnapshotUnknown},
+		{BuildID: "unversioned", FirecrackerVersion: "v1.10.1_b", Status: SnapshotUnknown},
+	}, report.Snapshots)
+
+	report, err = Snapshots(context.Background(), persistence, "compatible", semver.MustParse("v2.1.0"))
+	require.NoError(t, err)
+
+	assert.True(t, report.OK)
+	assert.Equal(t, 1, report.Total)
+	assert.Empty(t, report.Snapshots)
+}
@@ -0,0 +1,394 @@
+package verify
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+type IssueKind string
+
+const (
+	IssueInvalidHeader   IssueKind = "invalid_header"
+	IssueBuildMismatch   IssueKind = "build_mismatch"
+	IssueMappingOverlap  IssueKind = "mapping_overlap"
+	IssueMappingGap      IssueKind = "mapping_gap"
+	IssueMappingInvalid  IssueKind = "mapping_invalid"
+	IssueMissingObject   IssueKind = "missing_object"
+	IssueObjectTooSmall  IssueKind = "object_too_small"
+	IssueObjectSize      IssueKind = "object_size_mismatch"
+	IssueChecksum        IssueKind = "checksum_mismatch"
+	IssueReadError       IssueKind = "read_error"
+	IssueMissingAncestor IssueKind = "missing_ancestor_header"
+)
+
+type ChecksumStatus string
+
+const (
+	ChecksumSkipped ChecksumStatus = "skipped"
+	ChecksumOK      ChecksumStatus = "ok"
+	ChecksumFailed  ChecksumStatus = "failed"
+	// ChecksumUnavailable is used for raw objects that are not deduplicated, there is nothing to compare them with.
+	ChecksumUnavailable ChecksumStatus = "unavailable"
+)
+
+type Issue struct {
+	Kind    IssueKind `json:"kind"`
+	BuildID string    `json:"build_id"`
+	File    string    `json:"file"`
+	Message string    `json:"message"`
+}
+
+// Object describes one data object of the verified ancestry chain.
+type Object struct {
+	BuildID string `json:"build_id"`
+	File    string `json:"file"`
+	// Header is false for the builds uploaded without a header, their data object is used as is.
+	Header     bool   `json:"header"`
+	Version    uint64 `json:"version,omitempty"`
+	Generation uint64 `json:"generation,omitempty"`
+	Mappings   int    `json:"mappings,omitempty"`
+	// ObjectSize is the size of the stored object, DataSize is the size of its uncompressed data.
+	ObjectSize int64 `json:"object_size"`
+	DataSize   int64 `json:"data_size"`
+	// RequiredSize is the end of the furthest range of the object referenced by the verified mappings.
+	RequiredSize uint64         `json:"required_size"`
+	Checksum     ChecksumStatus `json:"checksum"`
+}
+
+type Report struct {
+	BuildID string    `json:"build_id"`
+	OK      bool      `json:"ok"`
+	Objects []*Object `json:"objects"`
+	Issues  []Issue   `json:"issues"`
+}
+
+type verifier struct {
+	persistence storage.StorageProvider
+	fileType    build.DiffType
+	checksum    bool
+
+	report *Report
+}
+
+// Verify checks the build's diffs of the given types and the whole ancestry chain they reference.
+//
+// The headers are loaded starting from the build and following the BuildId references of their mappings.
+// Every referenced object must exist and be large enough for the mappings, and the mappings must cover the whole size without overlaps.
+// With checksum enabled the data of the framed objects is verified against the frame checksums
+// and the deduplicated objects against their chunk hashes.
+//
+// The found problems are returned in the report, the error is returned only if the verification itself cannot run.
+func Verify(ctx context.Context, persistence storage.StorageProvider, buildID string, fileTypes []build.DiffType, checksum bool) (*Report, error) {
+	if _, err := uuid.Parse(buildID); err != nil {
+		return nil, fmt.Errorf("invalid build id %s: %w", buildID, err)
+	}
+
+	report := &Report{
+		BuildID: buildID,
+		Objects: []*Object{},
+		Issues:  []Issue{},
+	}
+
+	for _, fileType := range fileTypes {
+		v := &verifier{
+			persistence: persistence,
+			fileType:    fileType,
+			checksum:    checksum,
+			report:      report,
+		}
+
+		err := v.verifyChain(ctx, buildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify %s: %w", fileType, err)
+		}
+	}
+
+	report.OK = len(report.Issues) == 0
+
+	return report, nil
+}
+
+func (v *verifier) issue(kind IssueKind, buildID string, format string, args ...any) {
+	v.report.Issues = append(v.report.Issues, Issue{
+		Kind:    kind,
+		BuildID: buildID,
+		File:    string(v.fileType),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *verifier) path(buildID string) string {
+	return fmt.Sprintf("%s/%s", buildID, v.fileType)
+}
+
+func (v *verifier) verifyChain(ctx context.Context, buildID string) error {
+	headers := make(map[string]*header.Header)
+	required := map[string]uint64{buildID: 0}
+	order := []string{buildID}
+
+	for i := 0; i < len(order); i++ {
+		current := order[i]
+
+		h, err := v.loadHeader(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		headers[current] = h
+
+		if h == nil {
+			if current != buildID {
+				v.issue(IssueMissingAncestor, current, "build is referenced by the chain but has no header")
+			}
+
+			continue
+		}
+
+		if h.Metadata.BuildId.String() != current {
+			v.issue(IssueBuildMismatch, current, "header belongs to build %s", h.Metadata.BuildId)
+		}
+
+		v.checkMappings(current, h)
+
+		for _, mapping := range h.Mapping {
+			if mapping.BuildId == uuid.Nil || mapping.Length == 0 {
+				continue
+			}
+
+			ref := mapping.BuildId.String()
+			end := mapping.BuildStorageOffset + mapping.Length
+
+			if _, ok := required[ref]; !ok {
+				order = append(order, ref)
+			}
+
+			required[ref] = max(required[ref], end)
+		}
+	}
+
+	for _, current := range order {
+		v.verifyObject(ctx, current, headers[current], required[current])
+	}
+
+	return nil
+}
+
+// loadHeader returns nil if the build has no header.
+func (v *verifier) loadHeader(ctx context.Context, buildID string) (*header.Header, error) {
+	obj, err := v.persistence.OpenObject(ctx, v.path(buildID)+storage.HeaderSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open header of %s: %w", buildID, err)
+	}
+
+	h, err := header.Deserialize(obj)
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		v.issue(IssueInvalidHeader, buildID, "failed to deserialize header: %s", err)
+
+		return nil, nil
+	}
+
+	return h, nil
+}
+
+// checkMappings reports all the problems of the mappings instead of stopping at the first one like header.ValidateMappings.
+func (v *verifier) checkMappings(buildID string, h *header.Header) {
+	blockSize := h.Metadata.BlockSize
+	size := h.Metadata.Size
+
+	if blockSize == 0 {
+		v.issue(IssueInvalidHeader, buildID, "block size is zero")
+
+		return
+	}
+
+	var cursor uint64
+
+	for _, mapping := range h.Mapping {
+		switch {
+		case mapping.Length == 0:
+			v.issue(IssueMappingInvalid, buildID, "mapping has zero length: %s", mapping.Format(blockSize))
+		case mapping.Offset%blockSize != 0 || mapping.Length%blockSize != 0:
+			v.issue(IssueMappingInvalid, buildID, "mapping is not aligned to the block size %d: %s", blockSize, mapping.Format(blockSize))
+		}
+
+		if mapping.Offset < cursor {
+			v.issue(IssueMappingOverlap, buildID, "mapping overlaps the previous one by %d B: %s", cursor-mapping.Offset, mapping.Format(blockSize))
+		} else if mapping.Offset > cursor {
+			v.issue(IssueMappingGap, buildID, "%d B before the mapping are not mapped: %s", mapping.Offset-cursor, mapping.Format(blockSize))
+		}
+
+		end := mapping.Offset + mapping.Length
+		if end > size {
+			v.issue(IssueMappingInvalid, buildID, "mapping ends %d B past the size %d: %s", end-size, size, mapping.Format(blockSize))
+		}
+
+		cursor = max(cursor, end)
+	}
+
+	if cursor < size {
+		v.issue(IssueMappingGap, buildID, "the last %d B up to the size %d are not mapped", size-cursor, size)
+	}
+}
+
+func (v *verifier) verifyObject(ctx context.Context, buildID string, h *header.Header, required uint64) {
+	object := &Object{
+		BuildID:      buildID,
+		File:         string(v.fileType),
+		Header:       h != nil,
+		RequiredSize: required,
+		Checksum:     ChecksumSkipped,
+	}
+	v.report.Objects = append(v.report.Objects, object)
+
+	var storageMetadata *header.StorageMetadata
+	if h != nil {
+		object.Version = h.Metadata.Version
+		object.Generation = h.Metadata.Generation
+		object.Mappings = len(h.Mapping)
+		storageMetadata = h.Storage
+	}
+
+	obj, err := v.persistence.OpenObject(ctx, v.path(buildID))
+	if err != nil {
+		v.issue(IssueReadError, buildID, "failed to open object: %s", err)
+
+		return
+	}
+
+	size, err := obj.Size()
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		v.issue(IssueMissingObject, buildID, "data object %s does not exist", v.path(buildID))
+
+		return
+	}
+
+	if err != nil {
+		v.issue(IssueReadError, buildID, "failed to get object size: %s", err)
+
+		return
+	}
+
+	object.ObjectSize = size
+	object.DataSize = size
+
+	if storageMetadata != nil {
+		object.DataSize = storageMetadata.Size()
+
+		var storedSize int64
+		if len(storageMetadata.Frames) > 0 {
+			last := storageMetadata.Frames[len(storageMetadata.Frames)-1]
+			storedSize = int64(last.CompressedOffset + last.CompressedLength)
+		}
+
+		if storedSize != size {
+			v.issue(IssueObjectSize, buildID, "object has %d B, the frames need %d B", size, storedSize)
+		}
+	}
+
+	if uint64(object.DataSize) < required {
+		v.issue(IssueObjectTooSmall, buildID, "object has %d B of data, the mappings reference up to %d B", object.DataSize, required)
+	}
+
+	if !v.checksum {
+		return
+	}
+
+	switch {
+	case storageMetadata != nil:
+		object.Checksum = v.verifyFrames(buildID, obj, storageMetadata)
+	default:
+		object.Checksum = v.verifyChunks(ctx, buildID, obj)
+	}
+}
+
+func (v *verifier) verifyFrames(buildID string, obj storage.StorageObjectProvider, storageMetadata *header.StorageMetadata) ChecksumStatus {
+	status := ChecksumOK
+
+	for _, frame := range storageMetadata.Frames {
+		compressed := make([]byte, frame.CompressedLength)
+
+		err := readFull(obj, compressed, int64(frame.CompressedOffset))
+		if err != nil {
+			v.issue(IssueReadError, buildID, "failed to read frame at %d: %s", frame.Offset, err)
+			status = ChecksumFailed
+
+			continue
+		}
+
+		_, err = header.DecompressFrame(storageMetadata.Compression, frame, compressed)
+		if err != nil {
+			v.issue(IssueChecksum, buildID, "%s", err)
+			status = ChecksumFailed
+		}
+	}
+
+	return status
+}
+
+// verifyChunks compares the data of a deduplicated object with the hashes of its chunk index.
+func (v *verifier) verifyChunks(ctx context.Context, buildID string, obj storage.StorageObjectProvider) ChecksumStatus {
+	dedup, ok := v.persistence.(*storage.DedupStorageProvider)
+	if !ok {
+		return ChecksumUnavailable
+	}
+
+	index, err := dedup.ChunkIndex(ctx, v.path(buildID))
+	if errors.Is(err, storage.ErrorObjectNotExist) {
+		return ChecksumUnavailable
+	}
+
+	if err != nil {
+		v.issue(IssueReadError, buildID, "failed to read chunk index: %s", err)
+
+		return ChecksumFailed
+	}
+
+	status := ChecksumOK
+
+	for i, expected := range index.Hashes {
+		offset := header.BlockOffset(int64(i), int64(index.ChunkSize))
+		data := make([]byte, index.ChunkLength(i))
+
+		err := readFull(obj, data, offset)
+		if err != nil {
+			v.issue(IssueReadError, buildID, "failed to read chunk at %d: %s", offset, err)
+			status = ChecksumFailed
+
+			continue
+		}
+
+		if header.ChunkHash(sha256.Sum256(data)) != expected {
+			v.issue(IssueChecksum, buildID, "chunk at %d does not match its hash %s", offset, expected)
+			status = ChecksumFailed
+		}
+	}
+
+	return status
+}
+
+// readFull reads the whole buffer, the io.EOF returned together with the last bytes of the object is not an error.
+func readFull(obj storage.StorageObjectProvider, buff []byte, off int64) error {
+	n, err := obj.ReadAt(buff, off)
+	if errors.Is(err, io.EOF) && n == len(buff) {
+		return nil
+	}
+
+	if err == nil && n != len(buff) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,149 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const blockSize = header.RootfsBlockSize
+
+func upload(t *testing.T, persistence storage.StorageProvider, h *header.Header, data []byte) {
+	t.Helper()
+
+	ctx := context.Background()
+	dataPath := h.Metadata.BuildId.String() + "/" + string(build.Rootfs)
+
+	if h.Storage != nil {
+		var compressed bytes.Buffer
+
+		frames, err := header.CompressFrames(h.Storage.Compression, bytes.NewReader(data), &compressed)
+		require.NoError(t, err)
+
+		h.Storage = frames
+		data = compressed.Bytes()
+	}
+
+	obj, err := persistence.OpenObject(ctx, dataPath)
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	serialized, err := header.Serialize(h)
+	require.NoError(t, err)
+
+	obj, err = persistence.OpenObject(ctx, dataPath+storage.HeaderSuffix)
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(serialized)
+	require.NoError(t, err)
+}
+
+// chain uploads a framed base with 4 blocks and a raw diff replacing its second block.
+func chain(t *testing.T) (string, storage.StorageProvider, uuid.UUID, uuid.UUID) {
+	t.Helper()
+
+	basePath := t.TempDir()
+	persistence, err := storage.NewFileSystemStorageProvider(basePath)
+	require.NoError(t, err)
+
+	baseID := uuid.New()
+	baseMetadata := header.NewTemplateMetadata(baseID, blockSize, 4*blockSize)
+	baseMetadata.Version = header.VersionFramed
+
+	base := header.NewHeader(baseMetadata, nil)
+	base.Storage = &header.StorageMetadata{Compression: header.CompressionZstd}
+	upload(t, persistence, base, bytes.Repeat([]byte{1}, 4*blockSize))
+
+	diffID := uuid.New()
+	diff := header.NewHeader(base.Metadata.NextGeneration(diffID), []*header.BuildMap{
+		{Offset: 0, Length: blockSize, BuildId: baseID, BuildStorageOffset: 0},
+		{Offset: blockSize, Length: blockSize, BuildId: diffID, BuildStorageOffset: 0},
+		{Offset: 2 * blockSize, Length: 2 * blockSize, BuildId: baseID, BuildStorageOffset: 2 * blockSize},
+	})
+	diff.Metadata.Version = header.VersionRaw
+	upload(t, persistence, diff, bytes.Repeat([]byte{2}, blockSize))
+
+	return basePath, persistence, baseID, diffID
+}
+
+func kinds(report *Report) []IssueKind {
+	var result []IssueKind
+	for _, issue := range report.Issues {
+		result = append(result, issue.Kind)
+	}
+
+	return result
+}
+
+func TestVerify_ValidChain(t *testing.T) {
+	_, persistence, baseID, diffID := chain(t)
+
+	report, err := Verify(context.Background(), persistence, diffID.String(), []build.DiffType{build.Rootfs}, true)
+	require.NoError(t, err)
+
+	assert.True(t, report.OK, "unexpected issues: %v", report.Issues)
+	require.Len(t, report.Objects, 2)
+	assert.Equal(t, baseID.String(), report.Objects[1].BuildID)
+	assert.Equal(t, uint64(4*blockSize), report.Objects[1].RequiredSize)
+	assert.Equal(t, ChecksumOK, report.Objects[1].Checksum)
+	assert.Equal(t, ChecksumUnavailable, report.Objects[0].Checksum)
+}
+
+func TestVerify_MissingAncestorObject(t *testing.T) {
+	basePath, persistence, baseID, diffID := chain(t)
+
+	require.NoError(t, os.Remove(filepath.Join(basePath, baseID.String(), string(build.Rootfs))))
+
+	report, err := Verify(context.Background(), persistence, diffID.String(), []build.DiffType{build.Rootfs}, false)
+	require.NoError(t, err)
+
+	assert.False(t, report.OK)
+	assert.Equal(t, []IssueKind{IssueMissingObject}, kinds(report))
+	assert.Equal(t, baseID.String(), report.Issues[0].BuildID)
+}
+
+func TestVerify_CorruptedFrame(t *testing.T) {
+	basePath, persistence, baseID, diffID := chain(t)
+
+	dataPath := filepath.Join(basePath, baseID.String(), string(build.Rootfs))
+	data, err := os.ReadFile(dataPath)
+	require.NoError(t, err)
+
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(dataPath, data, 0o644))
+
+	report, err := Verify(context.Background(), persistence, diffID.String(), []build.DiffType{build.Rootfs}, true)
+	require.NoError(t, err)
+
+	assert.False(t, report.OK)
+	assert.Contains(t, kinds(report), IssueChecksum)
+	assert.Equal(t, ChecksumFailed, report.Objects[1].Checksum)
+}
+
+func TestVerify_MappingProblems(t *testing.T) {
+	persistence, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	buildID := uuid.New()
+	h := header.NewHeader(header.NewTemplateMetadata(buildID, blockSize, 6*blockSize), []*header.BuildMap{
+		{Offset: 0, Length: 2 * blockSize, BuildId: buildID, BuildStorageOffset: 0},
+		{Offset: blockSize, Length: blockSize, BuildId: buildID, BuildStorageOffset: 2 * blockSize},
+		{Offset: 3 * blockSize, Length: blockSize, BuildId: buildID, BuildStorageOffset: 3 * blockSize},
+	})
+	upload(t, persistence, h, bytes.Repeat([]byte{1}, 2*blockSize))
+
+	report, err := Verify(context.Background(), persistence, buildID.String(), []build.DiffType{build.Rootfs}, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []IssueKind{IssueMappingOverlap, IssueMappingGap, IssueMappingGap, IssueObjectTooSmall}, kinds(report))
+}
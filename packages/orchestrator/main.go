@@ -21,6 +21,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/grpcserver"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/recovery"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
@@ -28,6 +29,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/service"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
 	tmplserver "github.com/e2b-dev/infra/packages/orchestrator/internal/template/server"
+	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -49,6 +51,7 @@ const (
 	version = "0.1.0"
 
 	fileLockName = "/opt/e2b/runtime/orchestrator.lock"
+	journalDir   = "/orchestrator/journal"
 )
 
 var (
@@ -96,10 +99,13 @@ func run(port, proxyPort uint) (success bool) {
 			break
 		}
 	}
+	// The resources left behind by the crashed orchestrator are cleaned up before the start.
+	crashed := false
 	if !env.IsDevelopment() && !forceStop && hasOrchestrator {
 		info, err := os.Stat(lockPath)
 		if err == nil {
-			log.Fatalf("Orchestrator was already started at %s, exiting", info.ModTime())
+			log.Printf("Orchestrator was already started at %s and didn't exit cleanly, recovering", info.ModTime())
+			crashed = true
 		}
 
 		f, err := os.Create(lockPath)
@@ -231,6 +237,22 @@ func run(port, proxyPort uint) (success bool) {
 
 	tracer := tel.TracerProvider.Tracer(serviceName)
 
+	journal, err := recovery.NewJournal(env.GetEnv("SANDBOX_JOURNAL_PATH", journalDir))
+	if err != nil {
+		zap.L().Fatal("failed to create sandbox journal", zap.Error(err))
+	}
+
+	var recovered []recovery.Entry
+	if crashed {
+		report, err := recovery.Recover(ctx, tracer, journal, clientID)
+		if err != nil {
+			// The resources that couldn't be cleaned up are left for the next recovery, the orchestrator can still start.
+			zap.L().Error("failed to recover all resources after the crash", zap.Error(err))
+		}
+
+		recovered = report.Sandboxes
+	}
+
 	networkPool, err := network.NewPool(ctx, tel.MeterProvider, network.NewSlotsPoolSize, network.ReusedSlotsPoolSize, clientID, tracer)
 	if err != nil {
 		zap.L().Fatal("failed to create network pool", zap.Error(err))
@@ -250,12 +272,26 @@ func run(port, proxyPort uint) (success bool) {
 		zap.L().Fatal("failed to create feature flags client", zap.Error(err))
 	}
 
-	sandboxObserver, err := metrics.NewSandboxObserver(ctx, serviceInfo.SourceCommit, serviceInfo.ClientId, sandboxMetricExportPeriod, sandboxes)
+	var clickhouseStore chdb.Store
+	if os.Getenv("WRITE_METRICS_TO_CLICKHOUSE") == "true" {
+		clickhouseStore, err = chdb.NewStore(chdb.ClickHouseConfig{
+			ConnectionString: os.Getenv("CLICKHOUSE_CONNECTION_STRING"),
+			Username:         os.Getenv("CLICKHOUSE_USERNAME"),
+			Password:         os.Getenv("CLICKHOUSE_PASSWORD"),
+			Database:         os.Getenv("CLICKHOUSE_DATABASE"),
+			Debug:            os.Getenv("CLICKHOUSE_DEBUG") == "true",
+		})
+		if err != nil {
+			zap.L().Fatal("failed to create ClickHouse store", zap.Error(err))
+		}
+	}
+
+	sandboxObserver, err := metrics.NewSandboxObserver(ctx, serviceInfo.SourceCommit, serviceInfo.ClientId, sandboxMetricExportPeriod, sandboxes, clickhouseStore)
 	if err != nil {
 		zap.L().Fatal("failed to create sandbox observer", zap.Error(err))
 	}
 
-	_, err = server.New(ctx, grpcSrv, tel, networkPool, devicePool, tracer, serviceInfo, sandboxProxy, sandboxes, featureFlags)
+	orchestratorSrv, err := server.New(ctx, grpcSrv, tel, networkPool, devicePool, tracer, serviceInfo, sandboxProxy, sandboxes, featureFlags, journal, recovered)
 	if err != nil {
 		zap.L().Fatal("failed to create server", zap.Error(err))
 	}
@@ -280,6 +316,7 @@ func run(port, proxyPort uint) (success bool) {
 	var closers []Closeable
 	closers = append(closers,
 		grpcSrv,
+		orchestratorSrv,
 		networkPool,
 		devicePool,
 		sandboxProxy,
@@ -20,7 +20,7 @@ type Store interface {
 	Exec(ctx context.Context, query string, args ...any) error
 
 	// Metrics queries
-	InsertMetrics(ctx context.Context, metrics chmodels.Metrics) error
+	InsertMetrics(ctx context.Context, metrics ...chmodels.Metrics) error
 	QueryMetrics(ctx context.Context, sandboxID, teamID string, start int64, limit int) ([]chmodels.Metrics, error)
 }
 
@@ -7,15 +7,23 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
 )
 
-func (c *ClickHouseStore) InsertMetrics(ctx context.Context, metrics chmodels.Metrics) error {
+// InsertMetrics writes the metrics in a single batch.
+func (c *ClickHouseStore) InsertMetrics(ctx context.Context, metrics ...chmodels.Metrics) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+
 	batch, err := c.Conn.PrepareBatch(ctx, "INSERT INTO metrics")
 	if err != nil {
 		return err
 	}
-	err = batch.AppendStruct(&metrics)
-	if err != nil {
-		batch.Abort()
-		return fmt.Errorf("failed to append metrics struct to clickhouse batcher: %w", err)
+
+	for i := range metrics {
+		err = batch.AppendStruct(&metrics[i])
+		if err != nil {
+			batch.Abort()
+			return fmt.Errorf("failed to append metrics struct to clickhouse batcher: %w", err)
+		}
 	}
 
 	return batch.Send()
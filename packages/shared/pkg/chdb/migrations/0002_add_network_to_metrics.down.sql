@@ -0,0 +1,6 @@
+ALTER TABLE metrics
+	DROP COLUMN IF EXISTS net_rx_bytes,
+	DROP COLUMN IF EXISTS net_tx_bytes,
+	DROP COLUMN IF EXISTS net_rx_packets,
+	DROP COLUMN IF EXISTS net_tx_packets,
+	DROP COLUMN IF EXISTS net_new_connections;
@@ -0,0 +1,6 @@
+ALTER TABLE metrics
+	ADD COLUMN IF NOT EXISTS net_rx_bytes UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_tx_bytes UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_rx_packets UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_tx_packets UInt64 DEFAULT 0,
+	ADD COLUMN IF NOT EXISTS net_new_connections UInt64 DEFAULT 0;
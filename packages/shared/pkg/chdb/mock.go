@@ -26,7 +26,7 @@ func (m *MockStore) Exec(ctx context.Context, query string, args ...any) error {
 	return nil
 }
 
-func (m *MockStore) InsertMetrics(ctx context.Context, metrics chmodels.Metrics) error {
+func (m *MockStore) InsertMetrics(ctx context.Context, metrics ...chmodels.Metrics) error {
 	return nil
 }
 
@@ -1,7 +1,24 @@
 package consts
 
-import "os"
+import (
+	"os"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
 
 const NodeIDLength = 8
 
 var OrchestratorPort = os.Getenv("ORCHESTRATOR_PORT")
+
+// TeamNetworksCIDR is the range the addresses of the sandboxes in the team networks are allocated from, it must be the same on the API and all the nodes.
+var TeamNetworksCIDR = env.GetEnv("TEAM_NETWORKS_CIDR", "10.100.0.0/16")
+
+// BlockedEgressRanges are the private and link-local ranges the sandboxes can't reach, the egress policy can't allow them.
+var BlockedEgressRanges = []string{
+	"10.0.0.0/8",
+	"169.254.0.0/16",
+	"192.168.0.0/16",
+	"172.16.0.0/12",
+	"fc00::/7",
+	"fe80::/10",
+}
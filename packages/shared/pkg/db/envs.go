@@ -37,7 +37,16 @@ type Template struct {
 }
 
 type UpdateEnvInput struct {
-	Public bool
+	Public       *bool
+	WarmPoolSize *int32
+}
+
+type WarmPoolTemplate struct {
+	TemplateID string
+	Size       int32
+	Build      *models.EnvBuild
+	// Tier of the team owning the template, the warm sandboxes boot with its maximum resources.
+	Tier *models.Tier
 }
 
 func (db *DB) DeleteEnv(ctx context.Context, envID string) error {
@@ -55,7 +64,53 @@ func (db *DB) DeleteEnv(ctx context.Context, envID string) error {
 }
 
 func (db *DB) UpdateEnv(ctx context.Context, envID string, input UpdateEnvInput) error {
-	return db.Client.Env.UpdateOneID(envID).SetPublic(input.Public).Exec(ctx)
+	update := db.Client.Env.UpdateOneID(envID)
+
+	if input.Public != nil {
+		update.SetPublic(*input.Public)
+	}
+
+	if input.WarmPoolSize != nil {
+		update.SetWarmPoolSize(*input.WarmPoolSize)
+	}
+
+	return update.Exec(ctx)
+}
+
+// GetWarmPoolEnvs returns the templates with the warm pool enabled together with their latest build.
+// Only the templates running on the nodes managed by the API are returned.
+func (db *DB) GetWarmPoolEnvs(ctx context.Context) ([]*WarmPoolTemplate, error) {
+	envs, err := db.
+		Client.
+		Env.
+		Query().
+		Where(
+			env.WarmPoolSizeGT(0),
+			env.ClusterIDIsNil(),
+			env.HasBuildsWith(envbuild.StatusEQ(envbuild.StatusUploaded)),
+		).
+		WithBuilds(func(query *models.EnvBuildQuery) {
+			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded)).Order(models.Desc(envbuild.FieldFinishedAt))
+		}).
+		WithTeam(func(query *models.TeamQuery) {
+			query.WithTeamTier()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list warm pool envs: %w", err)
+	}
+
+	result := make([]*WarmPoolTemplate, 0, len(envs))
+	for _, item := range envs {
+		result = append(result, &WarmPoolTemplate{
+			TemplateID: item.ID,
+			Size:       item.WarmPoolSize,
+			Build:      item.Edges.Builds[0],
+			Tier:       item.Edges.Team.Edges.TeamTier,
+		})
+	}
+
+	return result, nil
 }
 
 func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID) (result []*Template, err error) {
@@ -74,6 +129,9 @@ func (db *DB) GetEnvs(ctx context.Context, teamID uuid.UUID) (result []*Template
 		WithBuilds(func(query *models.EnvBuildQuery) {
 			query.Where(envbuild.StatusEQ(envbuild.StatusUploaded)).Order(models.Desc(envbuild.FieldFinishedAt))
 		}).
+		WithTeam(func(query *models.TeamQuery) {
+			query.WithTeamTier()
+		}).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list envs: %w", err)
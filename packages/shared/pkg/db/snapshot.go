@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 type SnapshotInfo struct {
@@ -26,6 +27,15 @@ type SnapshotInfo struct {
 	FirecrackerVersion string
 	EnvdVersion        string
 	EnvdSecured        bool
+	// Resources the sandbox was limited to within the VM, nil means the whole VM.
+	VCPULimit  *int64
+	RAMMBLimit *int64
+	// EgressPolicy of the sandbox, nil means no restrictions.
+	EgressPolicy *types.SandboxEgressPolicy
+	// PortAccess of the sandbox, nil means all the ports are public.
+	PortAccess *types.SandboxPortAccess
+	// Network of the sandbox, nil means no team network.
+	Network *types.SandboxNetwork
 }
 
 // Check if there exists snapshot with the ID, if yes then return a new
@@ -74,7 +84,7 @@ func (db *DB) NewSnapshotBuild(
 			return nil, fmt.Errorf("failed to create env '%s': %w", snapshotConfig.SandboxID, err)
 		}
 
-		err = tx.
+		create := tx.
 			Snapshot.
 			Create().
 			SetSandboxID(snapshotConfig.SandboxID).
@@ -83,19 +93,65 @@ func (db *DB) NewSnapshotBuild(
 			SetMetadata(snapshotConfig.Metadata).
 			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
 			SetEnvSecure(snapshotConfig.EnvdSecured).
-			Exec(ctx)
+			SetNillableVcpuLimit(snapshotConfig.VCPULimit).
+			SetNillableRAMMBLimit(snapshotConfig.RAMMBLimit)
+
+		if snapshotConfig.EgressPolicy != nil {
+			create.SetEgressPolicy(snapshotConfig.EgressPolicy)
+		}
+
+		if snapshotConfig.PortAccess != nil {
+			create.SetPortAccess(snapshotConfig.PortAccess)
+		}
+
+		if snapshotConfig.Network != nil {
+			create.SetNetwork(snapshotConfig.Network)
+		}
+
+		err = create.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
 	} else {
 		e = s.Edges.Env
-		// Update existing snapshot with new metadata and pause time
-		err = tx.
+		// Update existing snapshot with new metadata, pause time, resources and egress policy
+		update := tx.
 			Snapshot.
 			UpdateOne(s).
 			SetMetadata(snapshotConfig.Metadata).
-			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
-			Exec(ctx)
+			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt)
+
+		if snapshotConfig.VCPULimit != nil {
+			update.SetVcpuLimit(*snapshotConfig.VCPULimit)
+		} else {
+			update.ClearVcpuLimit()
+		}
+
+		if snapshotConfig.RAMMBLimit != nil {
+			update.SetRAMMBLimit(*snapshotConfig.RAMMBLimit)
+		} else {
+			update.ClearRAMMBLimit()
+		}
+
+		if snapshotConfig.EgressPolicy != nil {
+			update.SetEgressPolicy(snapshotConfig.EgressPolicy)
+		} else {
+			update.ClearEgressPolicy()
+		}
+
+		if snapshotConfig.PortAccess != nil {
+			update.SetPortAccess(snapshotConfig.PortAccess)
+		} else {
+			update.ClearPortAccess()
+		}
+
+		if snapshotConfig.Network != nil {
+			update.SetNetwork(snapshotConfig.Network)
+		} else {
+			update.ClearNetwork()
+		}
+
+		err = update.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to update snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
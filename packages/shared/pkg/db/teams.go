@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+)
+
+// GetTeamTiers returns the current tiers of the teams, keyed by the team ID.
+func (db *DB) GetTeamTiers(ctx context.Context, teamIDs []uuid.UUID) (map[uuid.UUID]*models.Tier, error) {
+	teams, err := db.
+		Client.
+		Team.
+		Query().
+		Where(team.IDIn(teamIDs...)).
+		WithTeamTier().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team tiers: %w", err)
+	}
+
+	tiers := make(map[uuid.UUID]*models.Tier, len(teams))
+	for _, t := range teams {
+		tiers[t.ID] = t.Edges.TeamTier
+	}
+
+	return tiers, nil
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+)
+
+// ErrVolumeAttached is returned when the volume is attached to another sandbox.
+var ErrVolumeAttached = errors.New("volume is attached to another sandbox")
+
+// AttachVolumes marks the volumes as attached to the sandbox, a volume can be attached to only one sandbox at a time.
+// Either all the volumes are attached or none of them, the volumes already attached to the sandbox are kept.
+func (db *DB) AttachVolumes(ctx context.Context, sandboxID string, volumeIDs []uuid.UUID) error {
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	attached, err := tx.
+		Volume.
+		Update().
+		Where(
+			volume.IDIn(volumeIDs...),
+			volume.Or(
+				volume.AttachedSandboxIDIsNil(),
+				volume.AttachedSandboxID(sandboxID),
+			),
+		).
+		SetAttachedSandboxID(sandboxID).
+		SetAttachedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to attach volumes to sandbox '%s': %w", sandboxID, err))
+	}
+
+	if attached != len(volumeIDs) {
+		return rollback(tx, fmt.Errorf("%w: %d of %d volumes are attached elsewhere or deleted", ErrVolumeAttached, len(volumeIDs)-attached, len(volumeIDs)))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DetachVolumes removes the attachments of the volumes to the sandbox, the volumes attached to other sandboxes are kept.
+func (db *DB) DetachVolumes(ctx context.Context, sandboxID string, volumeIDs []uuid.UUID) error {
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+
+	_, err := db.
+		Client.
+		Volume.
+		Update().
+		Where(
+			volume.IDIn(volumeIDs...),
+			volume.AttachedSandboxID(sandboxID),
+		).
+		ClearAttachedSandboxID().
+		ClearAttachedAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detach volumes from sandbox '%s': %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// GetVolumesAttachedBefore returns the volumes attached to a sandbox before the given time.
+func (db *DB) GetVolumesAttachedBefore(ctx context.Context, before time.Time) ([]*models.Volume, error) {
+	volumes, err := db.
+		Client.
+		Volume.
+		Query().
+		Where(volume.AttachedAtLT(before)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attached volumes: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// DeleteVolume deletes the volume record if the volume is not attached to any sandbox, it returns ErrVolumeAttached otherwise.
+// The deleteData function is called while the record is deleted in the transaction, the record is kept if it fails.
+// The deleted row stays locked until the transaction ends, so the volume can't be attached while its data is deleted.
+func (db *DB) DeleteVolume(ctx context.Context, volumeID uuid.UUID, deleteData func(ctx context.Context) error) error {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting a transaction: %w", err)
+	}
+
+	deleted, err := tx.
+		Volume.
+		Delete().
+		Where(
+			volume.ID(volumeID),
+			volume.AttachedSandboxIDIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete volume '%s': %w", volumeID, err))
+	}
+
+	if deleted == 0 {
+		return rollback(tx, fmt.Errorf("%w: %s", ErrVolumeAttached, volumeID))
+	}
+
+	err = deleteData(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete data of volume '%s': %w", volumeID, err))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
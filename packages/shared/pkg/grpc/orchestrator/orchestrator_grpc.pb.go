@@ -30,6 +30,13 @@ type SandboxServiceClient interface {
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
 	Exec(ctx context.Context, in *SandboxExecRequest, opts ...grpc.CallOption) (*SandboxExecResponse, error)
+	DeleteVolume(ctx context.Context, in *VolumeDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error)
+	ReadSnapshotFile(ctx context.Context, in *SnapshotFileRequest, opts ...grpc.CallOption) (*SnapshotFileResponse, error)
+	Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error)
+	ConfigureWarmPool(ctx context.Context, in *WarmPoolConfigureRequest, opts ...grpc.CallOption) (*WarmPoolConfigureResponse, error)
+	ConfigureNetworks(ctx context.Context, in *NetworksConfigureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MigrateFinish(ctx context.Context, in *SandboxMigrateFinishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type sandboxServiceClient struct {
@@ -103,6 +110,69 @@ func (c *sandboxServiceClient) Exec(ctx context.Context, in *SandboxExecRequest,
 	return out, nil
 }
 
+func (c *sandboxServiceClient) DeleteVolume(ctx context.Context, in *VolumeDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Migrate(ctx context.Context, in *SandboxMigrateRequest, opts ...grpc.CallOption) (*SandboxMigrateResponse, error) {
+	out := new(SandboxMigrateResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ReadSnapshotFile(ctx context.Context, in *SnapshotFileRequest, opts ...grpc.CallOption) (*SnapshotFileResponse, error) {
+	out := new(SnapshotFileResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/ReadSnapshotFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error) {
+	out := new(SandboxForkResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Fork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ConfigureWarmPool(ctx context.Context, in *WarmPoolConfigureRequest, opts ...grpc.CallOption) (*WarmPoolConfigureResponse, error) {
+	out := new(WarmPoolConfigureResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/ConfigureWarmPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ConfigureNetworks(ctx context.Context, in *NetworksConfigureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/ConfigureNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) MigrateFinish(ctx context.Context, in *SandboxMigrateFinishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/MigrateFinish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SandboxServiceServer is the server API for SandboxService service.
 // All implementations must embed UnimplementedSandboxServiceServer
 // for forward compatibility
@@ -114,6 +184,13 @@ type SandboxServiceServer interface {
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
 	Exec(context.Context, *SandboxExecRequest) (*SandboxExecResponse, error)
+	DeleteVolume(context.Context, *VolumeDeleteRequest) (*emptypb.Empty, error)
+	Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error)
+	ReadSnapshotFile(context.Context, *SnapshotFileRequest) (*SnapshotFileResponse, error)
+	Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error)
+	ConfigureWarmPool(context.Context, *WarmPoolConfigureRequest) (*WarmPoolConfigureResponse, error)
+	ConfigureNetworks(context.Context, *NetworksConfigureRequest) (*emptypb.Empty, error)
+	MigrateFinish(context.Context, *SandboxMigrateFinishRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
 
@@ -142,6 +219,27 @@ func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *empt
 func (UnimplementedSandboxServiceServer) Exec(context.Context, *SandboxExecRequest) (*SandboxExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedSandboxServiceServer) DeleteVolume(context.Context, *VolumeDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (UnimplementedSandboxServiceServer) Migrate(context.Context, *SandboxMigrateRequest) (*SandboxMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedSandboxServiceServer) ReadSnapshotFile(context.Context, *SnapshotFileRequest) (*SnapshotFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSnapshotFile not implemented")
+}
+func (UnimplementedSandboxServiceServer) Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fork not implemented")
+}
+func (UnimplementedSandboxServiceServer) ConfigureWarmPool(context.Context, *WarmPoolConfigureRequest) (*WarmPoolConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureWarmPool not implemented")
+}
+func (UnimplementedSandboxServiceServer) ConfigureNetworks(context.Context, *NetworksConfigureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureNetworks not implemented")
+}
+func (UnimplementedSandboxServiceServer) MigrateFinish(context.Context, *SandboxMigrateFinishRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateFinish not implemented")
+}
 func (UnimplementedSandboxServiceServer) mustEmbedUnimplementedSandboxServiceServer() {}
 
 // UnsafeSandboxServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -281,6 +379,132 @@ func _SandboxService_Exec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).DeleteVolume(ctx, req.(*VolumeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Migrate(ctx, req.(*SandboxMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ReadSnapshotFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ReadSnapshotFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/ReadSnapshotFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ReadSnapshotFile(ctx, req.(*SnapshotFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Fork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Fork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Fork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Fork(ctx, req.(*SandboxForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ConfigureWarmPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmPoolConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ConfigureWarmPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/ConfigureWarmPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ConfigureWarmPool(ctx, req.(*WarmPoolConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ConfigureNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworksConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ConfigureNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/ConfigureNetworks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ConfigureNetworks(ctx, req.(*NetworksConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_MigrateFinish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxMigrateFinishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).MigrateFinish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/MigrateFinish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).MigrateFinish(ctx, req.(*SandboxMigrateFinishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SandboxService_ServiceDesc is the grpc.ServiceDesc for SandboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -316,6 +540,34 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _SandboxService_Exec_Handler,
 		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _SandboxService_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _SandboxService_Migrate_Handler,
+		},
+		{
+			MethodName: "ReadSnapshotFile",
+			Handler:    _SandboxService_ReadSnapshotFile_Handler,
+		},
+		{
+			MethodName: "Fork",
+			Handler:    _SandboxService_Fork_Handler,
+		},
+		{
+			MethodName: "ConfigureWarmPool",
+			Handler:    _SandboxService_ConfigureWarmPool_Handler,
+		},
+		{
+			MethodName: "ConfigureNetworks",
+			Handler:    _SandboxService_ConfigureNetworks_Handler,
+		},
+		{
+			MethodName: "MigrateFinish",
+			Handler:    _SandboxService_MigrateFinish_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orchestrator.proto",
@@ -10,4 +10,10 @@ type Metrics struct {
 	CPUUsedPercent float32   `ch:"cpu_used_pct"`
 	MemTotalMiB    uint64    `ch:"mem_total_mib"`
 	MemUsedMiB     uint64    `ch:"mem_used_mib"`
+	// Internet traffic of the sandbox since it was started, Rx is received and Tx is sent by the sandbox.
+	NetRxBytes        uint64 `ch:"net_rx_bytes"`
+	NetTxBytes        uint64 `ch:"net_tx_bytes"`
+	NetRxPackets      uint64 `ch:"net_rx_packets"`
+	NetTxPackets      uint64 `ch:"net_tx_packets"`
+	NetNewConnections uint64 `ch:"net_new_connections"`
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -94,6 +95,7 @@ func checkColumn(table, column string) error {
 			tier.Table:        tier.ValidColumn,
 			user.Table:        user.ValidColumn,
 			usersteams.Table:  usersteams.ValidColumn,
+			volume.Table:      volume.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -38,6 +38,8 @@ type Env struct {
 	LastSpawnedAt time.Time `json:"last_spawned_at,omitempty"`
 	// ClusterID holds the value of the "cluster_id" field.
 	ClusterID *uuid.UUID `json:"cluster_id,omitempty"`
+	// Number of sandboxes kept booted on each node to be handed out on create
+	WarmPoolSize int32 `json:"warm_pool_size,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvQuery when eager-loading is set.
 	Edges        EnvEdges `json:"edges"`
@@ -123,7 +125,7 @@ func (*Env) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case env.FieldPublic:
 			values[i] = new(sql.NullBool)
-		case env.FieldBuildCount, env.FieldSpawnCount:
+		case env.FieldBuildCount, env.FieldSpawnCount, env.FieldWarmPoolSize:
 			values[i] = new(sql.NullInt64)
 		case env.FieldID:
 			values[i] = new(sql.NullString)
@@ -208,6 +210,12 @@ func (e *Env) assignValues(columns []string, values []any) error {
 				e.ClusterID = new(uuid.UUID)
 				*e.ClusterID = *value.S.(*uuid.UUID)
 			}
+		case env.FieldWarmPoolSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field warm_pool_size", values[i])
+			} else if value.Valid {
+				e.WarmPoolSize = int32(value.Int64)
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -299,6 +307,9 @@ func (e *Env) String() string {
 		builder.WriteString("cluster_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("warm_pool_size=")
+	builder.WriteString(fmt.Sprintf("%v", e.WarmPoolSize))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldLastSpawnedAt = "last_spawned_at"
 	// FieldClusterID holds the string denoting the cluster_id field in the database.
 	FieldClusterID = "cluster_id"
+	// FieldWarmPoolSize holds the string denoting the warm_pool_size field in the database.
+	FieldWarmPoolSize = "warm_pool_size"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
@@ -95,6 +97,7 @@ var Columns = []string{
 	FieldSpawnCount,
 	FieldLastSpawnedAt,
 	FieldClusterID,
+	FieldWarmPoolSize,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -116,6 +119,8 @@ var (
 	DefaultBuildCount int32
 	// DefaultSpawnCount holds the default value on creation for the "spawn_count" field.
 	DefaultSpawnCount int64
+	// DefaultWarmPoolSize holds the default value on creation for the "warm_pool_size" field.
+	DefaultWarmPoolSize int32
 )
 
 // OrderOption defines the ordering options for the Env queries.
@@ -171,6 +176,11 @@ func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
 }
 
+// ByWarmPoolSize orders the results by the warm_pool_size field.
+func ByWarmPoolSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarmPoolSize, opts...).ToFunc()
+}
+
 // ByTeamField orders the results by team field.
 func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -112,6 +112,11 @@ func ClusterID(v uuid.UUID) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldClusterID, v))
 }
 
+// WarmPoolSize applies equality check predicate on the "warm_pool_size" field. It's identical to WarmPoolSizeEQ.
+func WarmPoolSize(v int32) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldWarmPoolSize, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldCreatedAt, v))
@@ -432,6 +437,46 @@ func ClusterIDNotNil() predicate.Env {
 	return predicate.Env(sql.FieldNotNull(FieldClusterID))
 }
 
+// WarmPoolSizeEQ applies the EQ predicate on the "warm_pool_size" field.
+func WarmPoolSizeEQ(v int32) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldWarmPoolSize, v))
+}
+
+// WarmPoolSizeNEQ applies the NEQ predicate on the "warm_pool_size" field.
+func WarmPoolSizeNEQ(v int32) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldWarmPoolSize, v))
+}
+
+// WarmPoolSizeIn applies the In predicate on the "warm_pool_size" field.
+func WarmPoolSizeIn(vs ...int32) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldWarmPoolSize, vs...))
+}
+
+// WarmPoolSizeNotIn applies the NotIn predicate on the "warm_pool_size" field.
+func WarmPoolSizeNotIn(vs ...int32) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldWarmPoolSize, vs...))
+}
+
+// WarmPoolSizeGT applies the GT predicate on the "warm_pool_size" field.
+func WarmPoolSizeGT(v int32) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldWarmPoolSize, v))
+}
+
+// WarmPoolSizeGTE applies the GTE predicate on the "warm_pool_size" field.
+func WarmPoolSizeGTE(v int32) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldWarmPoolSize, v))
+}
+
+// WarmPoolSizeLT applies the LT predicate on the "warm_pool_size" field.
+func WarmPoolSizeLT(v int32) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldWarmPoolSize, v))
+}
+
+// WarmPoolSizeLTE applies the LTE predicate on the "warm_pool_size" field.
+func WarmPoolSizeLTE(v int32) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldWarmPoolSize, v))
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.Env {
 	return predicate.Env(func(s *sql.Selector) {
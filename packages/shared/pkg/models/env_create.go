@@ -139,6 +139,20 @@ func (ec *EnvCreate) SetNillableClusterID(u *uuid.UUID) *EnvCreate {
 	return ec
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (ec *EnvCreate) SetWarmPoolSize(i int32) *EnvCreate {
+	ec.mutation.SetWarmPoolSize(i)
+	return ec
+}
+
+// SetNillableWarmPoolSize sets the "warm_pool_size" field if the given value is not nil.
+func (ec *EnvCreate) SetNillableWarmPoolSize(i *int32) *EnvCreate {
+	if i != nil {
+		ec.SetWarmPoolSize(*i)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EnvCreate) SetID(s string) *EnvCreate {
 	ec.mutation.SetID(s)
@@ -265,6 +279,10 @@ func (ec *EnvCreate) defaults() {
 		v := env.DefaultSpawnCount
 		ec.mutation.SetSpawnCount(v)
 	}
+	if _, ok := ec.mutation.WarmPoolSize(); !ok {
+		v := env.DefaultWarmPoolSize
+		ec.mutation.SetWarmPoolSize(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -287,6 +305,9 @@ func (ec *EnvCreate) check() error {
 	if _, ok := ec.mutation.SpawnCount(); !ok {
 		return &ValidationError{Name: "spawn_count", err: errors.New(`models: missing required field "Env.spawn_count"`)}
 	}
+	if _, ok := ec.mutation.WarmPoolSize(); !ok {
+		return &ValidationError{Name: "warm_pool_size", err: errors.New(`models: missing required field "Env.warm_pool_size"`)}
+	}
 	if _, ok := ec.mutation.TeamID(); !ok {
 		return &ValidationError{Name: "team", err: errors.New(`models: missing required edge "Env.team"`)}
 	}
@@ -355,6 +376,10 @@ func (ec *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		_spec.SetField(env.FieldClusterID, field.TypeUUID, value)
 		_node.ClusterID = &value
 	}
+	if value, ok := ec.mutation.WarmPoolSize(); ok {
+		_spec.SetField(env.FieldWarmPoolSize, field.TypeInt32, value)
+		_node.WarmPoolSize = value
+	}
 	if nodes := ec.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -620,6 +645,24 @@ func (u *EnvUpsert) ClearClusterID() *EnvUpsert {
 	return u
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (u *EnvUpsert) SetWarmPoolSize(v int32) *EnvUpsert {
+	u.Set(env.FieldWarmPoolSize, v)
+	return u
+}
+
+// UpdateWarmPoolSize sets the "warm_pool_size" field to the value that was provided on create.
+func (u *EnvUpsert) UpdateWarmPoolSize() *EnvUpsert {
+	u.SetExcluded(env.FieldWarmPoolSize)
+	return u
+}
+
+// AddWarmPoolSize adds v to the "warm_pool_size" field.
+func (u *EnvUpsert) AddWarmPoolSize(v int32) *EnvUpsert {
+	u.Add(env.FieldWarmPoolSize, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -818,6 +861,27 @@ func (u *EnvUpsertOne) ClearClusterID() *EnvUpsertOne {
 	})
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (u *EnvUpsertOne) SetWarmPoolSize(v int32) *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetWarmPoolSize(v)
+	})
+}
+
+// AddWarmPoolSize adds v to the "warm_pool_size" field.
+func (u *EnvUpsertOne) AddWarmPoolSize(v int32) *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.AddWarmPoolSize(v)
+	})
+}
+
+// UpdateWarmPoolSize sets the "warm_pool_size" field to the value that was provided on create.
+func (u *EnvUpsertOne) UpdateWarmPoolSize() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateWarmPoolSize()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1183,6 +1247,27 @@ func (u *EnvUpsertBulk) ClearClusterID() *EnvUpsertBulk {
 	})
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (u *EnvUpsertBulk) SetWarmPoolSize(v int32) *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetWarmPoolSize(v)
+	})
+}
+
+// AddWarmPoolSize adds v to the "warm_pool_size" field.
+func (u *EnvUpsertBulk) AddWarmPoolSize(v int32) *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.AddWarmPoolSize(v)
+	})
+}
+
+// UpdateWarmPoolSize sets the "warm_pool_size" field to the value that was provided on create.
+func (u *EnvUpsertBulk) UpdateWarmPoolSize() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateWarmPoolSize()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -180,6 +180,27 @@ func (eu *EnvUpdate) ClearClusterID() *EnvUpdate {
 	return eu
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (eu *EnvUpdate) SetWarmPoolSize(i int32) *EnvUpdate {
+	eu.mutation.ResetWarmPoolSize()
+	eu.mutation.SetWarmPoolSize(i)
+	return eu
+}
+
+// SetNillableWarmPoolSize sets the "warm_pool_size" field if the given value is not nil.
+func (eu *EnvUpdate) SetNillableWarmPoolSize(i *int32) *EnvUpdate {
+	if i != nil {
+		eu.SetWarmPoolSize(*i)
+	}
+	return eu
+}
+
+// AddWarmPoolSize adds i to the "warm_pool_size" field.
+func (eu *EnvUpdate) AddWarmPoolSize(i int32) *EnvUpdate {
+	eu.mutation.AddWarmPoolSize(i)
+	return eu
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (eu *EnvUpdate) SetTeam(t *Team) *EnvUpdate {
 	return eu.SetTeamID(t.ID)
@@ -412,6 +433,12 @@ func (eu *EnvUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.ClusterIDCleared() {
 		_spec.ClearField(env.FieldClusterID, field.TypeUUID)
 	}
+	if value, ok := eu.mutation.WarmPoolSize(); ok {
+		_spec.SetField(env.FieldWarmPoolSize, field.TypeInt32, value)
+	}
+	if value, ok := eu.mutation.AddedWarmPoolSize(); ok {
+		_spec.AddField(env.FieldWarmPoolSize, field.TypeInt32, value)
+	}
 	if eu.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -786,6 +813,27 @@ func (euo *EnvUpdateOne) ClearClusterID() *EnvUpdateOne {
 	return euo
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (euo *EnvUpdateOne) SetWarmPoolSize(i int32) *EnvUpdateOne {
+	euo.mutation.ResetWarmPoolSize()
+	euo.mutation.SetWarmPoolSize(i)
+	return euo
+}
+
+// SetNillableWarmPoolSize sets the "warm_pool_size" field if the given value is not nil.
+func (euo *EnvUpdateOne) SetNillableWarmPoolSize(i *int32) *EnvUpdateOne {
+	if i != nil {
+		euo.SetWarmPoolSize(*i)
+	}
+	return euo
+}
+
+// AddWarmPoolSize adds i to the "warm_pool_size" field.
+func (euo *EnvUpdateOne) AddWarmPoolSize(i int32) *EnvUpdateOne {
+	euo.mutation.AddWarmPoolSize(i)
+	return euo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (euo *EnvUpdateOne) SetTeam(t *Team) *EnvUpdateOne {
 	return euo.SetTeamID(t.ID)
@@ -1048,6 +1096,12 @@ func (euo *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 	if euo.mutation.ClusterIDCleared() {
 		_spec.ClearField(env.FieldClusterID, field.TypeUUID)
 	}
+	if value, ok := euo.mutation.WarmPoolSize(); ok {
+		_spec.SetField(env.FieldWarmPoolSize, field.TypeInt32, value)
+	}
+	if value, ok := euo.mutation.AddedWarmPoolSize(); ok {
+		_spec.AddField(env.FieldWarmPoolSize, field.TypeInt32, value)
+	}
 	if euo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
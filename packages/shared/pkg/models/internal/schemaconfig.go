@@ -18,6 +18,7 @@ type SchemaConfig struct {
 	Tier        string // Tier table.
 	User        string // User table.
 	UsersTeams  string // UsersTeams table.
+	Volume      string // Volume table.
 }
 
 type schemaCtxKey struct{}
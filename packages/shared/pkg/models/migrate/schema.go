@@ -59,6 +59,7 @@ var (
 		{Name: "spawn_count", Type: field.TypeInt64, Comment: "Number of times the env was spawned", Default: 0},
 		{Name: "last_spawned_at", Type: field.TypeTime, Nullable: true, Comment: "Timestamp of the last time the env was spawned"},
 		{Name: "cluster_id", Type: field.TypeUUID, Nullable: true, SchemaType: map[string]string{"postgres": "uuid"}},
+		{Name: "warm_pool_size", Type: field.TypeInt32, Comment: "Number of sandboxes kept booted on each node to be handed out on create", Default: 0},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -70,13 +71,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "envs_teams_envs",
-				Columns:    []*schema.Column{EnvsColumns[8]},
+				Columns:    []*schema.Column{EnvsColumns[9]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "envs_users_created_envs",
-				Columns:    []*schema.Column{EnvsColumns[9]},
+				Columns:    []*schema.Column{EnvsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -145,6 +146,11 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "sandbox_started_at", Type: field.TypeTime},
 		{Name: "env_secure", Type: field.TypeBool, Default: false},
+		{Name: "vcpu_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "ram_mb_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "egress_policy", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "port_access", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "network", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// SnapshotsTable holds the schema information for the "snapshots" table.
@@ -155,7 +161,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "snapshots_envs_snapshots",
-				Columns:    []*schema.Column{SnapshotsColumns[7]},
+				Columns:    []*schema.Column{SnapshotsColumns[12]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -230,6 +236,13 @@ var (
 		{Name: "disk_mb", Type: field.TypeInt64, Default: "512"},
 		{Name: "concurrent_instances", Type: field.TypeInt64, Comment: "The number of instances the team can run concurrently"},
 		{Name: "max_length_hours", Type: field.TypeInt64},
+		{Name: "max_vcpu", Type: field.TypeInt64, Default: "8"},
+		{Name: "max_ram_mb", Type: field.TypeInt64, Default: "8096"},
+		{Name: "disk_bandwidth_mb_per_sec", Type: field.TypeInt64, Nullable: true, Comment: "The disk bandwidth limit of a sandbox, unlimited when not set"},
+		{Name: "disk_iops", Type: field.TypeInt64, Nullable: true, Comment: "The disk operations per second limit of a sandbox, unlimited when not set"},
+		{Name: "network_bandwidth_mb_per_sec", Type: field.TypeInt64, Nullable: true, Comment: "The network bandwidth limit of a sandbox in each direction, unlimited when not set"},
+		{Name: "network_packets_per_sec", Type: field.TypeInt64, Nullable: true, Comment: "The network packets per second limit of a sandbox in each direction, unlimited when not set"},
+		{Name: "max_volume_size_mb", Type: field.TypeInt64, Comment: "The maximum size of a volume created by the team", Default: "10240"},
 	}
 	// TiersTable holds the schema information for the "tiers" table.
 	TiersTable = &schema.Table{
@@ -282,6 +295,37 @@ var (
 			},
 		},
 	}
+	// VolumesColumns holds the columns for the "volumes" table.
+	VolumesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "size_mb", Type: field.TypeInt64},
+		{Name: "attached_sandbox_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "attached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "team_id", Type: field.TypeUUID},
+	}
+	// VolumesTable holds the schema information for the "volumes" table.
+	VolumesTable = &schema.Table{
+		Name:       "volumes",
+		Columns:    VolumesColumns,
+		PrimaryKey: []*schema.Column{VolumesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "volumes_teams_volumes",
+				Columns:    []*schema.Column{VolumesColumns[6]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "volume_team_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{VolumesColumns[6], VolumesColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccessTokensTable,
@@ -295,6 +339,7 @@ var (
 		TiersTable,
 		UsersTable,
 		UsersTeamsTable,
+		VolumesTable,
 	}
 )
 
@@ -327,4 +372,6 @@ func init() {
 	UsersTeamsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTeamsTable.ForeignKeys[1].RefTable = TeamsTable
 	UsersTeamsTable.Annotation = &entsql.Annotation{}
+	VolumesTable.ForeignKeys[0].RefTable = TeamsTable
+	VolumesTable.Annotation = &entsql.Annotation{}
 }
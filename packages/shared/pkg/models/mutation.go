@@ -23,6 +23,8 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -46,6 +48,7 @@ const (
 	TypeTier        = "Tier"
 	TypeUser        = "User"
 	TypeUsersTeams  = "UsersTeams"
+	TypeVolume      = "Volume"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -1379,6 +1382,8 @@ type EnvMutation struct {
 	addspawn_count     *int64
 	last_spawned_at    *time.Time
 	cluster_id         *uuid.UUID
+	warm_pool_size     *int32
+	addwarm_pool_size  *int32
 	clearedFields      map[string]struct{}
 	team               *uuid.UUID
 	clearedteam        bool
@@ -1905,6 +1910,62 @@ func (m *EnvMutation) ResetClusterID() {
 	delete(m.clearedFields, env.FieldClusterID)
 }
 
+// SetWarmPoolSize sets the "warm_pool_size" field.
+func (m *EnvMutation) SetWarmPoolSize(i int32) {
+	m.warm_pool_size = &i
+	m.addwarm_pool_size = nil
+}
+
+// WarmPoolSize returns the value of the "warm_pool_size" field in the mutation.
+func (m *EnvMutation) WarmPoolSize() (r int32, exists bool) {
+	v := m.warm_pool_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWarmPoolSize returns the old "warm_pool_size" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvMutation) OldWarmPoolSize(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarmPoolSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarmPoolSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarmPoolSize: %w", err)
+	}
+	return oldValue.WarmPoolSize, nil
+}
+
+// AddWarmPoolSize adds i to the "warm_pool_size" field.
+func (m *EnvMutation) AddWarmPoolSize(i int32) {
+	if m.addwarm_pool_size != nil {
+		*m.addwarm_pool_size += i
+	} else {
+		m.addwarm_pool_size = &i
+	}
+}
+
+// AddedWarmPoolSize returns the value that was added to the "warm_pool_size" field in this mutation.
+func (m *EnvMutation) AddedWarmPoolSize() (r int32, exists bool) {
+	v := m.addwarm_pool_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWarmPoolSize resets all changes to the "warm_pool_size" field.
+func (m *EnvMutation) ResetWarmPoolSize() {
+	m.warm_pool_size = nil
+	m.addwarm_pool_size = nil
+}
+
 // ClearTeam clears the "team" edge to the Team entity.
 func (m *EnvMutation) ClearTeam() {
 	m.clearedteam = true
@@ -2168,7 +2229,7 @@ func (m *EnvMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
 		fields = append(fields, env.FieldCreatedAt)
 	}
@@ -2196,6 +2257,9 @@ func (m *EnvMutation) Fields() []string {
 	if m.cluster_id != nil {
 		fields = append(fields, env.FieldClusterID)
 	}
+	if m.warm_pool_size != nil {
+		fields = append(fields, env.FieldWarmPoolSize)
+	}
 	return fields
 }
 
@@ -2222,6 +2286,8 @@ func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 		return m.LastSpawnedAt()
 	case env.FieldClusterID:
 		return m.ClusterID()
+	case env.FieldWarmPoolSize:
+		return m.WarmPoolSize()
 	}
 	return nil, false
 }
@@ -2249,6 +2315,8 @@ func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldLastSpawnedAt(ctx)
 	case env.FieldClusterID:
 		return m.OldClusterID(ctx)
+	case env.FieldWarmPoolSize:
+		return m.OldWarmPoolSize(ctx)
 	}
 	return nil, fmt.Errorf("unknown Env field %s", name)
 }
@@ -2321,6 +2389,13 @@ func (m *EnvMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetClusterID(v)
 		return nil
+	case env.FieldWarmPoolSize:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarmPoolSize(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Env field %s", name)
 }
@@ -2335,6 +2410,9 @@ func (m *EnvMutation) AddedFields() []string {
 	if m.addspawn_count != nil {
 		fields = append(fields, env.FieldSpawnCount)
 	}
+	if m.addwarm_pool_size != nil {
+		fields = append(fields, env.FieldWarmPoolSize)
+	}
 	return fields
 }
 
@@ -2347,6 +2425,8 @@ func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedBuildCount()
 	case env.FieldSpawnCount:
 		return m.AddedSpawnCount()
+	case env.FieldWarmPoolSize:
+		return m.AddedWarmPoolSize()
 	}
 	return nil, false
 }
@@ -2370,6 +2450,13 @@ func (m *EnvMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddSpawnCount(v)
 		return nil
+	case env.FieldWarmPoolSize:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWarmPoolSize(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Env numeric field %s", name)
 }
@@ -2445,6 +2532,9 @@ func (m *EnvMutation) ResetField(name string) error {
 	case env.FieldClusterID:
 		m.ResetClusterID()
 		return nil
+	case env.FieldWarmPoolSize:
+		m.ResetWarmPoolSize()
+		return nil
 	}
 	return fmt.Errorf("unknown Env field %s", name)
 }
@@ -4560,6 +4650,13 @@ type SnapshotMutation struct {
 	metadata           *map[string]string
 	sandbox_started_at *time.Time
 	env_secure         *bool
+	vcpu_limit         *int64
+	addvcpu_limit      *int64
+	ram_mb_limit       *int64
+	addram_mb_limit    *int64
+	egress_policy      **types.SandboxEgressPolicy
+	port_access        **types.SandboxPortAccess
+	network            **types.SandboxNetwork
 	clearedFields      map[string]struct{}
 	env                *string
 	clearedenv         bool
@@ -4924,6 +5021,293 @@ func (m *SnapshotMutation) ResetEnvSecure() {
 	m.env_secure = nil
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (m *SnapshotMutation) SetVcpuLimit(i int64) {
+	m.vcpu_limit = &i
+	m.addvcpu_limit = nil
+}
+
+// VcpuLimit returns the value of the "vcpu_limit" field in the mutation.
+func (m *SnapshotMutation) VcpuLimit() (r int64, exists bool) {
+	v := m.vcpu_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVcpuLimit returns the old "vcpu_limit" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldVcpuLimit(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVcpuLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVcpuLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVcpuLimit: %w", err)
+	}
+	return oldValue.VcpuLimit, nil
+}
+
+// AddVcpuLimit adds i to the "vcpu_limit" field.
+func (m *SnapshotMutation) AddVcpuLimit(i int64) {
+	if m.addvcpu_limit != nil {
+		*m.addvcpu_limit += i
+	} else {
+		m.addvcpu_limit = &i
+	}
+}
+
+// AddedVcpuLimit returns the value that was added to the "vcpu_limit" field in this mutation.
+func (m *SnapshotMutation) AddedVcpuLimit() (r int64, exists bool) {
+	v := m.addvcpu_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (m *SnapshotMutation) ClearVcpuLimit() {
+	m.vcpu_limit = nil
+	m.addvcpu_limit = nil
+	m.clearedFields[snapshot.FieldVcpuLimit] = struct{}{}
+}
+
+// VcpuLimitCleared returns if the "vcpu_limit" field was cleared in this mutation.
+func (m *SnapshotMutation) VcpuLimitCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldVcpuLimit]
+	return ok
+}
+
+// ResetVcpuLimit resets all changes to the "vcpu_limit" field.
+func (m *SnapshotMutation) ResetVcpuLimit() {
+	m.vcpu_limit = nil
+	m.addvcpu_limit = nil
+	delete(m.clearedFields, snapshot.FieldVcpuLimit)
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (m *SnapshotMutation) SetRAMMBLimit(i int64) {
+	m.ram_mb_limit = &i
+	m.addram_mb_limit = nil
+}
+
+// RAMMBLimit returns the value of the "ram_mb_limit" field in the mutation.
+func (m *SnapshotMutation) RAMMBLimit() (r int64, exists bool) {
+	v := m.ram_mb_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRAMMBLimit returns the old "ram_mb_limit" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldRAMMBLimit(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMMBLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMMBLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMMBLimit: %w", err)
+	}
+	return oldValue.RAMMBLimit, nil
+}
+
+// AddRAMMBLimit adds i to the "ram_mb_limit" field.
+func (m *SnapshotMutation) AddRAMMBLimit(i int64) {
+	if m.addram_mb_limit != nil {
+		*m.addram_mb_limit += i
+	} else {
+		m.addram_mb_limit = &i
+	}
+}
+
+// AddedRAMMBLimit returns the value that was added to the "ram_mb_limit" field in this mutation.
+func (m *SnapshotMutation) AddedRAMMBLimit() (r int64, exists bool) {
+	v := m.addram_mb_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (m *SnapshotMutation) ClearRAMMBLimit() {
+	m.ram_mb_limit = nil
+	m.addram_mb_limit = nil
+	m.clearedFields[snapshot.FieldRAMMBLimit] = struct{}{}
+}
+
+// RAMMBLimitCleared returns if the "ram_mb_limit" field was cleared in this mutation.
+func (m *SnapshotMutation) RAMMBLimitCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldRAMMBLimit]
+	return ok
+}
+
+// ResetRAMMBLimit resets all changes to the "ram_mb_limit" field.
+func (m *SnapshotMutation) ResetRAMMBLimit() {
+	m.ram_mb_limit = nil
+	m.addram_mb_limit = nil
+	delete(m.clearedFields, snapshot.FieldRAMMBLimit)
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (m *SnapshotMutation) SetEgressPolicy(tep *types.SandboxEgressPolicy) {
+	m.egress_policy = &tep
+}
+
+// EgressPolicy returns the value of the "egress_policy" field in the mutation.
+func (m *SnapshotMutation) EgressPolicy() (r *types.SandboxEgressPolicy, exists bool) {
+	v := m.egress_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEgressPolicy returns the old "egress_policy" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEgressPolicy(ctx context.Context) (v *types.SandboxEgressPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEgressPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEgressPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEgressPolicy: %w", err)
+	}
+	return oldValue.EgressPolicy, nil
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (m *SnapshotMutation) ClearEgressPolicy() {
+	m.egress_policy = nil
+	m.clearedFields[snapshot.FieldEgressPolicy] = struct{}{}
+}
+
+// EgressPolicyCleared returns if the "egress_policy" field was cleared in this mutation.
+func (m *SnapshotMutation) EgressPolicyCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldEgressPolicy]
+	return ok
+}
+
+// ResetEgressPolicy resets all changes to the "egress_policy" field.
+func (m *SnapshotMutation) ResetEgressPolicy() {
+	m.egress_policy = nil
+	delete(m.clearedFields, snapshot.FieldEgressPolicy)
+}
+
+// SetPortAccess sets the "port_access" field.
+func (m *SnapshotMutation) SetPortAccess(tpa *types.SandboxPortAccess) {
+	m.port_access = &tpa
+}
+
+// PortAccess returns the value of the "port_access" field in the mutation.
+func (m *SnapshotMutation) PortAccess() (r *types.SandboxPortAccess, exists bool) {
+	v := m.port_access
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortAccess returns the old "port_access" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldPortAccess(ctx context.Context) (v *types.SandboxPortAccess, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortAccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortAccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortAccess: %w", err)
+	}
+	return oldValue.PortAccess, nil
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (m *SnapshotMutation) ClearPortAccess() {
+	m.port_access = nil
+	m.clearedFields[snapshot.FieldPortAccess] = struct{}{}
+}
+
+// PortAccessCleared returns if the "port_access" field was cleared in this mutation.
+func (m *SnapshotMutation) PortAccessCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldPortAccess]
+	return ok
+}
+
+// ResetPortAccess resets all changes to the "port_access" field.
+func (m *SnapshotMutation) ResetPortAccess() {
+	m.port_access = nil
+	delete(m.clearedFields, snapshot.FieldPortAccess)
+}
+
+// SetNetwork sets the "network" field.
+func (m *SnapshotMutation) SetNetwork(tn *types.SandboxNetwork) {
+	m.network = &tn
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *SnapshotMutation) Network() (r *types.SandboxNetwork, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldNetwork(ctx context.Context) (v *types.SandboxNetwork, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (m *SnapshotMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[snapshot.FieldNetwork] = struct{}{}
+}
+
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *SnapshotMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldNetwork]
+	return ok
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *SnapshotMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, snapshot.FieldNetwork)
+}
+
 // ClearEnv clears the "env" edge to the Env entity.
 func (m *SnapshotMutation) ClearEnv() {
 	m.clearedenv = true
@@ -4985,7 +5369,7 @@ func (m *SnapshotMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
 		fields = append(fields, snapshot.FieldCreatedAt)
 	}
@@ -5007,6 +5391,21 @@ func (m *SnapshotMutation) Fields() []string {
 	if m.env_secure != nil {
 		fields = append(fields, snapshot.FieldEnvSecure)
 	}
+	if m.vcpu_limit != nil {
+		fields = append(fields, snapshot.FieldVcpuLimit)
+	}
+	if m.ram_mb_limit != nil {
+		fields = append(fields, snapshot.FieldRAMMBLimit)
+	}
+	if m.egress_policy != nil {
+		fields = append(fields, snapshot.FieldEgressPolicy)
+	}
+	if m.port_access != nil {
+		fields = append(fields, snapshot.FieldPortAccess)
+	}
+	if m.network != nil {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
 	return fields
 }
 
@@ -5029,6 +5428,16 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 		return m.SandboxStartedAt()
 	case snapshot.FieldEnvSecure:
 		return m.EnvSecure()
+	case snapshot.FieldVcpuLimit:
+		return m.VcpuLimit()
+	case snapshot.FieldRAMMBLimit:
+		return m.RAMMBLimit()
+	case snapshot.FieldEgressPolicy:
+		return m.EgressPolicy()
+	case snapshot.FieldPortAccess:
+		return m.PortAccess()
+	case snapshot.FieldNetwork:
+		return m.Network()
 	}
 	return nil, false
 }
@@ -5052,6 +5461,16 @@ func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldSandboxStartedAt(ctx)
 	case snapshot.FieldEnvSecure:
 		return m.OldEnvSecure(ctx)
+	case snapshot.FieldVcpuLimit:
+		return m.OldVcpuLimit(ctx)
+	case snapshot.FieldRAMMBLimit:
+		return m.OldRAMMBLimit(ctx)
+	case snapshot.FieldEgressPolicy:
+		return m.OldEgressPolicy(ctx)
+	case snapshot.FieldPortAccess:
+		return m.OldPortAccess(ctx)
+	case snapshot.FieldNetwork:
+		return m.OldNetwork(ctx)
 	}
 	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5110,6 +5529,41 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEnvSecure(v)
 		return nil
+	case snapshot.FieldVcpuLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpuLimit(v)
+		return nil
+	case snapshot.FieldRAMMBLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMMBLimit(v)
+		return nil
+	case snapshot.FieldEgressPolicy:
+		v, ok := value.(*types.SandboxEgressPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEgressPolicy(v)
+		return nil
+	case snapshot.FieldPortAccess:
+		v, ok := value.(*types.SandboxPortAccess)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortAccess(v)
+		return nil
+	case snapshot.FieldNetwork:
+		v, ok := value.(*types.SandboxNetwork)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5117,13 +5571,26 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *SnapshotMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addvcpu_limit != nil {
+		fields = append(fields, snapshot.FieldVcpuLimit)
+	}
+	if m.addram_mb_limit != nil {
+		fields = append(fields, snapshot.FieldRAMMBLimit)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldVcpuLimit:
+		return m.AddedVcpuLimit()
+	case snapshot.FieldRAMMBLimit:
+		return m.AddedRAMMBLimit()
+	}
 	return nil, false
 }
 
@@ -5132,6 +5599,20 @@ func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case snapshot.FieldVcpuLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpuLimit(v)
+		return nil
+	case snapshot.FieldRAMMBLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMMBLimit(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot numeric field %s", name)
 }
@@ -5139,7 +5620,23 @@ func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *SnapshotMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(snapshot.FieldVcpuLimit) {
+		fields = append(fields, snapshot.FieldVcpuLimit)
+	}
+	if m.FieldCleared(snapshot.FieldRAMMBLimit) {
+		fields = append(fields, snapshot.FieldRAMMBLimit)
+	}
+	if m.FieldCleared(snapshot.FieldEgressPolicy) {
+		fields = append(fields, snapshot.FieldEgressPolicy)
+	}
+	if m.FieldCleared(snapshot.FieldPortAccess) {
+		fields = append(fields, snapshot.FieldPortAccess)
+	}
+	if m.FieldCleared(snapshot.FieldNetwork) {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -5152,6 +5649,23 @@ func (m *SnapshotMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *SnapshotMutation) ClearField(name string) error {
+	switch name {
+	case snapshot.FieldVcpuLimit:
+		m.ClearVcpuLimit()
+		return nil
+	case snapshot.FieldRAMMBLimit:
+		m.ClearRAMMBLimit()
+		return nil
+	case snapshot.FieldEgressPolicy:
+		m.ClearEgressPolicy()
+		return nil
+	case snapshot.FieldPortAccess:
+		m.ClearPortAccess()
+		return nil
+	case snapshot.FieldNetwork:
+		m.ClearNetwork()
+		return nil
+	}
 	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
 
@@ -5180,7 +5694,22 @@ func (m *SnapshotMutation) ResetField(name string) error {
 	case snapshot.FieldEnvSecure:
 		m.ResetEnvSecure()
 		return nil
-	}
+	case snapshot.FieldVcpuLimit:
+		m.ResetVcpuLimit()
+		return nil
+	case snapshot.FieldRAMMBLimit:
+		m.ResetRAMMBLimit()
+		return nil
+	case snapshot.FieldEgressPolicy:
+		m.ResetEgressPolicy()
+		return nil
+	case snapshot.FieldPortAccess:
+		m.ResetPortAccess()
+		return nil
+	case snapshot.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
@@ -5283,6 +5812,9 @@ type TeamMutation struct {
 	envs                 map[string]struct{}
 	removedenvs          map[string]struct{}
 	clearedenvs          bool
+	volumes              map[uuid.UUID]struct{}
+	removedvolumes       map[uuid.UUID]struct{}
+	clearedvolumes       bool
 	users_teams          map[int]struct{}
 	removedusers_teams   map[int]struct{}
 	clearedusers_teams   bool
@@ -5937,6 +6469,60 @@ func (m *TeamMutation) ResetEnvs() {
 	m.removedenvs = nil
 }
 
+// AddVolumeIDs adds the "volumes" edge to the Volume entity by ids.
+func (m *TeamMutation) AddVolumeIDs(ids ...uuid.UUID) {
+	if m.volumes == nil {
+		m.volumes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.volumes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVolumes clears the "volumes" edge to the Volume entity.
+func (m *TeamMutation) ClearVolumes() {
+	m.clearedvolumes = true
+}
+
+// VolumesCleared reports if the "volumes" edge to the Volume entity was cleared.
+func (m *TeamMutation) VolumesCleared() bool {
+	return m.clearedvolumes
+}
+
+// RemoveVolumeIDs removes the "volumes" edge to the Volume entity by IDs.
+func (m *TeamMutation) RemoveVolumeIDs(ids ...uuid.UUID) {
+	if m.removedvolumes == nil {
+		m.removedvolumes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.volumes, ids[i])
+		m.removedvolumes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVolumes returns the removed IDs of the "volumes" edge to the Volume entity.
+func (m *TeamMutation) RemovedVolumesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvolumes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VolumesIDs returns the "volumes" edge IDs in the mutation.
+func (m *TeamMutation) VolumesIDs() (ids []uuid.UUID) {
+	for id := range m.volumes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVolumes resets all changes to the "volumes" edge.
+func (m *TeamMutation) ResetVolumes() {
+	m.volumes = nil
+	m.clearedvolumes = false
+	m.removedvolumes = nil
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
 func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
 	if m.users_teams == nil {
@@ -6270,7 +6856,7 @@ func (m *TeamMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.users != nil {
 		edges = append(edges, team.EdgeUsers)
 	}
@@ -6283,6 +6869,9 @@ func (m *TeamMutation) AddedEdges() []string {
 	if m.envs != nil {
 		edges = append(edges, team.EdgeEnvs)
 	}
+	if m.volumes != nil {
+		edges = append(edges, team.EdgeVolumes)
+	}
 	if m.users_teams != nil {
 		edges = append(edges, team.EdgeUsersTeams)
 	}
@@ -6315,6 +6904,12 @@ func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case team.EdgeVolumes:
+		ids := make([]ent.Value, 0, len(m.volumes))
+		for id := range m.volumes {
+			ids = append(ids, id)
+		}
+		return ids
 	case team.EdgeUsersTeams:
 		ids := make([]ent.Value, 0, len(m.users_teams))
 		for id := range m.users_teams {
@@ -6327,7 +6922,7 @@ func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.removedusers != nil {
 		edges = append(edges, team.EdgeUsers)
 	}
@@ -6337,6 +6932,9 @@ func (m *TeamMutation) RemovedEdges() []string {
 	if m.removedenvs != nil {
 		edges = append(edges, team.EdgeEnvs)
 	}
+	if m.removedvolumes != nil {
+		edges = append(edges, team.EdgeVolumes)
+	}
 	if m.removedusers_teams != nil {
 		edges = append(edges, team.EdgeUsersTeams)
 	}
@@ -6365,6 +6963,12 @@ func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case team.EdgeVolumes:
+		ids := make([]ent.Value, 0, len(m.removedvolumes))
+		for id := range m.removedvolumes {
+			ids = append(ids, id)
+		}
+		return ids
 	case team.EdgeUsersTeams:
 		ids := make([]ent.Value, 0, len(m.removedusers_teams))
 		for id := range m.removedusers_teams {
@@ -6377,7 +6981,7 @@ func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.clearedusers {
 		edges = append(edges, team.EdgeUsers)
 	}
@@ -6390,6 +6994,9 @@ func (m *TeamMutation) ClearedEdges() []string {
 	if m.clearedenvs {
 		edges = append(edges, team.EdgeEnvs)
 	}
+	if m.clearedvolumes {
+		edges = append(edges, team.EdgeVolumes)
+	}
 	if m.clearedusers_teams {
 		edges = append(edges, team.EdgeUsersTeams)
 	}
@@ -6408,6 +7015,8 @@ func (m *TeamMutation) EdgeCleared(name string) bool {
 		return m.clearedteam_tier
 	case team.EdgeEnvs:
 		return m.clearedenvs
+	case team.EdgeVolumes:
+		return m.clearedvolumes
 	case team.EdgeUsersTeams:
 		return m.clearedusers_teams
 	}
@@ -6441,6 +7050,9 @@ func (m *TeamMutation) ResetEdge(name string) error {
 	case team.EdgeEnvs:
 		m.ResetEnvs()
 		return nil
+	case team.EdgeVolumes:
+		m.ResetVolumes()
+		return nil
 	case team.EdgeUsersTeams:
 		m.ResetUsersTeams()
 		return nil
@@ -7586,23 +8198,37 @@ func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
 // TierMutation represents an operation that mutates the Tier nodes in the graph.
 type TierMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *string
-	name                    *string
-	disk_mb                 *int64
-	adddisk_mb              *int64
-	concurrent_instances    *int64
-	addconcurrent_instances *int64
-	max_length_hours        *int64
-	addmax_length_hours     *int64
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	done                    bool
-	oldValue                func(context.Context) (*Tier, error)
-	predicates              []predicate.Tier
+	op                              Op
+	typ                             string
+	id                              *string
+	name                            *string
+	disk_mb                         *int64
+	adddisk_mb                      *int64
+	concurrent_instances            *int64
+	addconcurrent_instances         *int64
+	max_length_hours                *int64
+	addmax_length_hours             *int64
+	max_vcpu                        *int64
+	addmax_vcpu                     *int64
+	max_ram_mb                      *int64
+	addmax_ram_mb                   *int64
+	disk_bandwidth_mb_per_sec       *int64
+	adddisk_bandwidth_mb_per_sec    *int64
+	disk_iops                       *int64
+	adddisk_iops                    *int64
+	network_bandwidth_mb_per_sec    *int64
+	addnetwork_bandwidth_mb_per_sec *int64
+	network_packets_per_sec         *int64
+	addnetwork_packets_per_sec      *int64
+	max_volume_size_mb              *int64
+	addmax_volume_size_mb           *int64
+	clearedFields                   map[string]struct{}
+	teams                           map[uuid.UUID]struct{}
+	removedteams                    map[uuid.UUID]struct{}
+	clearedteams                    bool
+	done                            bool
+	oldValue                        func(context.Context) (*Tier, error)
+	predicates                      []predicate.Tier
 }
 
 var _ ent.Mutation = (*TierMutation)(nil)
@@ -7913,535 +8539,456 @@ func (m *TierMutation) ResetMaxLengthHours() {
 	m.addmax_length_hours = nil
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *TierMutation) ClearTeams() {
-	m.clearedteams = true
+// SetMaxVcpu sets the "max_vcpu" field.
+func (m *TierMutation) SetMaxVcpu(i int64) {
+	m.max_vcpu = &i
+	m.addmax_vcpu = nil
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *TierMutation) TeamsCleared() bool {
-	return m.clearedteams
+// MaxVcpu returns the value of the "max_vcpu" field in the mutation.
+func (m *TierMutation) MaxVcpu() (r int64, exists bool) {
+	v := m.max_vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
+// OldMaxVcpu returns the old "max_vcpu" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxVcpu(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxVcpu is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxVcpu requires an ID field in the mutation")
 	}
-}
-
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxVcpu: %w", err)
 	}
-	return
+	return oldValue.MaxVcpu, nil
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
-		ids = append(ids, id)
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (m *TierMutation) AddMaxVcpu(i int64) {
+	if m.addmax_vcpu != nil {
+		*m.addmax_vcpu += i
+	} else {
+		m.addmax_vcpu = &i
 	}
-	return
-}
-
-// ResetTeams resets all changes to the "teams" edge.
-func (m *TierMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
-}
-
-// Where appends a list predicates to the TierMutation builder.
-func (m *TierMutation) Where(ps ...predicate.Tier) {
-	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tier, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedMaxVcpu returns the value that was added to the "max_vcpu" field in this mutation.
+func (m *TierMutation) AddedMaxVcpu() (r int64, exists bool) {
+	v := m.addmax_vcpu
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *TierMutation) Op() Op {
-	return m.op
+// ResetMaxVcpu resets all changes to the "max_vcpu" field.
+func (m *TierMutation) ResetMaxVcpu() {
+	m.max_vcpu = nil
+	m.addmax_vcpu = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TierMutation) SetOp(op Op) {
-	m.op = op
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (m *TierMutation) SetMaxRAMMB(i int64) {
+	m.max_ram_mb = &i
+	m.addmax_ram_mb = nil
 }
 
-// Type returns the node type of this mutation (Tier).
-func (m *TierMutation) Type() string {
-	return m.typ
+// MaxRAMMB returns the value of the "max_ram_mb" field in the mutation.
+func (m *TierMutation) MaxRAMMB() (r int64, exists bool) {
+	v := m.max_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TierMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, tier.FieldName)
-	}
-	if m.disk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
+// OldMaxRAMMB returns the old "max_ram_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRAMMB is only allowed on UpdateOne operations")
 	}
-	if m.concurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRAMMB requires an ID field in the mutation")
 	}
-	if m.max_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRAMMB: %w", err)
 	}
-	return fields
+	return oldValue.MaxRAMMB, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TierMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tier.FieldName:
-		return m.Name()
-	case tier.FieldDiskMB:
-		return m.DiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.ConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.MaxLengthHours()
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (m *TierMutation) AddMaxRAMMB(i int64) {
+	if m.addmax_ram_mb != nil {
+		*m.addmax_ram_mb += i
+	} else {
+		m.addmax_ram_mb = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case tier.FieldName:
-		return m.OldName(ctx)
-	case tier.FieldDiskMB:
-		return m.OldDiskMB(ctx)
-	case tier.FieldConcurrentInstances:
-		return m.OldConcurrentInstances(ctx)
-	case tier.FieldMaxLengthHours:
-		return m.OldMaxLengthHours(ctx)
+// AddedMaxRAMMB returns the value that was added to the "max_ram_mb" field in this mutation.
+func (m *TierMutation) AddedMaxRAMMB() (r int64, exists bool) {
+	v := m.addmax_ram_mb
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Tier field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TierMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case tier.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxLengthHours(v)
-		return nil
+// ResetMaxRAMMB resets all changes to the "max_ram_mb" field.
+func (m *TierMutation) ResetMaxRAMMB() {
+	m.max_ram_mb = nil
+	m.addmax_ram_mb = nil
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (m *TierMutation) SetDiskBandwidthMBPerSec(i int64) {
+	m.disk_bandwidth_mb_per_sec = &i
+	m.adddisk_bandwidth_mb_per_sec = nil
+}
+
+// DiskBandwidthMBPerSec returns the value of the "disk_bandwidth_mb_per_sec" field in the mutation.
+func (m *TierMutation) DiskBandwidthMBPerSec() (r int64, exists bool) {
+	v := m.disk_bandwidth_mb_per_sec
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TierMutation) AddedFields() []string {
-	var fields []string
-	if m.adddisk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
+// OldDiskBandwidthMBPerSec returns the old "disk_bandwidth_mb_per_sec" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskBandwidthMBPerSec(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskBandwidthMBPerSec is only allowed on UpdateOne operations")
 	}
-	if m.addconcurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskBandwidthMBPerSec requires an ID field in the mutation")
 	}
-	if m.addmax_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskBandwidthMBPerSec: %w", err)
 	}
-	return fields
+	return oldValue.DiskBandwidthMBPerSec, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tier.FieldDiskMB:
-		return m.AddedDiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.AddedConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.AddedMaxLengthHours()
+// AddDiskBandwidthMBPerSec adds i to the "disk_bandwidth_mb_per_sec" field.
+func (m *TierMutation) AddDiskBandwidthMBPerSec(i int64) {
+	if m.adddisk_bandwidth_mb_per_sec != nil {
+		*m.adddisk_bandwidth_mb_per_sec += i
+	} else {
+		m.adddisk_bandwidth_mb_per_sec = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TierMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxLengthHours(v)
-		return nil
+// AddedDiskBandwidthMBPerSec returns the value that was added to the "disk_bandwidth_mb_per_sec" field in this mutation.
+func (m *TierMutation) AddedDiskBandwidthMBPerSec() (r int64, exists bool) {
+	v := m.adddisk_bandwidth_mb_per_sec
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Tier numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TierMutation) ClearedFields() []string {
-	return nil
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (m *TierMutation) ClearDiskBandwidthMBPerSec() {
+	m.disk_bandwidth_mb_per_sec = nil
+	m.adddisk_bandwidth_mb_per_sec = nil
+	m.clearedFields[tier.FieldDiskBandwidthMBPerSec] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TierMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// DiskBandwidthMBPerSecCleared returns if the "disk_bandwidth_mb_per_sec" field was cleared in this mutation.
+func (m *TierMutation) DiskBandwidthMBPerSecCleared() bool {
+	_, ok := m.clearedFields[tier.FieldDiskBandwidthMBPerSec]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tier nullable field %s", name)
+// ResetDiskBandwidthMBPerSec resets all changes to the "disk_bandwidth_mb_per_sec" field.
+func (m *TierMutation) ResetDiskBandwidthMBPerSec() {
+	m.disk_bandwidth_mb_per_sec = nil
+	m.adddisk_bandwidth_mb_per_sec = nil
+	delete(m.clearedFields, tier.FieldDiskBandwidthMBPerSec)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TierMutation) ResetField(name string) error {
-	switch name {
-	case tier.FieldName:
-		m.ResetName()
-		return nil
-	case tier.FieldDiskMB:
-		m.ResetDiskMB()
-		return nil
-	case tier.FieldConcurrentInstances:
-		m.ResetConcurrentInstances()
-		return nil
-	case tier.FieldMaxLengthHours:
-		m.ResetMaxLengthHours()
-		return nil
-	}
-	return fmt.Errorf("unknown Tier field %s", name)
+// SetDiskIops sets the "disk_iops" field.
+func (m *TierMutation) SetDiskIops(i int64) {
+	m.disk_iops = &i
+	m.adddisk_iops = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.teams != nil {
-		edges = append(edges, tier.EdgeTeams)
+// DiskIops returns the value of the "disk_iops" field in the mutation.
+func (m *TierMutation) DiskIops() (r int64, exists bool) {
+	v := m.disk_iops
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TierMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tier.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
+// OldDiskIops returns the old "disk_iops" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskIops(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskIops is only allowed on UpdateOne operations")
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedteams != nil {
-		edges = append(edges, tier.EdgeTeams)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskIops requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TierMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tier.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskIops: %w", err)
 	}
-	return nil
+	return oldValue.DiskIops, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedteams {
-		edges = append(edges, tier.EdgeTeams)
+// AddDiskIops adds i to the "disk_iops" field.
+func (m *TierMutation) AddDiskIops(i int64) {
+	if m.adddisk_iops != nil {
+		*m.adddisk_iops += i
+	} else {
+		m.adddisk_iops = &i
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TierMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tier.EdgeTeams:
-		return m.clearedteams
+// AddedDiskIops returns the value that was added to the "disk_iops" field in this mutation.
+func (m *TierMutation) AddedDiskIops() (r int64, exists bool) {
+	v := m.adddisk_iops
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TierMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Tier unique edge %s", name)
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (m *TierMutation) ClearDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
+	m.clearedFields[tier.FieldDiskIops] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TierMutation) ResetEdge(name string) error {
-	switch name {
-	case tier.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	}
-	return fmt.Errorf("unknown Tier edge %s", name)
+// DiskIopsCleared returns if the "disk_iops" field was cleared in this mutation.
+func (m *TierMutation) DiskIopsCleared() bool {
+	_, ok := m.clearedFields[tier.FieldDiskIops]
+	return ok
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	email                   *string
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	created_envs            map[string]struct{}
-	removedcreated_envs     map[string]struct{}
-	clearedcreated_envs     bool
-	access_tokens           map[uuid.UUID]struct{}
-	removedaccess_tokens    map[uuid.UUID]struct{}
-	clearedaccess_tokens    bool
-	created_api_keys        map[uuid.UUID]struct{}
-	removedcreated_api_keys map[uuid.UUID]struct{}
-	clearedcreated_api_keys bool
-	users_teams             map[int]struct{}
-	removedusers_teams      map[int]struct{}
-	clearedusers_teams      bool
-	done                    bool
-	oldValue                func(context.Context) (*User, error)
-	predicates              []predicate.User
+// ResetDiskIops resets all changes to the "disk_iops" field.
+func (m *TierMutation) ResetDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
+	delete(m.clearedFields, tier.FieldDiskIops)
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (m *TierMutation) SetNetworkBandwidthMBPerSec(i int64) {
+	m.network_bandwidth_mb_per_sec = &i
+	m.addnetwork_bandwidth_mb_per_sec = nil
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// NetworkBandwidthMBPerSec returns the value of the "network_bandwidth_mb_per_sec" field in the mutation.
+func (m *TierMutation) NetworkBandwidthMBPerSec() (r int64, exists bool) {
+	v := m.network_bandwidth_mb_per_sec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// OldNetworkBandwidthMBPerSec returns the old "network_bandwidth_mb_per_sec" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldNetworkBandwidthMBPerSec(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkBandwidthMBPerSec is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkBandwidthMBPerSec requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkBandwidthMBPerSec: %w", err)
+	}
+	return oldValue.NetworkBandwidthMBPerSec, nil
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddNetworkBandwidthMBPerSec adds i to the "network_bandwidth_mb_per_sec" field.
+func (m *TierMutation) AddNetworkBandwidthMBPerSec(i int64) {
+	if m.addnetwork_bandwidth_mb_per_sec != nil {
+		*m.addnetwork_bandwidth_mb_per_sec += i
+	} else {
+		m.addnetwork_bandwidth_mb_per_sec = &i
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedNetworkBandwidthMBPerSec returns the value that was added to the "network_bandwidth_mb_per_sec" field in this mutation.
+func (m *TierMutation) AddedNetworkBandwidthMBPerSec() (r int64, exists bool) {
+	v := m.addnetwork_bandwidth_mb_per_sec
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (m *TierMutation) ClearNetworkBandwidthMBPerSec() {
+	m.network_bandwidth_mb_per_sec = nil
+	m.addnetwork_bandwidth_mb_per_sec = nil
+	m.clearedFields[tier.FieldNetworkBandwidthMBPerSec] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// NetworkBandwidthMBPerSecCleared returns if the "network_bandwidth_mb_per_sec" field was cleared in this mutation.
+func (m *TierMutation) NetworkBandwidthMBPerSecCleared() bool {
+	_, ok := m.clearedFields[tier.FieldNetworkBandwidthMBPerSec]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetNetworkBandwidthMBPerSec resets all changes to the "network_bandwidth_mb_per_sec" field.
+func (m *TierMutation) ResetNetworkBandwidthMBPerSec() {
+	m.network_bandwidth_mb_per_sec = nil
+	m.addnetwork_bandwidth_mb_per_sec = nil
+	delete(m.clearedFields, tier.FieldNetworkBandwidthMBPerSec)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (m *TierMutation) SetNetworkPacketsPerSec(i int64) {
+	m.network_packets_per_sec = &i
+	m.addnetwork_packets_per_sec = nil
+}
+
+// NetworkPacketsPerSec returns the value of the "network_packets_per_sec" field in the mutation.
+func (m *TierMutation) NetworkPacketsPerSec() (r int64, exists bool) {
+	v := m.network_packets_per_sec
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldNetworkPacketsPerSec returns the old "network_packets_per_sec" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldNetworkPacketsPerSec(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkPacketsPerSec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkPacketsPerSec requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkPacketsPerSec: %w", err)
+	}
+	return oldValue.NetworkPacketsPerSec, nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// AddNetworkPacketsPerSec adds i to the "network_packets_per_sec" field.
+func (m *TierMutation) AddNetworkPacketsPerSec(i int64) {
+	if m.addnetwork_packets_per_sec != nil {
+		*m.addnetwork_packets_per_sec += i
+	} else {
+		m.addnetwork_packets_per_sec = &i
+	}
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// AddedNetworkPacketsPerSec returns the value that was added to the "network_packets_per_sec" field in this mutation.
+func (m *TierMutation) AddedNetworkPacketsPerSec() (r int64, exists bool) {
+	v := m.addnetwork_packets_per_sec
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (m *TierMutation) ClearNetworkPacketsPerSec() {
+	m.network_packets_per_sec = nil
+	m.addnetwork_packets_per_sec = nil
+	m.clearedFields[tier.FieldNetworkPacketsPerSec] = struct{}{}
+}
+
+// NetworkPacketsPerSecCleared returns if the "network_packets_per_sec" field was cleared in this mutation.
+func (m *TierMutation) NetworkPacketsPerSecCleared() bool {
+	_, ok := m.clearedFields[tier.FieldNetworkPacketsPerSec]
+	return ok
+}
+
+// ResetNetworkPacketsPerSec resets all changes to the "network_packets_per_sec" field.
+func (m *TierMutation) ResetNetworkPacketsPerSec() {
+	m.network_packets_per_sec = nil
+	m.addnetwork_packets_per_sec = nil
+	delete(m.clearedFields, tier.FieldNetworkPacketsPerSec)
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (m *TierMutation) SetMaxVolumeSizeMB(i int64) {
+	m.max_volume_size_mb = &i
+	m.addmax_volume_size_mb = nil
+}
+
+// MaxVolumeSizeMB returns the value of the "max_volume_size_mb" field in the mutation.
+func (m *TierMutation) MaxVolumeSizeMB() (r int64, exists bool) {
+	v := m.max_volume_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxVolumeSizeMB returns the old "max_volume_size_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *TierMutation) OldMaxVolumeSizeMB(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxVolumeSizeMB is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldMaxVolumeSizeMB requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxVolumeSizeMB: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.MaxVolumeSizeMB, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// AddMaxVolumeSizeMB adds i to the "max_volume_size_mb" field.
+func (m *TierMutation) AddMaxVolumeSizeMB(i int64) {
+	if m.addmax_volume_size_mb != nil {
+		*m.addmax_volume_size_mb += i
+	} else {
+		m.addmax_volume_size_mb = &i
+	}
+}
+
+// AddedMaxVolumeSizeMB returns the value that was added to the "max_volume_size_mb" field in this mutation.
+func (m *TierMutation) AddedMaxVolumeSizeMB() (r int64, exists bool) {
+	v := m.addmax_volume_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxVolumeSizeMB resets all changes to the "max_volume_size_mb" field.
+func (m *TierMutation) ResetMaxVolumeSizeMB() {
+	m.max_volume_size_mb = nil
+	m.addmax_volume_size_mb = nil
 }
 
 // AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
+func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
 	if m.teams == nil {
 		m.teams = make(map[uuid.UUID]struct{})
 	}
@@ -8451,17 +8998,17 @@ func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
 }
 
 // ClearTeams clears the "teams" edge to the Team entity.
-func (m *UserMutation) ClearTeams() {
+func (m *TierMutation) ClearTeams() {
 	m.clearedteams = true
 }
 
 // TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UserMutation) TeamsCleared() bool {
+func (m *TierMutation) TeamsCleared() bool {
 	return m.clearedteams
 }
 
 // RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
 	if m.removedteams == nil {
 		m.removedteams = make(map[uuid.UUID]struct{})
 	}
@@ -8472,7 +9019,7 @@ func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
 }
 
 // RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
 	for id := range m.removedteams {
 		ids = append(ids, id)
 	}
@@ -8480,7 +9027,7 @@ func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
 }
 
 // TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
+func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
 	for id := range m.teams {
 		ids = append(ids, id)
 	}
@@ -8488,237 +9035,1616 @@ func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
 }
 
 // ResetTeams resets all changes to the "teams" edge.
-func (m *UserMutation) ResetTeams() {
+func (m *TierMutation) ResetTeams() {
 	m.teams = nil
 	m.clearedteams = false
 	m.removedteams = nil
 }
 
-// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
-func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
-	if m.created_envs == nil {
-		m.created_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.created_envs[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
-func (m *UserMutation) ClearCreatedEnvs() {
-	m.clearedcreated_envs = true
-}
-
-// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
-func (m *UserMutation) CreatedEnvsCleared() bool {
-	return m.clearedcreated_envs
+// Where appends a list predicates to the TierMutation builder.
+func (m *TierMutation) Where(ps ...predicate.Tier) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
-func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
-	if m.removedcreated_envs == nil {
-		m.removedcreated_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.created_envs, ids[i])
-		m.removedcreated_envs[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tier, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
-func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
-	for id := range m.removedcreated_envs {
-		ids = append(ids, id)
-	}
-	return
+// Op returns the operation name.
+func (m *TierMutation) Op() Op {
+	return m.op
 }
 
-// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
-func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
-	for id := range m.created_envs {
-		ids = append(ids, id)
-	}
-	return
+// SetOp allows setting the mutation operation.
+func (m *TierMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetCreatedEnvs resets all changes to the "created_envs" edge.
-func (m *UserMutation) ResetCreatedEnvs() {
-	m.created_envs = nil
-	m.clearedcreated_envs = false
-	m.removedcreated_envs = nil
+// Type returns the node type of this mutation (Tier).
+func (m *TierMutation) Type() string {
+	return m.typ
 }
 
-// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
-func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
-	if m.access_tokens == nil {
-		m.access_tokens = make(map[uuid.UUID]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TierMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, tier.FieldName)
 	}
-	for i := range ids {
-		m.access_tokens[ids[i]] = struct{}{}
+	if m.disk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
 	}
-}
-
-// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) ClearAccessTokens() {
-	m.clearedaccess_tokens = true
-}
-
-// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
-func (m *UserMutation) AccessTokensCleared() bool {
-	return m.clearedaccess_tokens
-}
-
-// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
-func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
-	if m.removedaccess_tokens == nil {
-		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
+	if m.concurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
 	}
-	for i := range ids {
-		delete(m.access_tokens, ids[i])
-		m.removedaccess_tokens[ids[i]] = struct{}{}
+	if m.max_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
 	}
-}
-
-// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccess_tokens {
-		ids = append(ids, id)
+	if m.max_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
 	}
-	return
-}
-
-// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
-func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.access_tokens {
-		ids = append(ids, id)
+	if m.max_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
 	}
-	return
-}
-
-// ResetAccessTokens resets all changes to the "access_tokens" edge.
-func (m *UserMutation) ResetAccessTokens() {
-	m.access_tokens = nil
-	m.clearedaccess_tokens = false
-	m.removedaccess_tokens = nil
-}
-
-// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.created_api_keys == nil {
-		m.created_api_keys = make(map[uuid.UUID]struct{})
+	if m.disk_bandwidth_mb_per_sec != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMBPerSec)
 	}
-	for i := range ids {
-		m.created_api_keys[ids[i]] = struct{}{}
+	if m.disk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
 	}
-}
-
-// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) ClearCreatedAPIKeys() {
-	m.clearedcreated_api_keys = true
-}
-
-// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *UserMutation) CreatedAPIKeysCleared() bool {
-	return m.clearedcreated_api_keys
-}
-
-// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedcreated_api_keys == nil {
-		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
+	if m.network_bandwidth_mb_per_sec != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMBPerSec)
 	}
-	for i := range ids {
-		delete(m.created_api_keys, ids[i])
-		m.removedcreated_api_keys[ids[i]] = struct{}{}
+	if m.network_packets_per_sec != nil {
+		fields = append(fields, tier.FieldNetworkPacketsPerSec)
 	}
+	if m.max_volume_size_mb != nil {
+		fields = append(fields, tier.FieldMaxVolumeSizeMB)
+	}
+	return fields
 }
 
-// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_api_keys {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TierMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tier.FieldName:
+		return m.Name()
+	case tier.FieldDiskMB:
+		return m.DiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.ConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.MaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.MaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.MaxRAMMB()
+	case tier.FieldDiskBandwidthMBPerSec:
+		return m.DiskBandwidthMBPerSec()
+	case tier.FieldDiskIops:
+		return m.DiskIops()
+	case tier.FieldNetworkBandwidthMBPerSec:
+		return m.NetworkBandwidthMBPerSec()
+	case tier.FieldNetworkPacketsPerSec:
+		return m.NetworkPacketsPerSec()
+	case tier.FieldMaxVolumeSizeMB:
+		return m.MaxVolumeSizeMB()
 	}
-	return
+	return nil, false
 }
 
-// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
-func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.created_api_keys {
-		ids = append(ids, id)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tier.FieldName:
+		return m.OldName(ctx)
+	case tier.FieldDiskMB:
+		return m.OldDiskMB(ctx)
+	case tier.FieldConcurrentInstances:
+		return m.OldConcurrentInstances(ctx)
+	case tier.FieldMaxLengthHours:
+		return m.OldMaxLengthHours(ctx)
+	case tier.FieldMaxVcpu:
+		return m.OldMaxVcpu(ctx)
+	case tier.FieldMaxRAMMB:
+		return m.OldMaxRAMMB(ctx)
+	case tier.FieldDiskBandwidthMBPerSec:
+		return m.OldDiskBandwidthMBPerSec(ctx)
+	case tier.FieldDiskIops:
+		return m.OldDiskIops(ctx)
+	case tier.FieldNetworkBandwidthMBPerSec:
+		return m.OldNetworkBandwidthMBPerSec(ctx)
+	case tier.FieldNetworkPacketsPerSec:
+		return m.OldNetworkPacketsPerSec(ctx)
+	case tier.FieldMaxVolumeSizeMB:
+		return m.OldMaxVolumeSizeMB(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tier field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TierMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tier.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLengthHours(v)
+		return nil
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxVcpu(v)
+		return nil
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRAMMB(v)
+		return nil
+	case tier.FieldDiskBandwidthMBPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskBandwidthMBPerSec(v)
+		return nil
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskIops(v)
+		return nil
+	case tier.FieldNetworkBandwidthMBPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkBandwidthMBPerSec(v)
+		return nil
+	case tier.FieldNetworkPacketsPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkPacketsPerSec(v)
+		return nil
+	case tier.FieldMaxVolumeSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxVolumeSizeMB(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tier field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TierMutation) AddedFields() []string {
+	var fields []string
+	if m.adddisk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
+	}
+	if m.addconcurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
+	}
+	if m.addmax_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
+	}
+	if m.addmax_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
+	}
+	if m.addmax_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
+	}
+	if m.adddisk_bandwidth_mb_per_sec != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMBPerSec)
+	}
+	if m.adddisk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
+	}
+	if m.addnetwork_bandwidth_mb_per_sec != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMBPerSec)
+	}
+	if m.addnetwork_packets_per_sec != nil {
+		fields = append(fields, tier.FieldNetworkPacketsPerSec)
+	}
+	if m.addmax_volume_size_mb != nil {
+		fields = append(fields, tier.FieldMaxVolumeSizeMB)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tier.FieldDiskMB:
+		return m.AddedDiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.AddedConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.AddedMaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.AddedMaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.AddedMaxRAMMB()
+	case tier.FieldDiskBandwidthMBPerSec:
+		return m.AddedDiskBandwidthMBPerSec()
+	case tier.FieldDiskIops:
+		return m.AddedDiskIops()
+	case tier.FieldNetworkBandwidthMBPerSec:
+		return m.AddedNetworkBandwidthMBPerSec()
+	case tier.FieldNetworkPacketsPerSec:
+		return m.AddedNetworkPacketsPerSec()
+	case tier.FieldMaxVolumeSizeMB:
+		return m.AddedMaxVolumeSizeMB()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TierMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLengthHours(v)
+		return nil
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxVcpu(v)
+		return nil
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRAMMB(v)
+		return nil
+	case tier.FieldDiskBandwidthMBPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskBandwidthMBPerSec(v)
+		return nil
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskIops(v)
+		return nil
+	case tier.FieldNetworkBandwidthMBPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkBandwidthMBPerSec(v)
+		return nil
+	case tier.FieldNetworkPacketsPerSec:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkPacketsPerSec(v)
+		return nil
+	case tier.FieldMaxVolumeSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxVolumeSizeMB(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tier numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TierMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tier.FieldDiskBandwidthMBPerSec) {
+		fields = append(fields, tier.FieldDiskBandwidthMBPerSec)
+	}
+	if m.FieldCleared(tier.FieldDiskIops) {
+		fields = append(fields, tier.FieldDiskIops)
+	}
+	if m.FieldCleared(tier.FieldNetworkBandwidthMBPerSec) {
+		fields = append(fields, tier.FieldNetworkBandwidthMBPerSec)
+	}
+	if m.FieldCleared(tier.FieldNetworkPacketsPerSec) {
+		fields = append(fields, tier.FieldNetworkPacketsPerSec)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TierMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TierMutation) ClearField(name string) error {
+	switch name {
+	case tier.FieldDiskBandwidthMBPerSec:
+		m.ClearDiskBandwidthMBPerSec()
+		return nil
+	case tier.FieldDiskIops:
+		m.ClearDiskIops()
+		return nil
+	case tier.FieldNetworkBandwidthMBPerSec:
+		m.ClearNetworkBandwidthMBPerSec()
+		return nil
+	case tier.FieldNetworkPacketsPerSec:
+		m.ClearNetworkPacketsPerSec()
+		return nil
+	}
+	return fmt.Errorf("unknown Tier nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TierMutation) ResetField(name string) error {
+	switch name {
+	case tier.FieldName:
+		m.ResetName()
+		return nil
+	case tier.FieldDiskMB:
+		m.ResetDiskMB()
+		return nil
+	case tier.FieldConcurrentInstances:
+		m.ResetConcurrentInstances()
+		return nil
+	case tier.FieldMaxLengthHours:
+		m.ResetMaxLengthHours()
+		return nil
+	case tier.FieldMaxVcpu:
+		m.ResetMaxVcpu()
+		return nil
+	case tier.FieldMaxRAMMB:
+		m.ResetMaxRAMMB()
+		return nil
+	case tier.FieldDiskBandwidthMBPerSec:
+		m.ResetDiskBandwidthMBPerSec()
+		return nil
+	case tier.FieldDiskIops:
+		m.ResetDiskIops()
+		return nil
+	case tier.FieldNetworkBandwidthMBPerSec:
+		m.ResetNetworkBandwidthMBPerSec()
+		return nil
+	case tier.FieldNetworkPacketsPerSec:
+		m.ResetNetworkPacketsPerSec()
+		return nil
+	case tier.FieldMaxVolumeSizeMB:
+		m.ResetMaxVolumeSizeMB()
+		return nil
+	}
+	return fmt.Errorf("unknown Tier field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.teams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TierMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedteams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteams {
+		edges = append(edges, tier.EdgeTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TierMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tier.EdgeTeams:
+		return m.clearedteams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TierMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tier unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TierMutation) ResetEdge(name string) error {
+	switch name {
+	case tier.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Tier edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	email                   *string
+	clearedFields           map[string]struct{}
+	teams                   map[uuid.UUID]struct{}
+	removedteams            map[uuid.UUID]struct{}
+	clearedteams            bool
+	created_envs            map[string]struct{}
+	removedcreated_envs     map[string]struct{}
+	clearedcreated_envs     bool
+	access_tokens           map[uuid.UUID]struct{}
+	removedaccess_tokens    map[uuid.UUID]struct{}
+	clearedaccess_tokens    bool
+	created_api_keys        map[uuid.UUID]struct{}
+	removedcreated_api_keys map[uuid.UUID]struct{}
+	clearedcreated_api_keys bool
+	users_teams             map[int]struct{}
+	removedusers_teams      map[int]struct{}
+	clearedusers_teams      bool
+	done                    bool
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UserMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UserMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UserMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
+func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
+	if m.created_envs == nil {
+		m.created_envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.created_envs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
+func (m *UserMutation) ClearCreatedEnvs() {
+	m.clearedcreated_envs = true
+}
+
+// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
+func (m *UserMutation) CreatedEnvsCleared() bool {
+	return m.clearedcreated_envs
+}
+
+// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
+func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
+	if m.removedcreated_envs == nil {
+		m.removedcreated_envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.created_envs, ids[i])
+		m.removedcreated_envs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
+func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
+	for id := range m.removedcreated_envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
+func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
+	for id := range m.created_envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedEnvs resets all changes to the "created_envs" edge.
+func (m *UserMutation) ResetCreatedEnvs() {
+	m.created_envs = nil
+	m.clearedcreated_envs = false
+	m.removedcreated_envs = nil
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.access_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
+}
+
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
+}
+
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccess_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.access_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
+}
+
+// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.created_api_keys == nil {
+		m.created_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) ClearCreatedAPIKeys() {
+	m.clearedcreated_api_keys = true
+}
+
+// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *UserMutation) CreatedAPIKeysCleared() bool {
+	return m.clearedcreated_api_keys
+}
+
+// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedcreated_api_keys == nil {
+		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.created_api_keys, ids[i])
+		m.removedcreated_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
+func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.created_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
+func (m *UserMutation) ResetCreatedAPIKeys() {
+	m.created_api_keys = nil
+	m.clearedcreated_api_keys = false
+	m.removedcreated_api_keys = nil
+}
+
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
+}
+
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *UserMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
+}
+
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *UserMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *UserMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.teams != nil {
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.created_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.created_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.created_envs))
+		for id := range m.created_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.created_api_keys))
+		for id := range m.created_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedteams != nil {
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.removedcreated_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.removedcreated_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
+		for id := range m.removedcreated_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
+		for id := range m.removedcreated_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedteams {
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.clearedcreated_envs {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedcreated_api_keys {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeTeams:
+		return m.clearedteams
+	case user.EdgeCreatedEnvs:
+		return m.clearedcreated_envs
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgeCreatedAPIKeys:
+		return m.clearedcreated_api_keys
+	case user.EdgeUsersTeams:
+		return m.clearedusers_teams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	case user.EdgeCreatedEnvs:
+		m.ResetCreatedEnvs()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgeCreatedAPIKeys:
+		m.ResetCreatedAPIKeys()
+		return nil
+	case user.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
+type UsersTeamsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	is_default    *bool
+	clearedFields map[string]struct{}
+	users         *uuid.UUID
+	clearedusers  bool
+	teams         *uuid.UUID
+	clearedteams  bool
+	done          bool
+	oldValue      func(context.Context) (*UsersTeams, error)
+	predicates    []predicate.UsersTeams
+}
+
+var _ ent.Mutation = (*UsersTeamsMutation)(nil)
+
+// usersteamsOption allows management of the mutation configuration using functional options.
+type usersteamsOption func(*UsersTeamsMutation)
+
+// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
+func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
+	m := &UsersTeamsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsersTeams,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUsersTeamsID sets the ID field of the mutation.
+func withUsersTeamsID(id int) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UsersTeams
+		)
+		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UsersTeams.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUsersTeams sets the old UsersTeams of the mutation.
+func withUsersTeams(node *UsersTeams) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		m.oldValue = func(context.Context) (*UsersTeams, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsersTeamsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsersTeamsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsersTeamsMutation) ResetUserID() {
+	m.users = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
+	m.teams = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.teams
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *UsersTeamsMutation) ResetTeamID() {
+	m.teams = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *UsersTeamsMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *UsersTeamsMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *UsersTeamsMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[usersteams.FieldUserID] = struct{}{}
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *UsersTeamsMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
-func (m *UserMutation) ResetCreatedAPIKeys() {
-	m.created_api_keys = nil
-	m.clearedcreated_api_keys = false
-	m.removedcreated_api_keys = nil
-}
-
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
-	}
+// ResetUsers resets all changes to the "users" edge.
+func (m *UsersTeamsMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// SetTeamsID sets the "teams" edge to the Team entity by id.
+func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
+	m.teams = &id
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *UserMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UsersTeamsMutation) ClearTeams() {
+	m.clearedteams = true
+	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
-	}
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UsersTeamsMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// TeamsID returns the "teams" edge ID in the mutation.
+func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
+	if m.teams != nil {
+		return *m.teams, true
 	}
 	return
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *UserMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamsID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
+	if id := m.teams; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *UserMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UsersTeamsMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UsersTeamsMutation builder.
+func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsersTeams, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8726,27 +10652,33 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UsersTeamsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UsersTeamsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UsersTeams).
+func (m *UsersTeamsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *UsersTeamsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.users != nil {
+		fields = append(fields, usersteams.FieldUserID)
+	}
+	if m.teams != nil {
+		fields = append(fields, usersteams.FieldTeamID)
+	}
+	if m.is_default != nil {
+		fields = append(fields, usersteams.FieldIsDefault)
 	}
 	return fields
 }
@@ -8754,10 +10686,14 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
+	case usersteams.FieldUserID:
+		return m.UserID()
+	case usersteams.FieldTeamID:
+		return m.TeamID()
+	case usersteams.FieldIsDefault:
+		return m.IsDefault()
 	}
 	return nil, false
 }
@@ -8765,298 +10701,229 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
+	case usersteams.FieldUserID:
+		return m.OldUserID(ctx)
+	case usersteams.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case usersteams.FieldIsDefault:
+		return m.OldIsDefault(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case usersteams.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUserID(v)
+		return nil
+	case usersteams.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case usersteams.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UsersTeamsMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UsersTeamsMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UsersTeamsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UsersTeamsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UsersTeamsMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case usersteams.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case usersteams.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case usersteams.FieldIsDefault:
+		m.ResetIsDefault()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.teams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.created_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.access_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.created_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.teams != nil {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.created_envs))
-		for id := range m.created_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.access_tokens))
-		for id := range m.access_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.created_api_keys))
-		for id := range m.created_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedteams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.removedcreated_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.removedaccess_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.removedcreated_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+	case usersteams.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	case usersteams.EdgeTeams:
+		if id := m.teams; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UsersTeamsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
-		for id := range m.removedcreated_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
-		for id := range m.removedaccess_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
-		for id := range m.removedcreated_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteams {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.clearedcreated_envs {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.clearedaccess_tokens {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.clearedcreated_api_keys {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *UsersTeamsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.clearedteams {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeTeams:
+	case usersteams.EdgeUsers:
+		return m.clearedusers
+	case usersteams.EdgeTeams:
 		return m.clearedteams
-	case user.EdgeCreatedEnvs:
-		return m.clearedcreated_envs
-	case user.EdgeAccessTokens:
-		return m.clearedaccess_tokens
-	case user.EdgeCreatedAPIKeys:
-		return m.clearedcreated_api_keys
-	case user.EdgeUsersTeams:
-		return m.clearedusers_teams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UsersTeamsMutation) ClearEdge(name string) error {
 	switch name {
+	case usersteams.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	case usersteams.EdgeTeams:
+		m.ClearTeams()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UsersTeamsMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	case user.EdgeCreatedEnvs:
-		m.ResetCreatedEnvs()
-		return nil
-	case user.EdgeAccessTokens:
-		m.ResetAccessTokens()
-		return nil
-	case user.EdgeCreatedAPIKeys:
-		m.ResetCreatedAPIKeys()
+	case usersteams.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case user.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case usersteams.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UsersTeams edge %s", name)
 }
 
-// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
-type UsersTeamsMutation struct {
+// VolumeMutation represents an operation that mutates the Volume nodes in the graph.
+type VolumeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	is_default    *bool
-	clearedFields map[string]struct{}
-	users         *uuid.UUID
-	clearedusers  bool
-	teams         *uuid.UUID
-	clearedteams  bool
-	done          bool
-	oldValue      func(context.Context) (*UsersTeams, error)
-	predicates    []predicate.UsersTeams
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	name                *string
+	size_mb             *int64
+	addsize_mb          *int64
+	attached_sandbox_id *string
+	attached_at         *time.Time
+	clearedFields       map[string]struct{}
+	team                *uuid.UUID
+	clearedteam         bool
+	done                bool
+	oldValue            func(context.Context) (*Volume, error)
+	predicates          []predicate.Volume
 }
 
-var _ ent.Mutation = (*UsersTeamsMutation)(nil)
+var _ ent.Mutation = (*VolumeMutation)(nil)
 
-// usersteamsOption allows management of the mutation configuration using functional options.
-type usersteamsOption func(*UsersTeamsMutation)
+// volumeOption allows management of the mutation configuration using functional options.
+type volumeOption func(*VolumeMutation)
 
-// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
-func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
-	m := &UsersTeamsMutation{
+// newVolumeMutation creates new mutation for the Volume entity.
+func newVolumeMutation(c config, op Op, opts ...volumeOption) *VolumeMutation {
+	m := &VolumeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsersTeams,
+		typ:           TypeVolume,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9065,20 +10932,20 @@ func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeam
 	return m
 }
 
-// withUsersTeamsID sets the ID field of the mutation.
-func withUsersTeamsID(id int) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
+// withVolumeID sets the ID field of the mutation.
+func withVolumeID(id uuid.UUID) volumeOption {
+	return func(m *VolumeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsersTeams
+			value *Volume
 		)
-		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+		m.oldValue = func(ctx context.Context) (*Volume, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsersTeams.Get(ctx, id)
+					value, err = m.Client().Volume.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9087,10 +10954,10 @@ func withUsersTeamsID(id int) usersteamsOption {
 	}
 }
 
-// withUsersTeams sets the old UsersTeams of the mutation.
-func withUsersTeams(node *UsersTeams) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
-		m.oldValue = func(context.Context) (*UsersTeams, error) {
+// withVolume sets the old Volume of the mutation.
+func withVolume(node *Volume) volumeOption {
+	return func(m *VolumeMutation) {
+		m.oldValue = func(context.Context) (*Volume, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9099,7 +10966,7 @@ func withUsersTeams(node *UsersTeams) usersteamsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsersTeamsMutation) Client() *Client {
+func (m VolumeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9107,7 +10974,7 @@ func (m UsersTeamsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsersTeamsMutation) Tx() (*Tx, error) {
+func (m VolumeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -9116,9 +10983,15 @@ func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Volume entities.
+func (m *VolumeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+func (m *VolumeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9129,75 +11002,75 @@ func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VolumeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Volume.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *VolumeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VolumeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VolumeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsersTeamsMutation) ResetUserID() {
-	m.users = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VolumeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetTeamID sets the "team_id" field.
-func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
-	m.teams = &u
+func (m *VolumeMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
 // TeamID returns the value of the "team_id" field in the mutation.
-func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.teams
+func (m *VolumeMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VolumeMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
@@ -9208,139 +11081,240 @@ func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err er
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *VolumeMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetName sets the "name" field.
+func (m *VolumeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VolumeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VolumeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSizeMB sets the "size_mb" field.
+func (m *VolumeMutation) SetSizeMB(i int64) {
+	m.size_mb = &i
+	m.addsize_mb = nil
+}
+
+// SizeMB returns the value of the "size_mb" field in the mutation.
+func (m *VolumeMutation) SizeMB() (r int64, exists bool) {
+	v := m.size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeMB returns the old "size_mb" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldSizeMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeMB: %w", err)
+	}
+	return oldValue.SizeMB, nil
+}
+
+// AddSizeMB adds i to the "size_mb" field.
+func (m *VolumeMutation) AddSizeMB(i int64) {
+	if m.addsize_mb != nil {
+		*m.addsize_mb += i
+	} else {
+		m.addsize_mb = &i
+	}
+}
+
+// AddedSizeMB returns the value that was added to the "size_mb" field in this mutation.
+func (m *VolumeMutation) AddedSizeMB() (r int64, exists bool) {
+	v := m.addsize_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *UsersTeamsMutation) ResetTeamID() {
-	m.teams = nil
+// ResetSizeMB resets all changes to the "size_mb" field.
+func (m *VolumeMutation) ResetSizeMB() {
+	m.size_mb = nil
+	m.addsize_mb = nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *UsersTeamsMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (m *VolumeMutation) SetAttachedSandboxID(s string) {
+	m.attached_sandbox_id = &s
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
+// AttachedSandboxID returns the value of the "attached_sandbox_id" field in the mutation.
+func (m *VolumeMutation) AttachedSandboxID() (r string, exists bool) {
+	v := m.attached_sandbox_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldAttachedSandboxID returns the old "attached_sandbox_id" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+func (m *VolumeMutation) OldAttachedSandboxID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttachedSandboxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldAttachedSandboxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttachedSandboxID: %w", err)
 	}
-	return oldValue.IsDefault, nil
+	return oldValue.AttachedSandboxID, nil
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *UsersTeamsMutation) ResetIsDefault() {
-	m.is_default = nil
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (m *VolumeMutation) ClearAttachedSandboxID() {
+	m.attached_sandbox_id = nil
+	m.clearedFields[volume.FieldAttachedSandboxID] = struct{}{}
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// AttachedSandboxIDCleared returns if the "attached_sandbox_id" field was cleared in this mutation.
+func (m *VolumeMutation) AttachedSandboxIDCleared() bool {
+	_, ok := m.clearedFields[volume.FieldAttachedSandboxID]
+	return ok
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *UsersTeamsMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[usersteams.FieldUserID] = struct{}{}
+// ResetAttachedSandboxID resets all changes to the "attached_sandbox_id" field.
+func (m *VolumeMutation) ResetAttachedSandboxID() {
+	m.attached_sandbox_id = nil
+	delete(m.clearedFields, volume.FieldAttachedSandboxID)
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *UsersTeamsMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetAttachedAt sets the "attached_at" field.
+func (m *VolumeMutation) SetAttachedAt(t time.Time) {
+	m.attached_at = &t
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// AttachedAt returns the value of the "attached_at" field in the mutation.
+func (m *VolumeMutation) AttachedAt() (r time.Time, exists bool) {
+	v := m.attached_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldAttachedAt returns the old "attached_at" field's value of the Volume entity.
+// If the Volume object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VolumeMutation) OldAttachedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedAt: %w", err)
+	}
+	return oldValue.AttachedAt, nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *UsersTeamsMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (m *VolumeMutation) ClearAttachedAt() {
+	m.attached_at = nil
+	m.clearedFields[volume.FieldAttachedAt] = struct{}{}
 }
 
-// SetTeamsID sets the "teams" edge to the Team entity by id.
-func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
-	m.teams = &id
+// AttachedAtCleared returns if the "attached_at" field was cleared in this mutation.
+func (m *VolumeMutation) AttachedAtCleared() bool {
+	_, ok := m.clearedFields[volume.FieldAttachedAt]
+	return ok
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UsersTeamsMutation) ClearTeams() {
-	m.clearedteams = true
-	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
+// ResetAttachedAt resets all changes to the "attached_at" field.
+func (m *VolumeMutation) ResetAttachedAt() {
+	m.attached_at = nil
+	delete(m.clearedFields, volume.FieldAttachedAt)
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UsersTeamsMutation) TeamsCleared() bool {
-	return m.clearedteams
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *VolumeMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[volume.FieldTeamID] = struct{}{}
 }
 
-// TeamsID returns the "teams" edge ID in the mutation.
-func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
-	if m.teams != nil {
-		return *m.teams, true
-	}
-	return
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *VolumeMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
+// TeamIDs returns the "team" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamsID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
-	if id := m.teams; id != nil {
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *VolumeMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UsersTeamsMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
+// ResetTeam resets all changes to the "team" edge.
+func (m *VolumeMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// Where appends a list predicates to the UsersTeamsMutation builder.
-func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
+// Where appends a list predicates to the VolumeMutation builder.
+func (m *VolumeMutation) Where(ps ...predicate.Volume) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VolumeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsersTeams, len(ps))
+func (m *VolumeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Volume, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9348,33 +11322,42 @@ func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UsersTeamsMutation) Op() Op {
+func (m *VolumeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UsersTeamsMutation) SetOp(op Op) {
+func (m *VolumeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UsersTeams).
-func (m *UsersTeamsMutation) Type() string {
+// Type returns the node type of this mutation (Volume).
+func (m *VolumeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UsersTeamsMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.users != nil {
-		fields = append(fields, usersteams.FieldUserID)
+func (m *VolumeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, volume.FieldCreatedAt)
 	}
-	if m.teams != nil {
-		fields = append(fields, usersteams.FieldTeamID)
+	if m.team != nil {
+		fields = append(fields, volume.FieldTeamID)
 	}
-	if m.is_default != nil {
-		fields = append(fields, usersteams.FieldIsDefault)
+	if m.name != nil {
+		fields = append(fields, volume.FieldName)
+	}
+	if m.size_mb != nil {
+		fields = append(fields, volume.FieldSizeMB)
+	}
+	if m.attached_sandbox_id != nil {
+		fields = append(fields, volume.FieldAttachedSandboxID)
+	}
+	if m.attached_at != nil {
+		fields = append(fields, volume.FieldAttachedAt)
 	}
 	return fields
 }
@@ -9382,14 +11365,20 @@ func (m *UsersTeamsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
+func (m *VolumeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.UserID()
-	case usersteams.FieldTeamID:
+	case volume.FieldCreatedAt:
+		return m.CreatedAt()
+	case volume.FieldTeamID:
 		return m.TeamID()
-	case usersteams.FieldIsDefault:
-		return m.IsDefault()
+	case volume.FieldName:
+		return m.Name()
+	case volume.FieldSizeMB:
+		return m.SizeMB()
+	case volume.FieldAttachedSandboxID:
+		return m.AttachedSandboxID()
+	case volume.FieldAttachedAt:
+		return m.AttachedAt()
 	}
 	return nil, false
 }
@@ -9397,128 +11386,187 @@ func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VolumeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.OldUserID(ctx)
-	case usersteams.FieldTeamID:
+	case volume.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case volume.FieldTeamID:
 		return m.OldTeamID(ctx)
-	case usersteams.FieldIsDefault:
-		return m.OldIsDefault(ctx)
+	case volume.FieldName:
+		return m.OldName(ctx)
+	case volume.FieldSizeMB:
+		return m.OldSizeMB(ctx)
+	case volume.FieldAttachedSandboxID:
+		return m.OldAttachedSandboxID(ctx)
+	case volume.FieldAttachedAt:
+		return m.OldAttachedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
+	return nil, fmt.Errorf("unknown Volume field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
+func (m *VolumeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case usersteams.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case volume.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case usersteams.FieldTeamID:
+	case volume.FieldTeamID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTeamID(v)
 		return nil
-	case usersteams.FieldIsDefault:
-		v, ok := value.(bool)
+	case volume.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetName(v)
+		return nil
+	case volume.FieldSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeMB(v)
+		return nil
+	case volume.FieldAttachedSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedSandboxID(v)
+		return nil
+	case volume.FieldAttachedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown Volume field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UsersTeamsMutation) AddedFields() []string {
-	return nil
+func (m *VolumeMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_mb != nil {
+		fields = append(fields, volume.FieldSizeMB)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VolumeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case volume.FieldSizeMB:
+		return m.AddedSizeMB()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
+func (m *VolumeMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case volume.FieldSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeMB(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
+	return fmt.Errorf("unknown Volume numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UsersTeamsMutation) ClearedFields() []string {
-	return nil
+func (m *VolumeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(volume.FieldAttachedSandboxID) {
+		fields = append(fields, volume.FieldAttachedSandboxID)
+	}
+	if m.FieldCleared(volume.FieldAttachedAt) {
+		fields = append(fields, volume.FieldAttachedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UsersTeamsMutation) FieldCleared(name string) bool {
+func (m *VolumeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
+func (m *VolumeMutation) ClearField(name string) error {
+	switch name {
+	case volume.FieldAttachedSandboxID:
+		m.ClearAttachedSandboxID()
+		return nil
+	case volume.FieldAttachedAt:
+		m.ClearAttachedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Volume nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ResetField(name string) error {
+func (m *VolumeMutation) ResetField(name string) error {
 	switch name {
-	case usersteams.FieldUserID:
-		m.ResetUserID()
+	case volume.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case usersteams.FieldTeamID:
+	case volume.FieldTeamID:
 		m.ResetTeamID()
 		return nil
-	case usersteams.FieldIsDefault:
-		m.ResetIsDefault()
+	case volume.FieldName:
+		m.ResetName()
+		return nil
+	case volume.FieldSizeMB:
+		m.ResetSizeMB()
+		return nil
+	case volume.FieldAttachedSandboxID:
+		m.ResetAttachedSandboxID()
+		return nil
+	case volume.FieldAttachedAt:
+		m.ResetAttachedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown Volume field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsersTeamsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.teams != nil {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *VolumeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.team != nil {
+		edges = append(edges, volume.EdgeTeam)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
+func (m *VolumeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case usersteams.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case usersteams.EdgeTeams:
-		if id := m.teams; id != nil {
+	case volume.EdgeTeam:
+		if id := m.team; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9526,65 +11574,54 @@ func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsersTeamsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VolumeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
+func (m *VolumeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsersTeamsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.clearedteams {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *VolumeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteam {
+		edges = append(edges, volume.EdgeTeam)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
+func (m *VolumeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case usersteams.EdgeUsers:
-		return m.clearedusers
-	case usersteams.EdgeTeams:
-		return m.clearedteams
+	case volume.EdgeTeam:
+		return m.clearedteam
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UsersTeamsMutation) ClearEdge(name string) error {
+func (m *VolumeMutation) ClearEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ClearTeams()
+	case volume.EdgeTeam:
+		m.ClearTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
+	return fmt.Errorf("unknown Volume unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UsersTeamsMutation) ResetEdge(name string) error {
+func (m *VolumeMutation) ResetEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ResetTeams()
+	case volume.EdgeTeam:
+		m.ResetTeam()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams edge %s", name)
+	return fmt.Errorf("unknown Volume edge %s", name)
 }
@@ -38,3 +38,6 @@ type User func(*sql.Selector)
 
 // UsersTeams is the predicate function for usersteams builders.
 type UsersTeams func(*sql.Selector)
+
+// Volume is the predicate function for volume builders.
+type Volume func(*sql.Selector)
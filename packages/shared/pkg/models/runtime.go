@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
@@ -60,6 +61,10 @@ func init() {
 	envDescSpawnCount := envFields[7].Descriptor()
 	// env.DefaultSpawnCount holds the default value on creation for the spawn_count field.
 	env.DefaultSpawnCount = envDescSpawnCount.Default.(int64)
+	// envDescWarmPoolSize is the schema descriptor for warm_pool_size field.
+	envDescWarmPoolSize := envFields[10].Descriptor()
+	// env.DefaultWarmPoolSize holds the default value on creation for the warm_pool_size field.
+	env.DefaultWarmPoolSize = envDescWarmPoolSize.Default.(int32)
 	envaliasFields := schema.EnvAlias{}.Fields()
 	_ = envaliasFields
 	// envaliasDescIsRenamable is the schema descriptor for is_renamable field.
@@ -126,4 +131,10 @@ func init() {
 	usersteamsDescIsDefault := usersteamsFields[2].Descriptor()
 	// usersteams.DefaultIsDefault holds the default value on creation for the is_default field.
 	usersteams.DefaultIsDefault = usersteamsDescIsDefault.Default.(bool)
+	volumeFields := schema.Volume{}.Fields()
+	_ = volumeFields
+	// volumeDescCreatedAt is the schema descriptor for created_at field.
+	volumeDescCreatedAt := volumeFields[1].Descriptor()
+	// volume.DefaultCreatedAt holds the default value on creation for the created_at field.
+	volume.DefaultCreatedAt = volumeDescCreatedAt.Default.(func() time.Time)
 }
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +35,16 @@ type Snapshot struct {
 	SandboxStartedAt time.Time `json:"sandbox_started_at,omitempty"`
 	// EnvSecure holds the value of the "env_secure" field.
 	EnvSecure bool `json:"env_secure,omitempty"`
+	// Number of vCPUs the sandbox was limited to when paused, NULL means all the vCPUs of the build
+	VcpuLimit *int64 `json:"vcpu_limit,omitempty"`
+	// Memory in MiB the sandbox was limited to when paused, NULL means all the memory of the build
+	RAMMBLimit *int64 `json:"ram_mb_limit,omitempty"`
+	// Egress policy of the sandbox, NULL means no restrictions
+	EgressPolicy *types.SandboxEgressPolicy `json:"egress_policy,omitempty"`
+	// Exposure of the sandbox ports through the proxies, NULL means all the ports are public
+	PortAccess *types.SandboxPortAccess `json:"port_access,omitempty"`
+	// Team network the sandbox is attached to, NULL means no team network
+	Network *types.SandboxNetwork `json:"network,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -67,10 +78,12 @@ func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldMetadata:
+		case snapshot.FieldMetadata, snapshot.FieldEgressPolicy, snapshot.FieldPortAccess, snapshot.FieldNetwork:
 			values[i] = new([]byte)
 		case snapshot.FieldEnvSecure:
 			values[i] = new(sql.NullBool)
+		case snapshot.FieldVcpuLimit, snapshot.FieldRAMMBLimit:
+			values[i] = new(sql.NullInt64)
 		case snapshot.FieldBaseEnvID, snapshot.FieldEnvID, snapshot.FieldSandboxID:
 			values[i] = new(sql.NullString)
 		case snapshot.FieldCreatedAt, snapshot.FieldSandboxStartedAt:
@@ -142,6 +155,44 @@ func (s *Snapshot) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.EnvSecure = value.Bool
 			}
+		case snapshot.FieldVcpuLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vcpu_limit", values[i])
+			} else if value.Valid {
+				s.VcpuLimit = new(int64)
+				*s.VcpuLimit = value.Int64
+			}
+		case snapshot.FieldRAMMBLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ram_mb_limit", values[i])
+			} else if value.Valid {
+				s.RAMMBLimit = new(int64)
+				*s.RAMMBLimit = value.Int64
+			}
+		case snapshot.FieldEgressPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field egress_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.EgressPolicy); err != nil {
+					return fmt.Errorf("unmarshal field egress_policy: %w", err)
+				}
+			}
+		case snapshot.FieldPortAccess:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field port_access", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.PortAccess); err != nil {
+					return fmt.Errorf("unmarshal field port_access: %w", err)
+				}
+			}
+		case snapshot.FieldNetwork:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Network); err != nil {
+					return fmt.Errorf("unmarshal field network: %w", err)
+				}
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +254,25 @@ func (s *Snapshot) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("env_secure=")
 	builder.WriteString(fmt.Sprintf("%v", s.EnvSecure))
+	builder.WriteString(", ")
+	if v := s.VcpuLimit; v != nil {
+		builder.WriteString("vcpu_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.RAMMBLimit; v != nil {
+		builder.WriteString("ram_mb_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("egress_policy=")
+	builder.WriteString(fmt.Sprintf("%v", s.EgressPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("port_access=")
+	builder.WriteString(fmt.Sprintf("%v", s.PortAccess))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fmt.Sprintf("%v", s.Network))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,16 @@ const (
 	FieldSandboxStartedAt = "sandbox_started_at"
 	// FieldEnvSecure holds the string denoting the env_secure field in the database.
 	FieldEnvSecure = "env_secure"
+	// FieldVcpuLimit holds the string denoting the vcpu_limit field in the database.
+	FieldVcpuLimit = "vcpu_limit"
+	// FieldRAMMBLimit holds the string denoting the ram_mb_limit field in the database.
+	FieldRAMMBLimit = "ram_mb_limit"
+	// FieldEgressPolicy holds the string denoting the egress_policy field in the database.
+	FieldEgressPolicy = "egress_policy"
+	// FieldPortAccess holds the string denoting the port_access field in the database.
+	FieldPortAccess = "port_access"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the snapshot in the database.
@@ -51,6 +61,11 @@ var Columns = []string{
 	FieldMetadata,
 	FieldSandboxStartedAt,
 	FieldEnvSecure,
+	FieldVcpuLimit,
+	FieldRAMMBLimit,
+	FieldEgressPolicy,
+	FieldPortAccess,
+	FieldNetwork,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -108,6 +123,16 @@ func ByEnvSecure(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnvSecure, opts...).ToFunc()
 }
 
+// ByVcpuLimit orders the results by the vcpu_limit field.
+func ByVcpuLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVcpuLimit, opts...).ToFunc()
+}
+
+// ByRAMMBLimit orders the results by the ram_mb_limit field.
+func ByRAMMBLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRAMMBLimit, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
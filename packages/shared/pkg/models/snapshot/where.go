@@ -87,6 +87,16 @@ func EnvSecure(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldEnvSecure, v))
 }
 
+// VcpuLimit applies equality check predicate on the "vcpu_limit" field. It's identical to VcpuLimitEQ.
+func VcpuLimit(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldVcpuLimit, v))
+}
+
+// RAMMBLimit applies equality check predicate on the "ram_mb_limit" field. It's identical to RAMMBLimitEQ.
+func RAMMBLimit(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldRAMMBLimit, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldCreatedAt, v))
@@ -372,6 +382,136 @@ func EnvSecureNEQ(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldNEQ(FieldEnvSecure, v))
 }
 
+// VcpuLimitEQ applies the EQ predicate on the "vcpu_limit" field.
+func VcpuLimitEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldVcpuLimit, v))
+}
+
+// VcpuLimitNEQ applies the NEQ predicate on the "vcpu_limit" field.
+func VcpuLimitNEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldVcpuLimit, v))
+}
+
+// VcpuLimitIn applies the In predicate on the "vcpu_limit" field.
+func VcpuLimitIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldVcpuLimit, vs...))
+}
+
+// VcpuLimitNotIn applies the NotIn predicate on the "vcpu_limit" field.
+func VcpuLimitNotIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldVcpuLimit, vs...))
+}
+
+// VcpuLimitGT applies the GT predicate on the "vcpu_limit" field.
+func VcpuLimitGT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldVcpuLimit, v))
+}
+
+// VcpuLimitGTE applies the GTE predicate on the "vcpu_limit" field.
+func VcpuLimitGTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldVcpuLimit, v))
+}
+
+// VcpuLimitLT applies the LT predicate on the "vcpu_limit" field.
+func VcpuLimitLT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldVcpuLimit, v))
+}
+
+// VcpuLimitLTE applies the LTE predicate on the "vcpu_limit" field.
+func VcpuLimitLTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldVcpuLimit, v))
+}
+
+// VcpuLimitIsNil applies the IsNil predicate on the "vcpu_limit" field.
+func VcpuLimitIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldVcpuLimit))
+}
+
+// VcpuLimitNotNil applies the NotNil predicate on the "vcpu_limit" field.
+func VcpuLimitNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldVcpuLimit))
+}
+
+// RAMMBLimitEQ applies the EQ predicate on the "ram_mb_limit" field.
+func RAMMBLimitEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitNEQ applies the NEQ predicate on the "ram_mb_limit" field.
+func RAMMBLimitNEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitIn applies the In predicate on the "ram_mb_limit" field.
+func RAMMBLimitIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldRAMMBLimit, vs...))
+}
+
+// RAMMBLimitNotIn applies the NotIn predicate on the "ram_mb_limit" field.
+func RAMMBLimitNotIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldRAMMBLimit, vs...))
+}
+
+// RAMMBLimitGT applies the GT predicate on the "ram_mb_limit" field.
+func RAMMBLimitGT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitGTE applies the GTE predicate on the "ram_mb_limit" field.
+func RAMMBLimitGTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitLT applies the LT predicate on the "ram_mb_limit" field.
+func RAMMBLimitLT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitLTE applies the LTE predicate on the "ram_mb_limit" field.
+func RAMMBLimitLTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldRAMMBLimit, v))
+}
+
+// RAMMBLimitIsNil applies the IsNil predicate on the "ram_mb_limit" field.
+func RAMMBLimitIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldRAMMBLimit))
+}
+
+// RAMMBLimitNotNil applies the NotNil predicate on the "ram_mb_limit" field.
+func RAMMBLimitNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldRAMMBLimit))
+}
+
+// EgressPolicyIsNil applies the IsNil predicate on the "egress_policy" field.
+func EgressPolicyIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldEgressPolicy))
+}
+
+// EgressPolicyNotNil applies the NotNil predicate on the "egress_policy" field.
+func EgressPolicyNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldEgressPolicy))
+}
+
+// PortAccessIsNil applies the IsNil predicate on the "port_access" field.
+func PortAccessIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldPortAccess))
+}
+
+// PortAccessNotNil applies the NotNil predicate on the "port_access" field.
+func PortAccessNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldPortAccess))
+}
+
+// NetworkIsNil applies the IsNil predicate on the "network" field.
+func NetworkIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldNetwork))
+}
+
+// NetworkNotNil applies the NotNil predicate on the "network" field.
+func NetworkNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldNetwork))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
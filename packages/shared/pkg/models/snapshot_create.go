@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -83,6 +84,52 @@ func (sc *SnapshotCreate) SetNillableEnvSecure(b *bool) *SnapshotCreate {
 	return sc
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (sc *SnapshotCreate) SetVcpuLimit(i int64) *SnapshotCreate {
+	sc.mutation.SetVcpuLimit(i)
+	return sc
+}
+
+// SetNillableVcpuLimit sets the "vcpu_limit" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableVcpuLimit(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetVcpuLimit(*i)
+	}
+	return sc
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (sc *SnapshotCreate) SetRAMMBLimit(i int64) *SnapshotCreate {
+	sc.mutation.SetRAMMBLimit(i)
+	return sc
+}
+
+// SetNillableRAMMBLimit sets the "ram_mb_limit" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableRAMMBLimit(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetRAMMBLimit(*i)
+	}
+	return sc
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (sc *SnapshotCreate) SetEgressPolicy(tep *types.SandboxEgressPolicy) *SnapshotCreate {
+	sc.mutation.SetEgressPolicy(tep)
+	return sc
+}
+
+// SetPortAccess sets the "port_access" field.
+func (sc *SnapshotCreate) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotCreate {
+	sc.mutation.SetPortAccess(tpa)
+	return sc
+}
+
+// SetNetwork sets the "network" field.
+func (sc *SnapshotCreate) SetNetwork(tn *types.SandboxNetwork) *SnapshotCreate {
+	sc.mutation.SetNetwork(tn)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SnapshotCreate) SetID(u uuid.UUID) *SnapshotCreate {
 	sc.mutation.SetID(u)
@@ -226,6 +273,26 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 		_node.EnvSecure = value
 	}
+	if value, ok := sc.mutation.VcpuLimit(); ok {
+		_spec.SetField(snapshot.FieldVcpuLimit, field.TypeInt64, value)
+		_node.VcpuLimit = &value
+	}
+	if value, ok := sc.mutation.RAMMBLimit(); ok {
+		_spec.SetField(snapshot.FieldRAMMBLimit, field.TypeInt64, value)
+		_node.RAMMBLimit = &value
+	}
+	if value, ok := sc.mutation.EgressPolicy(); ok {
+		_spec.SetField(snapshot.FieldEgressPolicy, field.TypeJSON, value)
+		_node.EgressPolicy = value
+	}
+	if value, ok := sc.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+		_node.PortAccess = value
+	}
+	if value, ok := sc.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+		_node.Network = value
+	}
 	if nodes := sc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -368,6 +435,108 @@ func (u *SnapshotUpsert) UpdateEnvSecure() *SnapshotUpsert {
 	return u
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (u *SnapshotUpsert) SetVcpuLimit(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldVcpuLimit, v)
+	return u
+}
+
+// UpdateVcpuLimit sets the "vcpu_limit" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateVcpuLimit() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldVcpuLimit)
+	return u
+}
+
+// AddVcpuLimit adds v to the "vcpu_limit" field.
+func (u *SnapshotUpsert) AddVcpuLimit(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldVcpuLimit, v)
+	return u
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (u *SnapshotUpsert) ClearVcpuLimit() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldVcpuLimit)
+	return u
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (u *SnapshotUpsert) SetRAMMBLimit(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldRAMMBLimit, v)
+	return u
+}
+
+// UpdateRAMMBLimit sets the "ram_mb_limit" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateRAMMBLimit() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldRAMMBLimit)
+	return u
+}
+
+// AddRAMMBLimit adds v to the "ram_mb_limit" field.
+func (u *SnapshotUpsert) AddRAMMBLimit(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldRAMMBLimit, v)
+	return u
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (u *SnapshotUpsert) ClearRAMMBLimit() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldRAMMBLimit)
+	return u
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (u *SnapshotUpsert) SetEgressPolicy(v *types.SandboxEgressPolicy) *SnapshotUpsert {
+	u.Set(snapshot.FieldEgressPolicy, v)
+	return u
+}
+
+// UpdateEgressPolicy sets the "egress_policy" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateEgressPolicy() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldEgressPolicy)
+	return u
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (u *SnapshotUpsert) ClearEgressPolicy() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldEgressPolicy)
+	return u
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsert) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsert {
+	u.Set(snapshot.FieldPortAccess, v)
+	return u
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdatePortAccess() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldPortAccess)
+	return u
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsert) ClearPortAccess() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldPortAccess)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsert) SetNetwork(v *types.SandboxNetwork) *SnapshotUpsert {
+	u.Set(snapshot.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateNetwork() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldNetwork)
+	return u
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsert) ClearNetwork() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldNetwork)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -503,6 +672,125 @@ func (u *SnapshotUpsertOne) UpdateEnvSecure() *SnapshotUpsertOne {
 	})
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (u *SnapshotUpsertOne) SetVcpuLimit(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetVcpuLimit(v)
+	})
+}
+
+// AddVcpuLimit adds v to the "vcpu_limit" field.
+func (u *SnapshotUpsertOne) AddVcpuLimit(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddVcpuLimit(v)
+	})
+}
+
+// UpdateVcpuLimit sets the "vcpu_limit" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateVcpuLimit() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateVcpuLimit()
+	})
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (u *SnapshotUpsertOne) ClearVcpuLimit() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearVcpuLimit()
+	})
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (u *SnapshotUpsertOne) SetRAMMBLimit(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRAMMBLimit(v)
+	})
+}
+
+// AddRAMMBLimit adds v to the "ram_mb_limit" field.
+func (u *SnapshotUpsertOne) AddRAMMBLimit(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddRAMMBLimit(v)
+	})
+}
+
+// UpdateRAMMBLimit sets the "ram_mb_limit" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateRAMMBLimit() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRAMMBLimit()
+	})
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (u *SnapshotUpsertOne) ClearRAMMBLimit() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearRAMMBLimit()
+	})
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (u *SnapshotUpsertOne) SetEgressPolicy(v *types.SandboxEgressPolicy) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetEgressPolicy(v)
+	})
+}
+
+// UpdateEgressPolicy sets the "egress_policy" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateEgressPolicy() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateEgressPolicy()
+	})
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (u *SnapshotUpsertOne) ClearEgressPolicy() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearEgressPolicy()
+	})
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsertOne) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPortAccess(v)
+	})
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdatePortAccess() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePortAccess()
+	})
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsertOne) ClearPortAccess() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPortAccess()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertOne) SetNetwork(v *types.SandboxNetwork) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertOne) ClearNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -805,6 +1093,125 @@ func (u *SnapshotUpsertBulk) UpdateEnvSecure() *SnapshotUpsertBulk {
 	})
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (u *SnapshotUpsertBulk) SetVcpuLimit(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetVcpuLimit(v)
+	})
+}
+
+// AddVcpuLimit adds v to the "vcpu_limit" field.
+func (u *SnapshotUpsertBulk) AddVcpuLimit(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddVcpuLimit(v)
+	})
+}
+
+// UpdateVcpuLimit sets the "vcpu_limit" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateVcpuLimit() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateVcpuLimit()
+	})
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (u *SnapshotUpsertBulk) ClearVcpuLimit() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearVcpuLimit()
+	})
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (u *SnapshotUpsertBulk) SetRAMMBLimit(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRAMMBLimit(v)
+	})
+}
+
+// AddRAMMBLimit adds v to the "ram_mb_limit" field.
+func (u *SnapshotUpsertBulk) AddRAMMBLimit(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddRAMMBLimit(v)
+	})
+}
+
+// UpdateRAMMBLimit sets the "ram_mb_limit" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateRAMMBLimit() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRAMMBLimit()
+	})
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (u *SnapshotUpsertBulk) ClearRAMMBLimit() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearRAMMBLimit()
+	})
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (u *SnapshotUpsertBulk) SetEgressPolicy(v *types.SandboxEgressPolicy) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetEgressPolicy(v)
+	})
+}
+
+// UpdateEgressPolicy sets the "egress_policy" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateEgressPolicy() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateEgressPolicy()
+	})
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (u *SnapshotUpsertBulk) ClearEgressPolicy() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearEgressPolicy()
+	})
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsertBulk) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPortAccess(v)
+	})
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdatePortAccess() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePortAccess()
+	})
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsertBulk) ClearPortAccess() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPortAccess()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertBulk) SetNetwork(v *types.SandboxNetwork) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertBulk) ClearNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 // SnapshotUpdate is the builder for updating Snapshot entities.
@@ -107,6 +108,96 @@ func (su *SnapshotUpdate) SetNillableEnvSecure(b *bool) *SnapshotUpdate {
 	return su
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (su *SnapshotUpdate) SetVcpuLimit(i int64) *SnapshotUpdate {
+	su.mutation.ResetVcpuLimit()
+	su.mutation.SetVcpuLimit(i)
+	return su
+}
+
+// SetNillableVcpuLimit sets the "vcpu_limit" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableVcpuLimit(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetVcpuLimit(*i)
+	}
+	return su
+}
+
+// AddVcpuLimit adds i to the "vcpu_limit" field.
+func (su *SnapshotUpdate) AddVcpuLimit(i int64) *SnapshotUpdate {
+	su.mutation.AddVcpuLimit(i)
+	return su
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (su *SnapshotUpdate) ClearVcpuLimit() *SnapshotUpdate {
+	su.mutation.ClearVcpuLimit()
+	return su
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (su *SnapshotUpdate) SetRAMMBLimit(i int64) *SnapshotUpdate {
+	su.mutation.ResetRAMMBLimit()
+	su.mutation.SetRAMMBLimit(i)
+	return su
+}
+
+// SetNillableRAMMBLimit sets the "ram_mb_limit" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableRAMMBLimit(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetRAMMBLimit(*i)
+	}
+	return su
+}
+
+// AddRAMMBLimit adds i to the "ram_mb_limit" field.
+func (su *SnapshotUpdate) AddRAMMBLimit(i int64) *SnapshotUpdate {
+	su.mutation.AddRAMMBLimit(i)
+	return su
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (su *SnapshotUpdate) ClearRAMMBLimit() *SnapshotUpdate {
+	su.mutation.ClearRAMMBLimit()
+	return su
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (su *SnapshotUpdate) SetEgressPolicy(tep *types.SandboxEgressPolicy) *SnapshotUpdate {
+	su.mutation.SetEgressPolicy(tep)
+	return su
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (su *SnapshotUpdate) ClearEgressPolicy() *SnapshotUpdate {
+	su.mutation.ClearEgressPolicy()
+	return su
+}
+
+// SetPortAccess sets the "port_access" field.
+func (su *SnapshotUpdate) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotUpdate {
+	su.mutation.SetPortAccess(tpa)
+	return su
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (su *SnapshotUpdate) ClearPortAccess() *SnapshotUpdate {
+	su.mutation.ClearPortAccess()
+	return su
+}
+
+// SetNetwork sets the "network" field.
+func (su *SnapshotUpdate) SetNetwork(tn *types.SandboxNetwork) *SnapshotUpdate {
+	su.mutation.SetNetwork(tn)
+	return su
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (su *SnapshotUpdate) ClearNetwork() *SnapshotUpdate {
+	su.mutation.ClearNetwork()
+	return su
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (su *SnapshotUpdate) SetEnv(e *Env) *SnapshotUpdate {
 	return su.SetEnvID(e.ID)
@@ -191,6 +282,42 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := su.mutation.VcpuLimit(); ok {
+		_spec.SetField(snapshot.FieldVcpuLimit, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedVcpuLimit(); ok {
+		_spec.AddField(snapshot.FieldVcpuLimit, field.TypeInt64, value)
+	}
+	if su.mutation.VcpuLimitCleared() {
+		_spec.ClearField(snapshot.FieldVcpuLimit, field.TypeInt64)
+	}
+	if value, ok := su.mutation.RAMMBLimit(); ok {
+		_spec.SetField(snapshot.FieldRAMMBLimit, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedRAMMBLimit(); ok {
+		_spec.AddField(snapshot.FieldRAMMBLimit, field.TypeInt64, value)
+	}
+	if su.mutation.RAMMBLimitCleared() {
+		_spec.ClearField(snapshot.FieldRAMMBLimit, field.TypeInt64)
+	}
+	if value, ok := su.mutation.EgressPolicy(); ok {
+		_spec.SetField(snapshot.FieldEgressPolicy, field.TypeJSON, value)
+	}
+	if su.mutation.EgressPolicyCleared() {
+		_spec.ClearField(snapshot.FieldEgressPolicy, field.TypeJSON)
+	}
+	if value, ok := su.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+	}
+	if su.mutation.PortAccessCleared() {
+		_spec.ClearField(snapshot.FieldPortAccess, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if su.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
 	if su.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -322,6 +449,96 @@ func (suo *SnapshotUpdateOne) SetNillableEnvSecure(b *bool) *SnapshotUpdateOne {
 	return suo
 }
 
+// SetVcpuLimit sets the "vcpu_limit" field.
+func (suo *SnapshotUpdateOne) SetVcpuLimit(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetVcpuLimit()
+	suo.mutation.SetVcpuLimit(i)
+	return suo
+}
+
+// SetNillableVcpuLimit sets the "vcpu_limit" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableVcpuLimit(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetVcpuLimit(*i)
+	}
+	return suo
+}
+
+// AddVcpuLimit adds i to the "vcpu_limit" field.
+func (suo *SnapshotUpdateOne) AddVcpuLimit(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddVcpuLimit(i)
+	return suo
+}
+
+// ClearVcpuLimit clears the value of the "vcpu_limit" field.
+func (suo *SnapshotUpdateOne) ClearVcpuLimit() *SnapshotUpdateOne {
+	suo.mutation.ClearVcpuLimit()
+	return suo
+}
+
+// SetRAMMBLimit sets the "ram_mb_limit" field.
+func (suo *SnapshotUpdateOne) SetRAMMBLimit(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetRAMMBLimit()
+	suo.mutation.SetRAMMBLimit(i)
+	return suo
+}
+
+// SetNillableRAMMBLimit sets the "ram_mb_limit" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableRAMMBLimit(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetRAMMBLimit(*i)
+	}
+	return suo
+}
+
+// AddRAMMBLimit adds i to the "ram_mb_limit" field.
+func (suo *SnapshotUpdateOne) AddRAMMBLimit(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddRAMMBLimit(i)
+	return suo
+}
+
+// ClearRAMMBLimit clears the value of the "ram_mb_limit" field.
+func (suo *SnapshotUpdateOne) ClearRAMMBLimit() *SnapshotUpdateOne {
+	suo.mutation.ClearRAMMBLimit()
+	return suo
+}
+
+// SetEgressPolicy sets the "egress_policy" field.
+func (suo *SnapshotUpdateOne) SetEgressPolicy(tep *types.SandboxEgressPolicy) *SnapshotUpdateOne {
+	suo.mutation.SetEgressPolicy(tep)
+	return suo
+}
+
+// ClearEgressPolicy clears the value of the "egress_policy" field.
+func (suo *SnapshotUpdateOne) ClearEgressPolicy() *SnapshotUpdateOne {
+	suo.mutation.ClearEgressPolicy()
+	return suo
+}
+
+// SetPortAccess sets the "port_access" field.
+func (suo *SnapshotUpdateOne) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotUpdateOne {
+	suo.mutation.SetPortAccess(tpa)
+	return suo
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (suo *SnapshotUpdateOne) ClearPortAccess() *SnapshotUpdateOne {
+	suo.mutation.ClearPortAccess()
+	return suo
+}
+
+// SetNetwork sets the "network" field.
+func (suo *SnapshotUpdateOne) SetNetwork(tn *types.SandboxNetwork) *SnapshotUpdateOne {
+	suo.mutation.SetNetwork(tn)
+	return suo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (suo *SnapshotUpdateOne) ClearNetwork() *SnapshotUpdateOne {
+	suo.mutation.ClearNetwork()
+	return suo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (suo *SnapshotUpdateOne) SetEnv(e *Env) *SnapshotUpdateOne {
 	return suo.SetEnvID(e.ID)
@@ -436,6 +653,42 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 	if value, ok := suo.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := suo.mutation.VcpuLimit(); ok {
+		_spec.SetField(snapshot.FieldVcpuLimit, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedVcpuLimit(); ok {
+		_spec.AddField(snapshot.FieldVcpuLimit, field.TypeInt64, value)
+	}
+	if suo.mutation.VcpuLimitCleared() {
+		_spec.ClearField(snapshot.FieldVcpuLimit, field.TypeInt64)
+	}
+	if value, ok := suo.mutation.RAMMBLimit(); ok {
+		_spec.SetField(snapshot.FieldRAMMBLimit, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedRAMMBLimit(); ok {
+		_spec.AddField(snapshot.FieldRAMMBLimit, field.TypeInt64, value)
+	}
+	if suo.mutation.RAMMBLimitCleared() {
+		_spec.ClearField(snapshot.FieldRAMMBLimit, field.TypeInt64)
+	}
+	if value, ok := suo.mutation.EgressPolicy(); ok {
+		_spec.SetField(snapshot.FieldEgressPolicy, field.TypeJSON, value)
+	}
+	if suo.mutation.EgressPolicyCleared() {
+		_spec.ClearField(snapshot.FieldEgressPolicy, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+	}
+	if suo.mutation.PortAccessCleared() {
+		_spec.ClearField(snapshot.FieldPortAccess, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if suo.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
 	if suo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
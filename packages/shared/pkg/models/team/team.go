@@ -38,6 +38,8 @@ const (
 	EdgeTeamTier = "team_tier"
 	// EdgeEnvs holds the string denoting the envs edge name in mutations.
 	EdgeEnvs = "envs"
+	// EdgeVolumes holds the string denoting the volumes edge name in mutations.
+	EdgeVolumes = "volumes"
 	// EdgeUsersTeams holds the string denoting the users_teams edge name in mutations.
 	EdgeUsersTeams = "users_teams"
 	// Table holds the table name of the team in the database.
@@ -68,6 +70,13 @@ const (
 	EnvsInverseTable = "envs"
 	// EnvsColumn is the table column denoting the envs relation/edge.
 	EnvsColumn = "team_id"
+	// VolumesTable is the table that holds the volumes relation/edge.
+	VolumesTable = "volumes"
+	// VolumesInverseTable is the table name for the Volume entity.
+	// It exists in this package in order to avoid circular dependency with the "volume" package.
+	VolumesInverseTable = "volumes"
+	// VolumesColumn is the table column denoting the volumes relation/edge.
+	VolumesColumn = "team_id"
 	// UsersTeamsTable is the table that holds the users_teams relation/edge.
 	UsersTeamsTable = "users_teams"
 	// UsersTeamsInverseTable is the table name for the UsersTeams entity.
@@ -210,6 +219,20 @@ func ByEnvs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByVolumesCount orders the results by volumes count.
+func ByVolumesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVolumesStep(), opts...)
+	}
+}
+
+// ByVolumes orders the results by volumes terms.
+func ByVolumes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVolumesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUsersTeamsCount orders the results by users_teams count.
 func ByUsersTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -251,6 +274,13 @@ func newEnvsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EnvsTable, EnvsColumn),
 	)
 }
+func newVolumesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VolumesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VolumesTable, VolumesColumn),
+	)
+}
 func newUsersTeamsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
 	"github.com/google/uuid"
 )
 
@@ -213,6 +214,21 @@ func (tu *TeamUpdate) AddEnvs(e ...*Env) *TeamUpdate {
 	return tu.AddEnvIDs(ids...)
 }
 
+// AddVolumeIDs adds the "volumes" edge to the Volume entity by IDs.
+func (tu *TeamUpdate) AddVolumeIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.AddVolumeIDs(ids...)
+	return tu
+}
+
+// AddVolumes adds the "volumes" edges to the Volume entity.
+func (tu *TeamUpdate) AddVolumes(v ...*Volume) *TeamUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tu.AddVolumeIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tu *TeamUpdate) AddUsersTeamIDs(ids ...int) *TeamUpdate {
 	tu.mutation.AddUsersTeamIDs(ids...)
@@ -302,6 +318,27 @@ func (tu *TeamUpdate) RemoveEnvs(e ...*Env) *TeamUpdate {
 	return tu.RemoveEnvIDs(ids...)
 }
 
+// ClearVolumes clears all "volumes" edges to the Volume entity.
+func (tu *TeamUpdate) ClearVolumes() *TeamUpdate {
+	tu.mutation.ClearVolumes()
+	return tu
+}
+
+// RemoveVolumeIDs removes the "volumes" edge to Volume entities by IDs.
+func (tu *TeamUpdate) RemoveVolumeIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.RemoveVolumeIDs(ids...)
+	return tu
+}
+
+// RemoveVolumes removes "volumes" edges to Volume entities.
+func (tu *TeamUpdate) RemoveVolumes(v ...*Volume) *TeamUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tu.RemoveVolumeIDs(ids...)
+}
+
 // ClearUsersTeams clears all "users_teams" edges to the UsersTeams entity.
 func (tu *TeamUpdate) ClearUsersTeams() *TeamUpdate {
 	tu.mutation.ClearUsersTeams()
@@ -598,6 +635,54 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.VolumesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Volume
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedVolumesIDs(); len(nodes) > 0 && !tu.mutation.VolumesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.VolumesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.UsersTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -848,6 +933,21 @@ func (tuo *TeamUpdateOne) AddEnvs(e ...*Env) *TeamUpdateOne {
 	return tuo.AddEnvIDs(ids...)
 }
 
+// AddVolumeIDs adds the "volumes" edge to the Volume entity by IDs.
+func (tuo *TeamUpdateOne) AddVolumeIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.AddVolumeIDs(ids...)
+	return tuo
+}
+
+// AddVolumes adds the "volumes" edges to the Volume entity.
+func (tuo *TeamUpdateOne) AddVolumes(v ...*Volume) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tuo.AddVolumeIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tuo *TeamUpdateOne) AddUsersTeamIDs(ids ...int) *TeamUpdateOne {
 	tuo.mutation.AddUsersTeamIDs(ids...)
@@ -937,6 +1037,27 @@ func (tuo *TeamUpdateOne) RemoveEnvs(e ...*Env) *TeamUpdateOne {
 	return tuo.RemoveEnvIDs(ids...)
 }
 
+// ClearVolumes clears all "volumes" edges to the Volume entity.
+func (tuo *TeamUpdateOne) ClearVolumes() *TeamUpdateOne {
+	tuo.mutation.ClearVolumes()
+	return tuo
+}
+
+// RemoveVolumeIDs removes the "volumes" edge to Volume entities by IDs.
+func (tuo *TeamUpdateOne) RemoveVolumeIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.RemoveVolumeIDs(ids...)
+	return tuo
+}
+
+// RemoveVolumes removes "volumes" edges to Volume entities.
+func (tuo *TeamUpdateOne) RemoveVolumes(v ...*Volume) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return tuo.RemoveVolumeIDs(ids...)
+}
+
 // ClearUsersTeams clears all "users_teams" edges to the UsersTeams entity.
 func (tuo *TeamUpdateOne) ClearUsersTeams() *TeamUpdateOne {
 	tuo.mutation.ClearUsersTeams()
@@ -1263,6 +1384,54 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.VolumesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Volume
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedVolumesIDs(); len(nodes) > 0 && !tuo.mutation.VolumesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.VolumesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.VolumesTable,
+			Columns: []string{team.VolumesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.UsersTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
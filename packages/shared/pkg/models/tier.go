@@ -24,6 +24,20 @@ type Tier struct {
 	ConcurrentInstances int64 `json:"concurrent_instances,omitempty"`
 	// MaxLengthHours holds the value of the "max_length_hours" field.
 	MaxLengthHours int64 `json:"max_length_hours,omitempty"`
+	// MaxVcpu holds the value of the "max_vcpu" field.
+	MaxVcpu int64 `json:"max_vcpu,omitempty"`
+	// MaxRAMMB holds the value of the "max_ram_mb" field.
+	MaxRAMMB int64 `json:"max_ram_mb,omitempty"`
+	// The disk bandwidth limit of a sandbox, unlimited when not set
+	DiskBandwidthMBPerSec *int64 `json:"disk_bandwidth_mb_per_sec,omitempty"`
+	// The disk operations per second limit of a sandbox, unlimited when not set
+	DiskIops *int64 `json:"disk_iops,omitempty"`
+	// The network bandwidth limit of a sandbox in each direction, unlimited when not set
+	NetworkBandwidthMBPerSec *int64 `json:"network_bandwidth_mb_per_sec,omitempty"`
+	// The network packets per second limit of a sandbox in each direction, unlimited when not set
+	NetworkPacketsPerSec *int64 `json:"network_packets_per_sec,omitempty"`
+	// The maximum size of a volume created by the team
+	MaxVolumeSizeMB int64 `json:"max_volume_size_mb,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TierQuery when eager-loading is set.
 	Edges        TierEdges `json:"edges"`
@@ -53,7 +67,7 @@ func (*Tier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours:
+		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours, tier.FieldMaxVcpu, tier.FieldMaxRAMMB, tier.FieldDiskBandwidthMBPerSec, tier.FieldDiskIops, tier.FieldNetworkBandwidthMBPerSec, tier.FieldNetworkPacketsPerSec, tier.FieldMaxVolumeSizeMB:
 			values[i] = new(sql.NullInt64)
 		case tier.FieldID, tier.FieldName:
 			values[i] = new(sql.NullString)
@@ -102,6 +116,52 @@ func (t *Tier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.MaxLengthHours = value.Int64
 			}
+		case tier.FieldMaxVcpu:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_vcpu", values[i])
+			} else if value.Valid {
+				t.MaxVcpu = value.Int64
+			}
+		case tier.FieldMaxRAMMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_ram_mb", values[i])
+			} else if value.Valid {
+				t.MaxRAMMB = value.Int64
+			}
+		case tier.FieldDiskBandwidthMBPerSec:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_bandwidth_mb_per_sec", values[i])
+			} else if value.Valid {
+				t.DiskBandwidthMBPerSec = new(int64)
+				*t.DiskBandwidthMBPerSec = value.Int64
+			}
+		case tier.FieldDiskIops:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_iops", values[i])
+			} else if value.Valid {
+				t.DiskIops = new(int64)
+				*t.DiskIops = value.Int64
+			}
+		case tier.FieldNetworkBandwidthMBPerSec:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_bandwidth_mb_per_sec", values[i])
+			} else if value.Valid {
+				t.NetworkBandwidthMBPerSec = new(int64)
+				*t.NetworkBandwidthMBPerSec = value.Int64
+			}
+		case tier.FieldNetworkPacketsPerSec:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_packets_per_sec", values[i])
+			} else if value.Valid {
+				t.NetworkPacketsPerSec = new(int64)
+				*t.NetworkPacketsPerSec = value.Int64
+			}
+		case tier.FieldMaxVolumeSizeMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_volume_size_mb", values[i])
+			} else if value.Valid {
+				t.MaxVolumeSizeMB = value.Int64
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +214,35 @@ func (t *Tier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_length_hours=")
 	builder.WriteString(fmt.Sprintf("%v", t.MaxLengthHours))
+	builder.WriteString(", ")
+	builder.WriteString("max_vcpu=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxVcpu))
+	builder.WriteString(", ")
+	builder.WriteString("max_ram_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxRAMMB))
+	builder.WriteString(", ")
+	if v := t.DiskBandwidthMBPerSec; v != nil {
+		builder.WriteString("disk_bandwidth_mb_per_sec=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.DiskIops; v != nil {
+		builder.WriteString("disk_iops=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.NetworkBandwidthMBPerSec; v != nil {
+		builder.WriteString("network_bandwidth_mb_per_sec=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.NetworkPacketsPerSec; v != nil {
+		builder.WriteString("network_packets_per_sec=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_volume_size_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxVolumeSizeMB))
 	builder.WriteByte(')')
 	return builder.String()
 }
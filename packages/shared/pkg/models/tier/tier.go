@@ -20,6 +20,20 @@ const (
 	FieldConcurrentInstances = "concurrent_instances"
 	// FieldMaxLengthHours holds the string denoting the max_length_hours field in the database.
 	FieldMaxLengthHours = "max_length_hours"
+	// FieldMaxVcpu holds the string denoting the max_vcpu field in the database.
+	FieldMaxVcpu = "max_vcpu"
+	// FieldMaxRAMMB holds the string denoting the max_ram_mb field in the database.
+	FieldMaxRAMMB = "max_ram_mb"
+	// FieldDiskBandwidthMBPerSec holds the string denoting the disk_bandwidth_mb_per_sec field in the database.
+	FieldDiskBandwidthMBPerSec = "disk_bandwidth_mb_per_sec"
+	// FieldDiskIops holds the string denoting the disk_iops field in the database.
+	FieldDiskIops = "disk_iops"
+	// FieldNetworkBandwidthMBPerSec holds the string denoting the network_bandwidth_mb_per_sec field in the database.
+	FieldNetworkBandwidthMBPerSec = "network_bandwidth_mb_per_sec"
+	// FieldNetworkPacketsPerSec holds the string denoting the network_packets_per_sec field in the database.
+	FieldNetworkPacketsPerSec = "network_packets_per_sec"
+	// FieldMaxVolumeSizeMB holds the string denoting the max_volume_size_mb field in the database.
+	FieldMaxVolumeSizeMB = "max_volume_size_mb"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// Table holds the table name of the tier in the database.
@@ -40,6 +54,13 @@ var Columns = []string{
 	FieldDiskMB,
 	FieldConcurrentInstances,
 	FieldMaxLengthHours,
+	FieldMaxVcpu,
+	FieldMaxRAMMB,
+	FieldDiskBandwidthMBPerSec,
+	FieldDiskIops,
+	FieldNetworkBandwidthMBPerSec,
+	FieldNetworkPacketsPerSec,
+	FieldMaxVolumeSizeMB,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +101,41 @@ func ByMaxLengthHours(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxLengthHours, opts...).ToFunc()
 }
 
+// ByMaxVcpu orders the results by the max_vcpu field.
+func ByMaxVcpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVcpu, opts...).ToFunc()
+}
+
+// ByMaxRAMMB orders the results by the max_ram_mb field.
+func ByMaxRAMMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRAMMB, opts...).ToFunc()
+}
+
+// ByDiskBandwidthMBPerSec orders the results by the disk_bandwidth_mb_per_sec field.
+func ByDiskBandwidthMBPerSec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskBandwidthMBPerSec, opts...).ToFunc()
+}
+
+// ByDiskIops orders the results by the disk_iops field.
+func ByDiskIops(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskIops, opts...).ToFunc()
+}
+
+// ByNetworkBandwidthMBPerSec orders the results by the network_bandwidth_mb_per_sec field.
+func ByNetworkBandwidthMBPerSec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkBandwidthMBPerSec, opts...).ToFunc()
+}
+
+// ByNetworkPacketsPerSec orders the results by the network_packets_per_sec field.
+func ByNetworkPacketsPerSec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkPacketsPerSec, opts...).ToFunc()
+}
+
+// ByMaxVolumeSizeMB orders the results by the max_volume_size_mb field.
+func ByMaxVolumeSizeMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVolumeSizeMB, opts...).ToFunc()
+}
+
 // ByTeamsCount orders the results by teams count.
 func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
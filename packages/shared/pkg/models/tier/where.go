@@ -84,6 +84,41 @@ func MaxLengthHours(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldMaxLengthHours, v))
 }
 
+// MaxVcpu applies equality check predicate on the "max_vcpu" field. It's identical to MaxVcpuEQ.
+func MaxVcpu(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxRAMMB applies equality check predicate on the "max_ram_mb" field. It's identical to MaxRAMMBEQ.
+func MaxRAMMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// DiskBandwidthMBPerSec applies equality check predicate on the "disk_bandwidth_mb_per_sec" field. It's identical to DiskBandwidthMBPerSecEQ.
+func DiskBandwidthMBPerSec(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskIops applies equality check predicate on the "disk_iops" field. It's identical to DiskIopsEQ.
+func DiskIops(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// NetworkBandwidthMBPerSec applies equality check predicate on the "network_bandwidth_mb_per_sec" field. It's identical to NetworkBandwidthMBPerSecEQ.
+func NetworkBandwidthMBPerSec(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkPacketsPerSec applies equality check predicate on the "network_packets_per_sec" field. It's identical to NetworkPacketsPerSecEQ.
+func NetworkPacketsPerSec(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkPacketsPerSec, v))
+}
+
+// MaxVolumeSizeMB applies equality check predicate on the "max_volume_size_mb" field. It's identical to MaxVolumeSizeMBEQ.
+func MaxVolumeSizeMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVolumeSizeMB, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldName, v))
@@ -269,6 +304,326 @@ func MaxLengthHoursLTE(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldLTE(FieldMaxLengthHours, v))
 }
 
+// MaxVcpuEQ applies the EQ predicate on the "max_vcpu" field.
+func MaxVcpuEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuNEQ applies the NEQ predicate on the "max_vcpu" field.
+func MaxVcpuNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuIn applies the In predicate on the "max_vcpu" field.
+func MaxVcpuIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuNotIn applies the NotIn predicate on the "max_vcpu" field.
+func MaxVcpuNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuGT applies the GT predicate on the "max_vcpu" field.
+func MaxVcpuGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuGTE applies the GTE predicate on the "max_vcpu" field.
+func MaxVcpuGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLT applies the LT predicate on the "max_vcpu" field.
+func MaxVcpuLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLTE applies the LTE predicate on the "max_vcpu" field.
+func MaxVcpuLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxVcpu, v))
+}
+
+// MaxRAMMBEQ applies the EQ predicate on the "max_ram_mb" field.
+func MaxRAMMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBNEQ applies the NEQ predicate on the "max_ram_mb" field.
+func MaxRAMMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBIn applies the In predicate on the "max_ram_mb" field.
+func MaxRAMMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBNotIn applies the NotIn predicate on the "max_ram_mb" field.
+func MaxRAMMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBGT applies the GT predicate on the "max_ram_mb" field.
+func MaxRAMMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBGTE applies the GTE predicate on the "max_ram_mb" field.
+func MaxRAMMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLT applies the LT predicate on the "max_ram_mb" field.
+func MaxRAMMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLTE applies the LTE predicate on the "max_ram_mb" field.
+func MaxRAMMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxRAMMB, v))
+}
+
+// DiskBandwidthMBPerSecEQ applies the EQ predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecNEQ applies the NEQ predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecIn applies the In predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskBandwidthMBPerSec, vs...))
+}
+
+// DiskBandwidthMBPerSecNotIn applies the NotIn predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskBandwidthMBPerSec, vs...))
+}
+
+// DiskBandwidthMBPerSecGT applies the GT predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecGTE applies the GTE predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecLT applies the LT predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecLTE applies the LTE predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskBandwidthMBPerSec, v))
+}
+
+// DiskBandwidthMBPerSecIsNil applies the IsNil predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldDiskBandwidthMBPerSec))
+}
+
+// DiskBandwidthMBPerSecNotNil applies the NotNil predicate on the "disk_bandwidth_mb_per_sec" field.
+func DiskBandwidthMBPerSecNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldDiskBandwidthMBPerSec))
+}
+
+// DiskIopsEQ applies the EQ predicate on the "disk_iops" field.
+func DiskIopsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// DiskIopsNEQ applies the NEQ predicate on the "disk_iops" field.
+func DiskIopsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskIops, v))
+}
+
+// DiskIopsIn applies the In predicate on the "disk_iops" field.
+func DiskIopsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsNotIn applies the NotIn predicate on the "disk_iops" field.
+func DiskIopsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsGT applies the GT predicate on the "disk_iops" field.
+func DiskIopsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskIops, v))
+}
+
+// DiskIopsGTE applies the GTE predicate on the "disk_iops" field.
+func DiskIopsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskIops, v))
+}
+
+// DiskIopsLT applies the LT predicate on the "disk_iops" field.
+func DiskIopsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskIops, v))
+}
+
+// DiskIopsLTE applies the LTE predicate on the "disk_iops" field.
+func DiskIopsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskIops, v))
+}
+
+// DiskIopsIsNil applies the IsNil predicate on the "disk_iops" field.
+func DiskIopsIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldDiskIops))
+}
+
+// DiskIopsNotNil applies the NotNil predicate on the "disk_iops" field.
+func DiskIopsNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldDiskIops))
+}
+
+// NetworkBandwidthMBPerSecEQ applies the EQ predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecNEQ applies the NEQ predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecIn applies the In predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldNetworkBandwidthMBPerSec, vs...))
+}
+
+// NetworkBandwidthMBPerSecNotIn applies the NotIn predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldNetworkBandwidthMBPerSec, vs...))
+}
+
+// NetworkBandwidthMBPerSecGT applies the GT predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecGTE applies the GTE predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecLT applies the LT predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecLTE applies the LTE predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldNetworkBandwidthMBPerSec, v))
+}
+
+// NetworkBandwidthMBPerSecIsNil applies the IsNil predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldNetworkBandwidthMBPerSec))
+}
+
+// NetworkBandwidthMBPerSecNotNil applies the NotNil predicate on the "network_bandwidth_mb_per_sec" field.
+func NetworkBandwidthMBPerSecNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldNetworkBandwidthMBPerSec))
+}
+
+// NetworkPacketsPerSecEQ applies the EQ predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecNEQ applies the NEQ predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecIn applies the In predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldNetworkPacketsPerSec, vs...))
+}
+
+// NetworkPacketsPerSecNotIn applies the NotIn predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldNetworkPacketsPerSec, vs...))
+}
+
+// NetworkPacketsPerSecGT applies the GT predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecGTE applies the GTE predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecLT applies the LT predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecLTE applies the LTE predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldNetworkPacketsPerSec, v))
+}
+
+// NetworkPacketsPerSecIsNil applies the IsNil predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecIsNil() predicate.Tier {
+	return predicate.Tier(sql.FieldIsNull(FieldNetworkPacketsPerSec))
+}
+
+// NetworkPacketsPerSecNotNil applies the NotNil predicate on the "network_packets_per_sec" field.
+func NetworkPacketsPerSecNotNil() predicate.Tier {
+	return predicate.Tier(sql.FieldNotNull(FieldNetworkPacketsPerSec))
+}
+
+// MaxVolumeSizeMBEQ applies the EQ predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVolumeSizeMB, v))
+}
+
+// MaxVolumeSizeMBNEQ applies the NEQ predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxVolumeSizeMB, v))
+}
+
+// MaxVolumeSizeMBIn applies the In predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxVolumeSizeMB, vs...))
+}
+
+// MaxVolumeSizeMBNotIn applies the NotIn predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxVolumeSizeMB, vs...))
+}
+
+// MaxVolumeSizeMBGT applies the GT predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxVolumeSizeMB, v))
+}
+
+// MaxVolumeSizeMBGTE applies the GTE predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxVolumeSizeMB, v))
+}
+
+// MaxVolumeSizeMBLT applies the LT predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxVolumeSizeMB, v))
+}
+
+// MaxVolumeSizeMBLTE applies the LTE predicate on the "max_volume_size_mb" field.
+func MaxVolumeSizeMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxVolumeSizeMB, v))
+}
+
 // HasTeams applies the HasEdge predicate on the "teams" edge.
 func HasTeams() predicate.Tier {
 	return predicate.Tier(func(s *sql.Selector) {
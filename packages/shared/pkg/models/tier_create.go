@@ -48,6 +48,80 @@ func (tc *TierCreate) SetMaxLengthHours(i int64) *TierCreate {
 	return tc
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tc *TierCreate) SetMaxVcpu(i int64) *TierCreate {
+	tc.mutation.SetMaxVcpu(i)
+	return tc
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tc *TierCreate) SetMaxRAMMB(i int64) *TierCreate {
+	tc.mutation.SetMaxRAMMB(i)
+	return tc
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (tc *TierCreate) SetDiskBandwidthMBPerSec(i int64) *TierCreate {
+	tc.mutation.SetDiskBandwidthMBPerSec(i)
+	return tc
+}
+
+// SetNillableDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tc *TierCreate) SetNillableDiskBandwidthMBPerSec(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetDiskBandwidthMBPerSec(*i)
+	}
+	return tc
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tc *TierCreate) SetDiskIops(i int64) *TierCreate {
+	tc.mutation.SetDiskIops(i)
+	return tc
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tc *TierCreate) SetNillableDiskIops(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetDiskIops(*i)
+	}
+	return tc
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (tc *TierCreate) SetNetworkBandwidthMBPerSec(i int64) *TierCreate {
+	tc.mutation.SetNetworkBandwidthMBPerSec(i)
+	return tc
+}
+
+// SetNillableNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tc *TierCreate) SetNillableNetworkBandwidthMBPerSec(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetNetworkBandwidthMBPerSec(*i)
+	}
+	return tc
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (tc *TierCreate) SetNetworkPacketsPerSec(i int64) *TierCreate {
+	tc.mutation.SetNetworkPacketsPerSec(i)
+	return tc
+}
+
+// SetNillableNetworkPacketsPerSec sets the "network_packets_per_sec" field if the given value is not nil.
+func (tc *TierCreate) SetNillableNetworkPacketsPerSec(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetNetworkPacketsPerSec(*i)
+	}
+	return tc
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (tc *TierCreate) SetMaxVolumeSizeMB(i int64) *TierCreate {
+	tc.mutation.SetMaxVolumeSizeMB(i)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TierCreate) SetID(s string) *TierCreate {
 	tc.mutation.SetID(s)
@@ -115,6 +189,15 @@ func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.MaxLengthHours(); !ok {
 		return &ValidationError{Name: "max_length_hours", err: errors.New(`models: missing required field "Tier.max_length_hours"`)}
 	}
+	if _, ok := tc.mutation.MaxVcpu(); !ok {
+		return &ValidationError{Name: "max_vcpu", err: errors.New(`models: missing required field "Tier.max_vcpu"`)}
+	}
+	if _, ok := tc.mutation.MaxRAMMB(); !ok {
+		return &ValidationError{Name: "max_ram_mb", err: errors.New(`models: missing required field "Tier.max_ram_mb"`)}
+	}
+	if _, ok := tc.mutation.MaxVolumeSizeMB(); !ok {
+		return &ValidationError{Name: "max_volume_size_mb", err: errors.New(`models: missing required field "Tier.max_volume_size_mb"`)}
+	}
 	return nil
 }
 
@@ -168,6 +251,34 @@ func (tc *TierCreate) createSpec() (*Tier, *sqlgraph.CreateSpec) {
 		_spec.SetField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 		_node.MaxLengthHours = value
 	}
+	if value, ok := tc.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+		_node.MaxVcpu = value
+	}
+	if value, ok := tc.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+		_node.MaxRAMMB = value
+	}
+	if value, ok := tc.mutation.DiskBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64, value)
+		_node.DiskBandwidthMBPerSec = &value
+	}
+	if value, ok := tc.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+		_node.DiskIops = &value
+	}
+	if value, ok := tc.mutation.NetworkBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64, value)
+		_node.NetworkBandwidthMBPerSec = &value
+	}
+	if value, ok := tc.mutation.NetworkPacketsPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkPacketsPerSec, field.TypeInt64, value)
+		_node.NetworkPacketsPerSec = &value
+	}
+	if value, ok := tc.mutation.MaxVolumeSizeMB(); ok {
+		_spec.SetField(tier.FieldMaxVolumeSizeMB, field.TypeInt64, value)
+		_node.MaxVolumeSizeMB = value
+	}
 	if nodes := tc.mutation.TeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -303,6 +414,156 @@ func (u *TierUpsert) AddMaxLengthHours(v int64) *TierUpsert {
 	return u
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsert) SetMaxVcpu(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxVcpu() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxVcpu)
+	return u
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsert) AddMaxVcpu(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsert) SetMaxRAMMB(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxRAMMB() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxRAMMB)
+	return u
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsert) AddMaxRAMMB(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) SetDiskBandwidthMBPerSec(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskBandwidthMBPerSec, v)
+	return u
+}
+
+// UpdateDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskBandwidthMBPerSec() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskBandwidthMBPerSec)
+	return u
+}
+
+// AddDiskBandwidthMBPerSec adds v to the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) AddDiskBandwidthMBPerSec(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskBandwidthMBPerSec, v)
+	return u
+}
+
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) ClearDiskBandwidthMBPerSec() *TierUpsert {
+	u.SetNull(tier.FieldDiskBandwidthMBPerSec)
+	return u
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsert) SetDiskIops(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskIops, v)
+	return u
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskIops() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskIops)
+	return u
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsert) AddDiskIops(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskIops, v)
+	return u
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *TierUpsert) ClearDiskIops() *TierUpsert {
+	u.SetNull(tier.FieldDiskIops)
+	return u
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) SetNetworkBandwidthMBPerSec(v int64) *TierUpsert {
+	u.Set(tier.FieldNetworkBandwidthMBPerSec, v)
+	return u
+}
+
+// UpdateNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsert) UpdateNetworkBandwidthMBPerSec() *TierUpsert {
+	u.SetExcluded(tier.FieldNetworkBandwidthMBPerSec)
+	return u
+}
+
+// AddNetworkBandwidthMBPerSec adds v to the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) AddNetworkBandwidthMBPerSec(v int64) *TierUpsert {
+	u.Add(tier.FieldNetworkBandwidthMBPerSec, v)
+	return u
+}
+
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsert) ClearNetworkBandwidthMBPerSec() *TierUpsert {
+	u.SetNull(tier.FieldNetworkBandwidthMBPerSec)
+	return u
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (u *TierUpsert) SetNetworkPacketsPerSec(v int64) *TierUpsert {
+	u.Set(tier.FieldNetworkPacketsPerSec, v)
+	return u
+}
+
+// UpdateNetworkPacketsPerSec sets the "network_packets_per_sec" field to the value that was provided on create.
+func (u *TierUpsert) UpdateNetworkPacketsPerSec() *TierUpsert {
+	u.SetExcluded(tier.FieldNetworkPacketsPerSec)
+	return u
+}
+
+// AddNetworkPacketsPerSec adds v to the "network_packets_per_sec" field.
+func (u *TierUpsert) AddNetworkPacketsPerSec(v int64) *TierUpsert {
+	u.Add(tier.FieldNetworkPacketsPerSec, v)
+	return u
+}
+
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (u *TierUpsert) ClearNetworkPacketsPerSec() *TierUpsert {
+	u.SetNull(tier.FieldNetworkPacketsPerSec)
+	return u
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (u *TierUpsert) SetMaxVolumeSizeMB(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxVolumeSizeMB, v)
+	return u
+}
+
+// UpdateMaxVolumeSizeMB sets the "max_volume_size_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxVolumeSizeMB() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxVolumeSizeMB)
+	return u
+}
+
+// AddMaxVolumeSizeMB adds v to the "max_volume_size_mb" field.
+func (u *TierUpsert) AddMaxVolumeSizeMB(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxVolumeSizeMB, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -428,6 +689,181 @@ func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
 	})
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertOne) SetMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertOne) AddMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxVcpu() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertOne) SetMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertOne) AddMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxRAMMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) SetDiskBandwidthMBPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMBPerSec(v)
+	})
+}
+
+// AddDiskBandwidthMBPerSec adds v to the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) AddDiskBandwidthMBPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMBPerSec(v)
+	})
+}
+
+// UpdateDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskBandwidthMBPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMBPerSec()
+	})
+}
+
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) ClearDiskBandwidthMBPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearDiskBandwidthMBPerSec()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertOne) SetDiskIops(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertOne) AddDiskIops(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskIops() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *TierUpsertOne) ClearDiskIops() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearDiskIops()
+	})
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) SetNetworkBandwidthMBPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMBPerSec(v)
+	})
+}
+
+// AddNetworkBandwidthMBPerSec adds v to the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) AddNetworkBandwidthMBPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMBPerSec(v)
+	})
+}
+
+// UpdateNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateNetworkBandwidthMBPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMBPerSec()
+	})
+}
+
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertOne) ClearNetworkBandwidthMBPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearNetworkBandwidthMBPerSec()
+	})
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (u *TierUpsertOne) SetNetworkPacketsPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkPacketsPerSec(v)
+	})
+}
+
+// AddNetworkPacketsPerSec adds v to the "network_packets_per_sec" field.
+func (u *TierUpsertOne) AddNetworkPacketsPerSec(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkPacketsPerSec(v)
+	})
+}
+
+// UpdateNetworkPacketsPerSec sets the "network_packets_per_sec" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateNetworkPacketsPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkPacketsPerSec()
+	})
+}
+
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (u *TierUpsertOne) ClearNetworkPacketsPerSec() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearNetworkPacketsPerSec()
+	})
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (u *TierUpsertOne) SetMaxVolumeSizeMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVolumeSizeMB(v)
+	})
+}
+
+// AddMaxVolumeSizeMB adds v to the "max_volume_size_mb" field.
+func (u *TierUpsertOne) AddMaxVolumeSizeMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVolumeSizeMB(v)
+	})
+}
+
+// UpdateMaxVolumeSizeMB sets the "max_volume_size_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxVolumeSizeMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVolumeSizeMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -719,6 +1155,181 @@ func (u *TierUpsertBulk) UpdateMaxLengthHours() *TierUpsertBulk {
 	})
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertBulk) SetMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertBulk) AddMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxVcpu() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertBulk) SetMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertBulk) AddMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxRAMMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) SetDiskBandwidthMBPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMBPerSec(v)
+	})
+}
+
+// AddDiskBandwidthMBPerSec adds v to the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) AddDiskBandwidthMBPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMBPerSec(v)
+	})
+}
+
+// UpdateDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskBandwidthMBPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMBPerSec()
+	})
+}
+
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) ClearDiskBandwidthMBPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearDiskBandwidthMBPerSec()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertBulk) SetDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertBulk) AddDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskIops() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *TierUpsertBulk) ClearDiskIops() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearDiskIops()
+	})
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) SetNetworkBandwidthMBPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMBPerSec(v)
+	})
+}
+
+// AddNetworkBandwidthMBPerSec adds v to the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) AddNetworkBandwidthMBPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMBPerSec(v)
+	})
+}
+
+// UpdateNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateNetworkBandwidthMBPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMBPerSec()
+	})
+}
+
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (u *TierUpsertBulk) ClearNetworkBandwidthMBPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearNetworkBandwidthMBPerSec()
+	})
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (u *TierUpsertBulk) SetNetworkPacketsPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkPacketsPerSec(v)
+	})
+}
+
+// AddNetworkPacketsPerSec adds v to the "network_packets_per_sec" field.
+func (u *TierUpsertBulk) AddNetworkPacketsPerSec(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkPacketsPerSec(v)
+	})
+}
+
+// UpdateNetworkPacketsPerSec sets the "network_packets_per_sec" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateNetworkPacketsPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkPacketsPerSec()
+	})
+}
+
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (u *TierUpsertBulk) ClearNetworkPacketsPerSec() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.ClearNetworkPacketsPerSec()
+	})
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (u *TierUpsertBulk) SetMaxVolumeSizeMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVolumeSizeMB(v)
+	})
+}
+
+// AddMaxVolumeSizeMB adds v to the "max_volume_size_mb" field.
+func (u *TierUpsertBulk) AddMaxVolumeSizeMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVolumeSizeMB(v)
+	})
+}
+
+// UpdateMaxVolumeSizeMB sets the "max_volume_size_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxVolumeSizeMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVolumeSizeMB()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
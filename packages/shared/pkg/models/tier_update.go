@@ -108,6 +108,177 @@ func (tu *TierUpdate) AddMaxLengthHours(i int64) *TierUpdate {
 	return tu
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tu *TierUpdate) SetMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.ResetMaxVcpu()
+	tu.mutation.SetMaxVcpu(i)
+	return tu
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxVcpu(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxVcpu(*i)
+	}
+	return tu
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tu *TierUpdate) AddMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.AddMaxVcpu(i)
+	return tu
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tu *TierUpdate) SetMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.ResetMaxRAMMB()
+	tu.mutation.SetMaxRAMMB(i)
+	return tu
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxRAMMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxRAMMB(*i)
+	}
+	return tu
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tu *TierUpdate) AddMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.AddMaxRAMMB(i)
+	return tu
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) SetDiskBandwidthMBPerSec(i int64) *TierUpdate {
+	tu.mutation.ResetDiskBandwidthMBPerSec()
+	tu.mutation.SetDiskBandwidthMBPerSec(i)
+	return tu
+}
+
+// SetNillableDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskBandwidthMBPerSec(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskBandwidthMBPerSec(*i)
+	}
+	return tu
+}
+
+// AddDiskBandwidthMBPerSec adds i to the "disk_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) AddDiskBandwidthMBPerSec(i int64) *TierUpdate {
+	tu.mutation.AddDiskBandwidthMBPerSec(i)
+	return tu
+}
+
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) ClearDiskBandwidthMBPerSec() *TierUpdate {
+	tu.mutation.ClearDiskBandwidthMBPerSec()
+	return tu
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tu *TierUpdate) SetDiskIops(i int64) *TierUpdate {
+	tu.mutation.ResetDiskIops()
+	tu.mutation.SetDiskIops(i)
+	return tu
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskIops(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskIops(*i)
+	}
+	return tu
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tu *TierUpdate) AddDiskIops(i int64) *TierUpdate {
+	tu.mutation.AddDiskIops(i)
+	return tu
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (tu *TierUpdate) ClearDiskIops() *TierUpdate {
+	tu.mutation.ClearDiskIops()
+	return tu
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) SetNetworkBandwidthMBPerSec(i int64) *TierUpdate {
+	tu.mutation.ResetNetworkBandwidthMBPerSec()
+	tu.mutation.SetNetworkBandwidthMBPerSec(i)
+	return tu
+}
+
+// SetNillableNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableNetworkBandwidthMBPerSec(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetNetworkBandwidthMBPerSec(*i)
+	}
+	return tu
+}
+
+// AddNetworkBandwidthMBPerSec adds i to the "network_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) AddNetworkBandwidthMBPerSec(i int64) *TierUpdate {
+	tu.mutation.AddNetworkBandwidthMBPerSec(i)
+	return tu
+}
+
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (tu *TierUpdate) ClearNetworkBandwidthMBPerSec() *TierUpdate {
+	tu.mutation.ClearNetworkBandwidthMBPerSec()
+	return tu
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (tu *TierUpdate) SetNetworkPacketsPerSec(i int64) *TierUpdate {
+	tu.mutation.ResetNetworkPacketsPerSec()
+	tu.mutation.SetNetworkPacketsPerSec(i)
+	return tu
+}
+
+// SetNillableNetworkPacketsPerSec sets the "network_packets_per_sec" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableNetworkPacketsPerSec(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetNetworkPacketsPerSec(*i)
+	}
+	return tu
+}
+
+// AddNetworkPacketsPerSec adds i to the "network_packets_per_sec" field.
+func (tu *TierUpdate) AddNetworkPacketsPerSec(i int64) *TierUpdate {
+	tu.mutation.AddNetworkPacketsPerSec(i)
+	return tu
+}
+
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (tu *TierUpdate) ClearNetworkPacketsPerSec() *TierUpdate {
+	tu.mutation.ClearNetworkPacketsPerSec()
+	return tu
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (tu *TierUpdate) SetMaxVolumeSizeMB(i int64) *TierUpdate {
+	tu.mutation.ResetMaxVolumeSizeMB()
+	tu.mutation.SetMaxVolumeSizeMB(i)
+	return tu
+}
+
+// SetNillableMaxVolumeSizeMB sets the "max_volume_size_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxVolumeSizeMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxVolumeSizeMB(*i)
+	}
+	return tu
+}
+
+// AddMaxVolumeSizeMB adds i to the "max_volume_size_mb" field.
+func (tu *TierUpdate) AddMaxVolumeSizeMB(i int64) *TierUpdate {
+	tu.mutation.AddMaxVolumeSizeMB(i)
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TierUpdate) AddTeamIDs(ids ...uuid.UUID) *TierUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -212,6 +383,60 @@ func (tu *TierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tu.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.DiskBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskBandwidthMBPerSec(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if tu.mutation.DiskBandwidthMBPerSecCleared() {
+		_spec.ClearField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if tu.mutation.DiskIopsCleared() {
+		_spec.ClearField(tier.FieldDiskIops, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.NetworkBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedNetworkBandwidthMBPerSec(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if tu.mutation.NetworkBandwidthMBPerSecCleared() {
+		_spec.ClearField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.NetworkPacketsPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkPacketsPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedNetworkPacketsPerSec(); ok {
+		_spec.AddField(tier.FieldNetworkPacketsPerSec, field.TypeInt64, value)
+	}
+	if tu.mutation.NetworkPacketsPerSecCleared() {
+		_spec.ClearField(tier.FieldNetworkPacketsPerSec, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.MaxVolumeSizeMB(); ok {
+		_spec.SetField(tier.FieldMaxVolumeSizeMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxVolumeSizeMB(); ok {
+		_spec.AddField(tier.FieldMaxVolumeSizeMB, field.TypeInt64, value)
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -361,6 +586,177 @@ func (tuo *TierUpdateOne) AddMaxLengthHours(i int64) *TierUpdateOne {
 	return tuo
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tuo *TierUpdateOne) SetMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxVcpu()
+	tuo.mutation.SetMaxVcpu(i)
+	return tuo
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxVcpu(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxVcpu(*i)
+	}
+	return tuo
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tuo *TierUpdateOne) AddMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxVcpu(i)
+	return tuo
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tuo *TierUpdateOne) SetMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxRAMMB()
+	tuo.mutation.SetMaxRAMMB(i)
+	return tuo
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxRAMMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxRAMMB(*i)
+	}
+	return tuo
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tuo *TierUpdateOne) AddMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxRAMMB(i)
+	return tuo
+}
+
+// SetDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) SetDiskBandwidthMBPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskBandwidthMBPerSec()
+	tuo.mutation.SetDiskBandwidthMBPerSec(i)
+	return tuo
+}
+
+// SetNillableDiskBandwidthMBPerSec sets the "disk_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskBandwidthMBPerSec(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskBandwidthMBPerSec(*i)
+	}
+	return tuo
+}
+
+// AddDiskBandwidthMBPerSec adds i to the "disk_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) AddDiskBandwidthMBPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskBandwidthMBPerSec(i)
+	return tuo
+}
+
+// ClearDiskBandwidthMBPerSec clears the value of the "disk_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) ClearDiskBandwidthMBPerSec() *TierUpdateOne {
+	tuo.mutation.ClearDiskBandwidthMBPerSec()
+	return tuo
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tuo *TierUpdateOne) SetDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskIops()
+	tuo.mutation.SetDiskIops(i)
+	return tuo
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskIops(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskIops(*i)
+	}
+	return tuo
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tuo *TierUpdateOne) AddDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskIops(i)
+	return tuo
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (tuo *TierUpdateOne) ClearDiskIops() *TierUpdateOne {
+	tuo.mutation.ClearDiskIops()
+	return tuo
+}
+
+// SetNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) SetNetworkBandwidthMBPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.ResetNetworkBandwidthMBPerSec()
+	tuo.mutation.SetNetworkBandwidthMBPerSec(i)
+	return tuo
+}
+
+// SetNillableNetworkBandwidthMBPerSec sets the "network_bandwidth_mb_per_sec" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableNetworkBandwidthMBPerSec(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetNetworkBandwidthMBPerSec(*i)
+	}
+	return tuo
+}
+
+// AddNetworkBandwidthMBPerSec adds i to the "network_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) AddNetworkBandwidthMBPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.AddNetworkBandwidthMBPerSec(i)
+	return tuo
+}
+
+// ClearNetworkBandwidthMBPerSec clears the value of the "network_bandwidth_mb_per_sec" field.
+func (tuo *TierUpdateOne) ClearNetworkBandwidthMBPerSec() *TierUpdateOne {
+	tuo.mutation.ClearNetworkBandwidthMBPerSec()
+	return tuo
+}
+
+// SetNetworkPacketsPerSec sets the "network_packets_per_sec" field.
+func (tuo *TierUpdateOne) SetNetworkPacketsPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.ResetNetworkPacketsPerSec()
+	tuo.mutation.SetNetworkPacketsPerSec(i)
+	return tuo
+}
+
+// SetNillableNetworkPacketsPerSec sets the "network_packets_per_sec" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableNetworkPacketsPerSec(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetNetworkPacketsPerSec(*i)
+	}
+	return tuo
+}
+
+// AddNetworkPacketsPerSec adds i to the "network_packets_per_sec" field.
+func (tuo *TierUpdateOne) AddNetworkPacketsPerSec(i int64) *TierUpdateOne {
+	tuo.mutation.AddNetworkPacketsPerSec(i)
+	return tuo
+}
+
+// ClearNetworkPacketsPerSec clears the value of the "network_packets_per_sec" field.
+func (tuo *TierUpdateOne) ClearNetworkPacketsPerSec() *TierUpdateOne {
+	tuo.mutation.ClearNetworkPacketsPerSec()
+	return tuo
+}
+
+// SetMaxVolumeSizeMB sets the "max_volume_size_mb" field.
+func (tuo *TierUpdateOne) SetMaxVolumeSizeMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxVolumeSizeMB()
+	tuo.mutation.SetMaxVolumeSizeMB(i)
+	return tuo
+}
+
+// SetNillableMaxVolumeSizeMB sets the "max_volume_size_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxVolumeSizeMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxVolumeSizeMB(*i)
+	}
+	return tuo
+}
+
+// AddMaxVolumeSizeMB adds i to the "max_volume_size_mb" field.
+func (tuo *TierUpdateOne) AddMaxVolumeSizeMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxVolumeSizeMB(i)
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TierUpdateOne) AddTeamIDs(ids ...uuid.UUID) *TierUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -495,6 +891,60 @@ func (tuo *TierUpdateOne) sqlSave(ctx context.Context) (_node *Tier, err error)
 	if value, ok := tuo.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tuo.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.DiskBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskBandwidthMBPerSec(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if tuo.mutation.DiskBandwidthMBPerSecCleared() {
+		_spec.ClearField(tier.FieldDiskBandwidthMBPerSec, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if tuo.mutation.DiskIopsCleared() {
+		_spec.ClearField(tier.FieldDiskIops, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.NetworkBandwidthMBPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedNetworkBandwidthMBPerSec(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64, value)
+	}
+	if tuo.mutation.NetworkBandwidthMBPerSecCleared() {
+		_spec.ClearField(tier.FieldNetworkBandwidthMBPerSec, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.NetworkPacketsPerSec(); ok {
+		_spec.SetField(tier.FieldNetworkPacketsPerSec, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedNetworkPacketsPerSec(); ok {
+		_spec.AddField(tier.FieldNetworkPacketsPerSec, field.TypeInt64, value)
+	}
+	if tuo.mutation.NetworkPacketsPerSecCleared() {
+		_spec.ClearField(tier.FieldNetworkPacketsPerSec, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.MaxVolumeSizeMB(); ok {
+		_spec.SetField(tier.FieldMaxVolumeSizeMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxVolumeSizeMB(); ok {
+		_spec.AddField(tier.FieldMaxVolumeSizeMB, field.TypeInt64, value)
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package volume
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldName, v))
+}
+
+// SizeMB applies equality check predicate on the "size_mb" field. It's identical to SizeMBEQ.
+func SizeMB(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldSizeMB, v))
+}
+
+// AttachedSandboxID applies equality check predicate on the "attached_sandbox_id" field. It's identical to AttachedSandboxIDEQ.
+func AttachedSandboxID(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldAttachedSandboxID, v))
+}
+
+// AttachedAt applies equality check predicate on the "attached_at" field. It's identical to AttachedAtEQ.
+func AttachedAt(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldAttachedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldContainsFold(FieldName, v))
+}
+
+// SizeMBEQ applies the EQ predicate on the "size_mb" field.
+func SizeMBEQ(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldSizeMB, v))
+}
+
+// SizeMBNEQ applies the NEQ predicate on the "size_mb" field.
+func SizeMBNEQ(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldSizeMB, v))
+}
+
+// SizeMBIn applies the In predicate on the "size_mb" field.
+func SizeMBIn(vs ...int64) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldSizeMB, vs...))
+}
+
+// SizeMBNotIn applies the NotIn predicate on the "size_mb" field.
+func SizeMBNotIn(vs ...int64) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldSizeMB, vs...))
+}
+
+// SizeMBGT applies the GT predicate on the "size_mb" field.
+func SizeMBGT(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldSizeMB, v))
+}
+
+// SizeMBGTE applies the GTE predicate on the "size_mb" field.
+func SizeMBGTE(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldSizeMB, v))
+}
+
+// SizeMBLT applies the LT predicate on the "size_mb" field.
+func SizeMBLT(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldSizeMB, v))
+}
+
+// SizeMBLTE applies the LTE predicate on the "size_mb" field.
+func SizeMBLTE(v int64) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldSizeMB, v))
+}
+
+// AttachedSandboxIDEQ applies the EQ predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDEQ(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDNEQ applies the NEQ predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDNEQ(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDIn applies the In predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDIn(vs ...string) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldAttachedSandboxID, vs...))
+}
+
+// AttachedSandboxIDNotIn applies the NotIn predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDNotIn(vs ...string) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldAttachedSandboxID, vs...))
+}
+
+// AttachedSandboxIDGT applies the GT predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDGT(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDGTE applies the GTE predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDGTE(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDLT applies the LT predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDLT(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDLTE applies the LTE predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDLTE(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDContains applies the Contains predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDContains(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldContains(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDHasPrefix applies the HasPrefix predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDHasPrefix(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldHasPrefix(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDHasSuffix applies the HasSuffix predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDHasSuffix(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldHasSuffix(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDIsNil applies the IsNil predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDIsNil() predicate.Volume {
+	return predicate.Volume(sql.FieldIsNull(FieldAttachedSandboxID))
+}
+
+// AttachedSandboxIDNotNil applies the NotNil predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDNotNil() predicate.Volume {
+	return predicate.Volume(sql.FieldNotNull(FieldAttachedSandboxID))
+}
+
+// AttachedSandboxIDEqualFold applies the EqualFold predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDEqualFold(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldEqualFold(FieldAttachedSandboxID, v))
+}
+
+// AttachedSandboxIDContainsFold applies the ContainsFold predicate on the "attached_sandbox_id" field.
+func AttachedSandboxIDContainsFold(v string) predicate.Volume {
+	return predicate.Volume(sql.FieldContainsFold(FieldAttachedSandboxID, v))
+}
+
+// AttachedAtEQ applies the EQ predicate on the "attached_at" field.
+func AttachedAtEQ(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldEQ(FieldAttachedAt, v))
+}
+
+// AttachedAtNEQ applies the NEQ predicate on the "attached_at" field.
+func AttachedAtNEQ(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldNEQ(FieldAttachedAt, v))
+}
+
+// AttachedAtIn applies the In predicate on the "attached_at" field.
+func AttachedAtIn(vs ...time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldIn(FieldAttachedAt, vs...))
+}
+
+// AttachedAtNotIn applies the NotIn predicate on the "attached_at" field.
+func AttachedAtNotIn(vs ...time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldNotIn(FieldAttachedAt, vs...))
+}
+
+// AttachedAtGT applies the GT predicate on the "attached_at" field.
+func AttachedAtGT(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldGT(FieldAttachedAt, v))
+}
+
+// AttachedAtGTE applies the GTE predicate on the "attached_at" field.
+func AttachedAtGTE(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldGTE(FieldAttachedAt, v))
+}
+
+// AttachedAtLT applies the LT predicate on the "attached_at" field.
+func AttachedAtLT(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldLT(FieldAttachedAt, v))
+}
+
+// AttachedAtLTE applies the LTE predicate on the "attached_at" field.
+func AttachedAtLTE(v time.Time) predicate.Volume {
+	return predicate.Volume(sql.FieldLTE(FieldAttachedAt, v))
+}
+
+// AttachedAtIsNil applies the IsNil predicate on the "attached_at" field.
+func AttachedAtIsNil() predicate.Volume {
+	return predicate.Volume(sql.FieldIsNull(FieldAttachedAt))
+}
+
+// AttachedAtNotNil applies the NotNil predicate on the "attached_at" field.
+func AttachedAtNotNil() predicate.Volume {
+	return predicate.Volume(sql.FieldNotNull(FieldAttachedAt))
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.Volume {
+	return predicate.Volume(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.Volume
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.Volume {
+	return predicate.Volume(func(s *sql.Selector) {
+		step := newTeamStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.Volume
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Volume) predicate.Volume {
+	return predicate.Volume(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Volume) predicate.Volume {
+	return predicate.Volume(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Volume) predicate.Volume {
+	return predicate.Volume(sql.NotPredicates(p))
+}
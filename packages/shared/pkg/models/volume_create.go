@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/google/uuid"
+)
+
+// VolumeCreate is the builder for creating a Volume entity.
+type VolumeCreate struct {
+	config
+	mutation *VolumeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vc *VolumeCreate) SetCreatedAt(t time.Time) *VolumeCreate {
+	vc.mutation.SetCreatedAt(t)
+	return vc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vc *VolumeCreate) SetNillableCreatedAt(t *time.Time) *VolumeCreate {
+	if t != nil {
+		vc.SetCreatedAt(*t)
+	}
+	return vc
+}
+
+// SetTeamID sets the "team_id" field.
+func (vc *VolumeCreate) SetTeamID(u uuid.UUID) *VolumeCreate {
+	vc.mutation.SetTeamID(u)
+	return vc
+}
+
+// SetName sets the "name" field.
+func (vc *VolumeCreate) SetName(s string) *VolumeCreate {
+	vc.mutation.SetName(s)
+	return vc
+}
+
+// SetSizeMB sets the "size_mb" field.
+func (vc *VolumeCreate) SetSizeMB(i int64) *VolumeCreate {
+	vc.mutation.SetSizeMB(i)
+	return vc
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (vc *VolumeCreate) SetAttachedSandboxID(s string) *VolumeCreate {
+	vc.mutation.SetAttachedSandboxID(s)
+	return vc
+}
+
+// SetNillableAttachedSandboxID sets the "attached_sandbox_id" field if the given value is not nil.
+func (vc *VolumeCreate) SetNillableAttachedSandboxID(s *string) *VolumeCreate {
+	if s != nil {
+		vc.SetAttachedSandboxID(*s)
+	}
+	return vc
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (vc *VolumeCreate) SetAttachedAt(t time.Time) *VolumeCreate {
+	vc.mutation.SetAttachedAt(t)
+	return vc
+}
+
+// SetNillableAttachedAt sets the "attached_at" field if the given value is not nil.
+func (vc *VolumeCreate) SetNillableAttachedAt(t *time.Time) *VolumeCreate {
+	if t != nil {
+		vc.SetAttachedAt(*t)
+	}
+	return vc
+}
+
+// SetID sets the "id" field.
+func (vc *VolumeCreate) SetID(u uuid.UUID) *VolumeCreate {
+	vc.mutation.SetID(u)
+	return vc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (vc *VolumeCreate) SetTeam(t *Team) *VolumeCreate {
+	return vc.SetTeamID(t.ID)
+}
+
+// Mutation returns the VolumeMutation object of the builder.
+func (vc *VolumeCreate) Mutation() *VolumeMutation {
+	return vc.mutation
+}
+
+// Save creates the Volume in the database.
+func (vc *VolumeCreate) Save(ctx context.Context) (*Volume, error) {
+	vc.defaults()
+	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vc *VolumeCreate) SaveX(ctx context.Context) *Volume {
+	v, err := vc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vc *VolumeCreate) Exec(ctx context.Context) error {
+	_, err := vc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vc *VolumeCreate) ExecX(ctx context.Context) {
+	if err := vc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vc *VolumeCreate) defaults() {
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		v := volume.DefaultCreatedAt()
+		vc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vc *VolumeCreate) check() error {
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "Volume.created_at"`)}
+	}
+	if _, ok := vc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "Volume.team_id"`)}
+	}
+	if _, ok := vc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`models: missing required field "Volume.name"`)}
+	}
+	if _, ok := vc.mutation.SizeMB(); !ok {
+		return &ValidationError{Name: "size_mb", err: errors.New(`models: missing required field "Volume.size_mb"`)}
+	}
+	if _, ok := vc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team", err: errors.New(`models: missing required edge "Volume.team"`)}
+	}
+	return nil
+}
+
+func (vc *VolumeCreate) sqlSave(ctx context.Context) (*Volume, error) {
+	if err := vc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	vc.mutation.id = &_node.ID
+	vc.mutation.done = true
+	return _node, nil
+}
+
+func (vc *VolumeCreate) createSpec() (*Volume, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Volume{config: vc.config}
+		_spec = sqlgraph.NewCreateSpec(volume.Table, sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = vc.schemaConfig.Volume
+	_spec.OnConflict = vc.conflict
+	if id, ok := vc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := vc.mutation.CreatedAt(); ok {
+		_spec.SetField(volume.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vc.mutation.Name(); ok {
+		_spec.SetField(volume.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vc.mutation.SizeMB(); ok {
+		_spec.SetField(volume.FieldSizeMB, field.TypeInt64, value)
+		_node.SizeMB = value
+	}
+	if value, ok := vc.mutation.AttachedSandboxID(); ok {
+		_spec.SetField(volume.FieldAttachedSandboxID, field.TypeString, value)
+		_node.AttachedSandboxID = &value
+	}
+	if value, ok := vc.mutation.AttachedAt(); ok {
+		_spec.SetField(volume.FieldAttachedAt, field.TypeTime, value)
+		_node.AttachedAt = &value
+	}
+	if nodes := vc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   volume.TeamTable,
+			Columns: []string{volume.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = vc.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Volume.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VolumeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vc *VolumeCreate) OnConflict(opts ...sql.ConflictOption) *VolumeUpsertOne {
+	vc.conflict = opts
+	return &VolumeUpsertOne{
+		create: vc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vc *VolumeCreate) OnConflictColumns(columns ...string) *VolumeUpsertOne {
+	vc.conflict = append(vc.conflict, sql.ConflictColumns(columns...))
+	return &VolumeUpsertOne{
+		create: vc,
+	}
+}
+
+type (
+	// VolumeUpsertOne is the builder for "upsert"-ing
+	//  one Volume node.
+	VolumeUpsertOne struct {
+		create *VolumeCreate
+	}
+
+	// VolumeUpsert is the "OnConflict" setter.
+	VolumeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTeamID sets the "team_id" field.
+func (u *VolumeUpsert) SetTeamID(v uuid.UUID) *VolumeUpsert {
+	u.Set(volume.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *VolumeUpsert) UpdateTeamID() *VolumeUpsert {
+	u.SetExcluded(volume.FieldTeamID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VolumeUpsert) SetName(v string) *VolumeUpsert {
+	u.Set(volume.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VolumeUpsert) UpdateName() *VolumeUpsert {
+	u.SetExcluded(volume.FieldName)
+	return u
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (u *VolumeUpsert) SetAttachedSandboxID(v string) *VolumeUpsert {
+	u.Set(volume.FieldAttachedSandboxID, v)
+	return u
+}
+
+// UpdateAttachedSandboxID sets the "attached_sandbox_id" field to the value that was provided on create.
+func (u *VolumeUpsert) UpdateAttachedSandboxID() *VolumeUpsert {
+	u.SetExcluded(volume.FieldAttachedSandboxID)
+	return u
+}
+
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (u *VolumeUpsert) ClearAttachedSandboxID() *VolumeUpsert {
+	u.SetNull(volume.FieldAttachedSandboxID)
+	return u
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (u *VolumeUpsert) SetAttachedAt(v time.Time) *VolumeUpsert {
+	u.Set(volume.FieldAttachedAt, v)
+	return u
+}
+
+// UpdateAttachedAt sets the "attached_at" field to the value that was provided on create.
+func (u *VolumeUpsert) UpdateAttachedAt() *VolumeUpsert {
+	u.SetExcluded(volume.FieldAttachedAt)
+	return u
+}
+
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (u *VolumeUpsert) ClearAttachedAt() *VolumeUpsert {
+	u.SetNull(volume.FieldAttachedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(volume.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VolumeUpsertOne) UpdateNewValues() *VolumeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(volume.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(volume.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SizeMB(); exists {
+			s.SetIgnore(volume.FieldSizeMB)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VolumeUpsertOne) Ignore() *VolumeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VolumeUpsertOne) DoNothing() *VolumeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VolumeCreate.OnConflict
+// documentation for more info.
+func (u *VolumeUpsertOne) Update(set func(*VolumeUpsert)) *VolumeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VolumeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *VolumeUpsertOne) SetTeamID(v uuid.UUID) *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *VolumeUpsertOne) UpdateTeamID() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *VolumeUpsertOne) SetName(v string) *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VolumeUpsertOne) UpdateName() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (u *VolumeUpsertOne) SetAttachedSandboxID(v string) *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetAttachedSandboxID(v)
+	})
+}
+
+// UpdateAttachedSandboxID sets the "attached_sandbox_id" field to the value that was provided on create.
+func (u *VolumeUpsertOne) UpdateAttachedSandboxID() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateAttachedSandboxID()
+	})
+}
+
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (u *VolumeUpsertOne) ClearAttachedSandboxID() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.ClearAttachedSandboxID()
+	})
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (u *VolumeUpsertOne) SetAttachedAt(v time.Time) *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetAttachedAt(v)
+	})
+}
+
+// UpdateAttachedAt sets the "attached_at" field to the value that was provided on create.
+func (u *VolumeUpsertOne) UpdateAttachedAt() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateAttachedAt()
+	})
+}
+
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (u *VolumeUpsertOne) ClearAttachedAt() *VolumeUpsertOne {
+	return u.Update(func(s *VolumeUpsert) {
+		s.ClearAttachedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VolumeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for VolumeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VolumeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VolumeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: VolumeUpsertOne.ID is not supported by MySQL driver. Use VolumeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VolumeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VolumeCreateBulk is the builder for creating many Volume entities in bulk.
+type VolumeCreateBulk struct {
+	config
+	err      error
+	builders []*VolumeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Volume entities in the database.
+func (vcb *VolumeCreateBulk) Save(ctx context.Context) ([]*Volume, error) {
+	if vcb.err != nil {
+		return nil, vcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vcb.builders))
+	nodes := make([]*Volume, len(vcb.builders))
+	mutators := make([]Mutator, len(vcb.builders))
+	for i := range vcb.builders {
+		func(i int, root context.Context) {
+			builder := vcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VolumeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcb *VolumeCreateBulk) SaveX(ctx context.Context) []*Volume {
+	v, err := vcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcb *VolumeCreateBulk) Exec(ctx context.Context) error {
+	_, err := vcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcb *VolumeCreateBulk) ExecX(ctx context.Context) {
+	if err := vcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Volume.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VolumeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vcb *VolumeCreateBulk) OnConflict(opts ...sql.ConflictOption) *VolumeUpsertBulk {
+	vcb.conflict = opts
+	return &VolumeUpsertBulk{
+		create: vcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vcb *VolumeCreateBulk) OnConflictColumns(columns ...string) *VolumeUpsertBulk {
+	vcb.conflict = append(vcb.conflict, sql.ConflictColumns(columns...))
+	return &VolumeUpsertBulk{
+		create: vcb,
+	}
+}
+
+// VolumeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Volume nodes.
+type VolumeUpsertBulk struct {
+	create *VolumeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(volume.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VolumeUpsertBulk) UpdateNewValues() *VolumeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(volume.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(volume.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SizeMB(); exists {
+				s.SetIgnore(volume.FieldSizeMB)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Volume.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VolumeUpsertBulk) Ignore() *VolumeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VolumeUpsertBulk) DoNothing() *VolumeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VolumeCreateBulk.OnConflict
+// documentation for more info.
+func (u *VolumeUpsertBulk) Update(set func(*VolumeUpsert)) *VolumeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VolumeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *VolumeUpsertBulk) SetTeamID(v uuid.UUID) *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *VolumeUpsertBulk) UpdateTeamID() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *VolumeUpsertBulk) SetName(v string) *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VolumeUpsertBulk) UpdateName() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (u *VolumeUpsertBulk) SetAttachedSandboxID(v string) *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetAttachedSandboxID(v)
+	})
+}
+
+// UpdateAttachedSandboxID sets the "attached_sandbox_id" field to the value that was provided on create.
+func (u *VolumeUpsertBulk) UpdateAttachedSandboxID() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateAttachedSandboxID()
+	})
+}
+
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (u *VolumeUpsertBulk) ClearAttachedSandboxID() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.ClearAttachedSandboxID()
+	})
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (u *VolumeUpsertBulk) SetAttachedAt(v time.Time) *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.SetAttachedAt(v)
+	})
+}
+
+// UpdateAttachedAt sets the "attached_at" field to the value that was provided on create.
+func (u *VolumeUpsertBulk) UpdateAttachedAt() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.UpdateAttachedAt()
+	})
+}
+
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (u *VolumeUpsertBulk) ClearAttachedAt() *VolumeUpsertBulk {
+	return u.Update(func(s *VolumeUpsert) {
+		s.ClearAttachedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VolumeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the VolumeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for VolumeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VolumeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
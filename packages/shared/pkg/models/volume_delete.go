@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+)
+
+// VolumeDelete is the builder for deleting a Volume entity.
+type VolumeDelete struct {
+	config
+	hooks    []Hook
+	mutation *VolumeMutation
+}
+
+// Where appends a list predicates to the VolumeDelete builder.
+func (vd *VolumeDelete) Where(ps ...predicate.Volume) *VolumeDelete {
+	vd.mutation.Where(ps...)
+	return vd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vd *VolumeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vd.sqlExec, vd.mutation, vd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vd *VolumeDelete) ExecX(ctx context.Context) int {
+	n, err := vd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vd *VolumeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(volume.Table, sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID))
+	_spec.Node.Schema = vd.schemaConfig.Volume
+	ctx = internal.NewSchemaConfigContext(ctx, vd.schemaConfig)
+	if ps := vd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vd.mutation.done = true
+	return affected, err
+}
+
+// VolumeDeleteOne is the builder for deleting a single Volume entity.
+type VolumeDeleteOne struct {
+	vd *VolumeDelete
+}
+
+// Where appends a list predicates to the VolumeDelete builder.
+func (vdo *VolumeDeleteOne) Where(ps ...predicate.Volume) *VolumeDeleteOne {
+	vdo.vd.mutation.Where(ps...)
+	return vdo
+}
+
+// Exec executes the deletion query.
+func (vdo *VolumeDeleteOne) Exec(ctx context.Context) error {
+	n, err := vdo.vd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{volume.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vdo *VolumeDeleteOne) ExecX(ctx context.Context) {
+	if err := vdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/google/uuid"
+)
+
+// VolumeQuery is the builder for querying Volume entities.
+type VolumeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []volume.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Volume
+	withTeam   *TeamQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VolumeQuery builder.
+func (vq *VolumeQuery) Where(ps ...predicate.Volume) *VolumeQuery {
+	vq.predicates = append(vq.predicates, ps...)
+	return vq
+}
+
+// Limit the number of records to be returned by this query.
+func (vq *VolumeQuery) Limit(limit int) *VolumeQuery {
+	vq.ctx.Limit = &limit
+	return vq
+}
+
+// Offset to start from.
+func (vq *VolumeQuery) Offset(offset int) *VolumeQuery {
+	vq.ctx.Offset = &offset
+	return vq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vq *VolumeQuery) Unique(unique bool) *VolumeQuery {
+	vq.ctx.Unique = &unique
+	return vq
+}
+
+// Order specifies how the records should be ordered.
+func (vq *VolumeQuery) Order(o ...volume.OrderOption) *VolumeQuery {
+	vq.order = append(vq.order, o...)
+	return vq
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (vq *VolumeQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(volume.Table, volume.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, volume.TeamTable, volume.TeamColumn),
+		)
+		schemaConfig := vq.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.Volume
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Volume entity from the query.
+// Returns a *NotFoundError when no Volume was found.
+func (vq *VolumeQuery) First(ctx context.Context) (*Volume, error) {
+	nodes, err := vq.Limit(1).All(setContextOp(ctx, vq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{volume.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vq *VolumeQuery) FirstX(ctx context.Context) *Volume {
+	node, err := vq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Volume ID from the query.
+// Returns a *NotFoundError when no Volume ID was found.
+func (vq *VolumeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vq.Limit(1).IDs(setContextOp(ctx, vq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{volume.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vq *VolumeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := vq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Volume entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Volume entity is found.
+// Returns a *NotFoundError when no Volume entities are found.
+func (vq *VolumeQuery) Only(ctx context.Context) (*Volume, error) {
+	nodes, err := vq.Limit(2).All(setContextOp(ctx, vq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{volume.Label}
+	default:
+		return nil, &NotSingularError{volume.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vq *VolumeQuery) OnlyX(ctx context.Context) *Volume {
+	node, err := vq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Volume ID in the query.
+// Returns a *NotSingularError when more than one Volume ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vq *VolumeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vq.Limit(2).IDs(setContextOp(ctx, vq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{volume.Label}
+	default:
+		err = &NotSingularError{volume.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vq *VolumeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := vq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Volumes.
+func (vq *VolumeQuery) All(ctx context.Context) ([]*Volume, error) {
+	ctx = setContextOp(ctx, vq.ctx, "All")
+	if err := vq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Volume, *VolumeQuery]()
+	return withInterceptors[[]*Volume](ctx, vq, qr, vq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vq *VolumeQuery) AllX(ctx context.Context) []*Volume {
+	nodes, err := vq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Volume IDs.
+func (vq *VolumeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if vq.ctx.Unique == nil && vq.path != nil {
+		vq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vq.ctx, "IDs")
+	if err = vq.Select(volume.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vq *VolumeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := vq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vq *VolumeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vq.ctx, "Count")
+	if err := vq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vq, querierCount[*VolumeQuery](), vq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vq *VolumeQuery) CountX(ctx context.Context) int {
+	count, err := vq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vq *VolumeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vq.ctx, "Exist")
+	switch _, err := vq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vq *VolumeQuery) ExistX(ctx context.Context) bool {
+	exist, err := vq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VolumeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vq *VolumeQuery) Clone() *VolumeQuery {
+	if vq == nil {
+		return nil
+	}
+	return &VolumeQuery{
+		config:     vq.config,
+		ctx:        vq.ctx.Clone(),
+		order:      append([]volume.OrderOption{}, vq.order...),
+		inters:     append([]Interceptor{}, vq.inters...),
+		predicates: append([]predicate.Volume{}, vq.predicates...),
+		withTeam:   vq.withTeam.Clone(),
+		// clone intermediate query.
+		sql:  vq.sql.Clone(),
+		path: vq.path,
+	}
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VolumeQuery) WithTeam(opts ...func(*TeamQuery)) *VolumeQuery {
+	query := (&TeamClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withTeam = query
+	return vq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Volume.Query().
+//		GroupBy(volume.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (vq *VolumeQuery) GroupBy(field string, fields ...string) *VolumeGroupBy {
+	vq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VolumeGroupBy{build: vq}
+	grbuild.flds = &vq.ctx.Fields
+	grbuild.label = volume.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Volume.Query().
+//		Select(volume.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (vq *VolumeQuery) Select(fields ...string) *VolumeSelect {
+	vq.ctx.Fields = append(vq.ctx.Fields, fields...)
+	sbuild := &VolumeSelect{VolumeQuery: vq}
+	sbuild.label = volume.Label
+	sbuild.flds, sbuild.scan = &vq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VolumeSelect configured with the given aggregations.
+func (vq *VolumeQuery) Aggregate(fns ...AggregateFunc) *VolumeSelect {
+	return vq.Select().Aggregate(fns...)
+}
+
+func (vq *VolumeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vq.ctx.Fields {
+		if !volume.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if vq.path != nil {
+		prev, err := vq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vq.sql = prev
+	}
+	return nil
+}
+
+func (vq *VolumeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Volume, error) {
+	var (
+		nodes       = []*Volume{}
+		_spec       = vq.querySpec()
+		loadedTypes = [1]bool{
+			vq.withTeam != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Volume).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Volume{config: vq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = vq.schemaConfig.Volume
+	ctx = internal.NewSchemaConfigContext(ctx, vq.schemaConfig)
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := vq.withTeam; query != nil {
+		if err := vq.loadTeam(ctx, query, nodes, nil,
+			func(n *Volume, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vq *VolumeQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*Volume, init func(*Volume), assign func(*Volume, *Team)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Volume)
+	for i := range nodes {
+		fk := nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (vq *VolumeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vq.querySpec()
+	_spec.Node.Schema = vq.schemaConfig.Volume
+	ctx = internal.NewSchemaConfigContext(ctx, vq.schemaConfig)
+	if len(vq.modifiers) > 0 {
+		_spec.Modifiers = vq.modifiers
+	}
+	_spec.Node.Columns = vq.ctx.Fields
+	if len(vq.ctx.Fields) > 0 {
+		_spec.Unique = vq.ctx.Unique != nil && *vq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vq.driver, _spec)
+}
+
+func (vq *VolumeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(volume.Table, volume.Columns, sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID))
+	_spec.From = vq.sql
+	if unique := vq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, volume.FieldID)
+		for i := range fields {
+			if fields[i] != volume.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if vq.withTeam != nil {
+			_spec.Node.AddColumnOnce(volume.FieldTeamID)
+		}
+	}
+	if ps := vq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vq *VolumeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vq.driver.Dialect())
+	t1 := builder.Table(volume.Table)
+	columns := vq.ctx.Fields
+	if len(columns) == 0 {
+		columns = volume.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vq.sql != nil {
+		selector = vq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vq.ctx.Unique != nil && *vq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(vq.schemaConfig.Volume)
+	ctx = internal.NewSchemaConfigContext(ctx, vq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range vq.modifiers {
+		m(selector)
+	}
+	for _, p := range vq.predicates {
+		p(selector)
+	}
+	for _, p := range vq.order {
+		p(selector)
+	}
+	if offset := vq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vq *VolumeQuery) Modify(modifiers ...func(s *sql.Selector)) *VolumeSelect {
+	vq.modifiers = append(vq.modifiers, modifiers...)
+	return vq.Select()
+}
+
+// VolumeGroupBy is the group-by builder for Volume entities.
+type VolumeGroupBy struct {
+	selector
+	build *VolumeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vgb *VolumeGroupBy) Aggregate(fns ...AggregateFunc) *VolumeGroupBy {
+	vgb.fns = append(vgb.fns, fns...)
+	return vgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vgb *VolumeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vgb.build.ctx, "GroupBy")
+	if err := vgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VolumeQuery, *VolumeGroupBy](ctx, vgb.build, vgb, vgb.build.inters, v)
+}
+
+func (vgb *VolumeGroupBy) sqlScan(ctx context.Context, root *VolumeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vgb.fns))
+	for _, fn := range vgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vgb.flds)+len(vgb.fns))
+		for _, f := range *vgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VolumeSelect is the builder for selecting fields of Volume entities.
+type VolumeSelect struct {
+	*VolumeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vs *VolumeSelect) Aggregate(fns ...AggregateFunc) *VolumeSelect {
+	vs.fns = append(vs.fns, fns...)
+	return vs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vs *VolumeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vs.ctx, "Select")
+	if err := vs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VolumeQuery, *VolumeSelect](ctx, vs.VolumeQuery, vs, vs.inters, v)
+}
+
+func (vs *VolumeSelect) sqlScan(ctx context.Context, root *VolumeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vs.fns))
+	for _, fn := range vs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (vs *VolumeSelect) Modify(modifiers ...func(s *sql.Selector)) *VolumeSelect {
+	vs.modifiers = append(vs.modifiers, modifiers...)
+	return vs
+}
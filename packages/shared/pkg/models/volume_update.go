@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/volume"
+	"github.com/google/uuid"
+)
+
+// VolumeUpdate is the builder for updating Volume entities.
+type VolumeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VolumeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VolumeUpdate builder.
+func (vu *VolumeUpdate) Where(ps ...predicate.Volume) *VolumeUpdate {
+	vu.mutation.Where(ps...)
+	return vu
+}
+
+// SetTeamID sets the "team_id" field.
+func (vu *VolumeUpdate) SetTeamID(u uuid.UUID) *VolumeUpdate {
+	vu.mutation.SetTeamID(u)
+	return vu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (vu *VolumeUpdate) SetNillableTeamID(u *uuid.UUID) *VolumeUpdate {
+	if u != nil {
+		vu.SetTeamID(*u)
+	}
+	return vu
+}
+
+// SetName sets the "name" field.
+func (vu *VolumeUpdate) SetName(s string) *VolumeUpdate {
+	vu.mutation.SetName(s)
+	return vu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vu *VolumeUpdate) SetNillableName(s *string) *VolumeUpdate {
+	if s != nil {
+		vu.SetName(*s)
+	}
+	return vu
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (vu *VolumeUpdate) SetAttachedSandboxID(s string) *VolumeUpdate {
+	vu.mutation.SetAttachedSandboxID(s)
+	return vu
+}
+
+// SetNillableAttachedSandboxID sets the "attached_sandbox_id" field if the given value is not nil.
+func (vu *VolumeUpdate) SetNillableAttachedSandboxID(s *string) *VolumeUpdate {
+	if s != nil {
+		vu.SetAttachedSandboxID(*s)
+	}
+	return vu
+}
+
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (vu *VolumeUpdate) ClearAttachedSandboxID() *VolumeUpdate {
+	vu.mutation.ClearAttachedSandboxID()
+	return vu
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (vu *VolumeUpdate) SetAttachedAt(t time.Time) *VolumeUpdate {
+	vu.mutation.SetAttachedAt(t)
+	return vu
+}
+
+// SetNillableAttachedAt sets the "attached_at" field if the given value is not nil.
+func (vu *VolumeUpdate) SetNillableAttachedAt(t *time.Time) *VolumeUpdate {
+	if t != nil {
+		vu.SetAttachedAt(*t)
+	}
+	return vu
+}
+
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (vu *VolumeUpdate) ClearAttachedAt() *VolumeUpdate {
+	vu.mutation.ClearAttachedAt()
+	return vu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (vu *VolumeUpdate) SetTeam(t *Team) *VolumeUpdate {
+	return vu.SetTeamID(t.ID)
+}
+
+// Mutation returns the VolumeMutation object of the builder.
+func (vu *VolumeUpdate) Mutation() *VolumeMutation {
+	return vu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (vu *VolumeUpdate) ClearTeam() *VolumeUpdate {
+	vu.mutation.ClearTeam()
+	return vu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vu *VolumeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vu.sqlSave, vu.mutation, vu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vu *VolumeUpdate) SaveX(ctx context.Context) int {
+	affected, err := vu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vu *VolumeUpdate) Exec(ctx context.Context) error {
+	_, err := vu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vu *VolumeUpdate) ExecX(ctx context.Context) {
+	if err := vu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vu *VolumeUpdate) check() error {
+	if _, ok := vu.mutation.TeamID(); vu.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Volume.team"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vu *VolumeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VolumeUpdate {
+	vu.modifiers = append(vu.modifiers, modifiers...)
+	return vu
+}
+
+func (vu *VolumeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(volume.Table, volume.Columns, sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID))
+	if ps := vu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vu.mutation.Name(); ok {
+		_spec.SetField(volume.FieldName, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.AttachedSandboxID(); ok {
+		_spec.SetField(volume.FieldAttachedSandboxID, field.TypeString, value)
+	}
+	if vu.mutation.AttachedSandboxIDCleared() {
+		_spec.ClearField(volume.FieldAttachedSandboxID, field.TypeString)
+	}
+	if value, ok := vu.mutation.AttachedAt(); ok {
+		_spec.SetField(volume.FieldAttachedAt, field.TypeTime, value)
+	}
+	if vu.mutation.AttachedAtCleared() {
+		_spec.ClearField(volume.FieldAttachedAt, field.TypeTime)
+	}
+	if vu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   volume.TeamTable,
+			Columns: []string{volume.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = vu.schemaConfig.Volume
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   volume.TeamTable,
+			Columns: []string{volume.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = vu.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = vu.schemaConfig.Volume
+	ctx = internal.NewSchemaConfigContext(ctx, vu.schemaConfig)
+	_spec.AddModifiers(vu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{volume.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vu.mutation.done = true
+	return n, nil
+}
+
+// VolumeUpdateOne is the builder for updating a single Volume entity.
+type VolumeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VolumeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTeamID sets the "team_id" field.
+func (vuo *VolumeUpdateOne) SetTeamID(u uuid.UUID) *VolumeUpdateOne {
+	vuo.mutation.SetTeamID(u)
+	return vuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (vuo *VolumeUpdateOne) SetNillableTeamID(u *uuid.UUID) *VolumeUpdateOne {
+	if u != nil {
+		vuo.SetTeamID(*u)
+	}
+	return vuo
+}
+
+// SetName sets the "name" field.
+func (vuo *VolumeUpdateOne) SetName(s string) *VolumeUpdateOne {
+	vuo.mutation.SetName(s)
+	return vuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vuo *VolumeUpdateOne) SetNillableName(s *string) *VolumeUpdateOne {
+	if s != nil {
+		vuo.SetName(*s)
+	}
+	return vuo
+}
+
+// SetAttachedSandboxID sets the "attached_sandbox_id" field.
+func (vuo *VolumeUpdateOne) SetAttachedSandboxID(s string) *VolumeUpdateOne {
+	vuo.mutation.SetAttachedSandboxID(s)
+	return vuo
+}
+
+// SetNillableAttachedSandboxID sets the "attached_sandbox_id" field if the given value is not nil.
+func (vuo *VolumeUpdateOne) SetNillableAttachedSandboxID(s *string) *VolumeUpdateOne {
+	if s != nil {
+		vuo.SetAttachedSandboxID(*s)
+	}
+	return vuo
+}
+
+// ClearAttachedSandboxID clears the value of the "attached_sandbox_id" field.
+func (vuo *VolumeUpdateOne) ClearAttachedSandboxID() *VolumeUpdateOne {
+	vuo.mutation.ClearAttachedSandboxID()
+	return vuo
+}
+
+// SetAttachedAt sets the "attached_at" field.
+func (vuo *VolumeUpdateOne) SetAttachedAt(t time.Time) *VolumeUpdateOne {
+	vuo.mutation.SetAttachedAt(t)
+	return vuo
+}
+
+// SetNillableAttachedAt sets the "attached_at" field if the given value is not nil.
+func (vuo *VolumeUpdateOne) SetNillableAttachedAt(t *time.Time) *VolumeUpdateOne {
+	if t != nil {
+		vuo.SetAttachedAt(*t)
+	}
+	return vuo
+}
+
+// ClearAttachedAt clears the value of the "attached_at" field.
+func (vuo *VolumeUpdateOne) ClearAttachedAt() *VolumeUpdateOne {
+	vuo.mutation.ClearAttachedAt()
+	return vuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (vuo *VolumeUpdateOne) SetTeam(t *Team) *VolumeUpdateOne {
+	return vuo.SetTeamID(t.ID)
+}
+
+// Mutation returns the VolumeMutation object of the builder.
+func (vuo *VolumeUpdateOne) Mutation() *VolumeMutation {
+	return vuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (vuo *VolumeUpdateOne) ClearTeam() *VolumeUpdateOne {
+	vuo.mutation.ClearTeam()
+	return vuo
+}
+
+// Where appends a list predicates to the VolumeUpdate builder.
+func (vuo *VolumeUpdateOne) Where(ps ...predicate.Volume) *VolumeUpdateOne {
+	vuo.mutation.Where(ps...)
+	return vuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vuo *VolumeUpdateOne) Select(field string, fields ...string) *VolumeUpdateOne {
+	vuo.fields = append([]string{field}, fields...)
+	return vuo
+}
+
+// Save executes the query and returns the updated Volume entity.
+func (vuo *VolumeUpdateOne) Save(ctx context.Context) (*Volume, error) {
+	return withHooks(ctx, vuo.sqlSave, vuo.mutation, vuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vuo *VolumeUpdateOne) SaveX(ctx context.Context) *Volume {
+	node, err := vuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vuo *VolumeUpdateOne) Exec(ctx context.Context) error {
+	_, err := vuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vuo *VolumeUpdateOne) ExecX(ctx context.Context) {
+	if err := vuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vuo *VolumeUpdateOne) check() error {
+	if _, ok := vuo.mutation.TeamID(); vuo.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "Volume.team"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (vuo *VolumeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VolumeUpdateOne {
+	vuo.modifiers = append(vuo.modifiers, modifiers...)
+	return vuo
+}
+
+func (vuo *VolumeUpdateOne) sqlSave(ctx context.Context) (_node *Volume, err error) {
+	if err := vuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(volume.Table, volume.Columns, sqlgraph.NewFieldSpec(volume.FieldID, field.TypeUUID))
+	id, ok := vuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "Volume.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, volume.FieldID)
+		for _, f := range fields {
+			if !volume.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != volume.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vuo.mutation.Name(); ok {
+		_spec.SetField(volume.FieldName, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.AttachedSandboxID(); ok {
+		_spec.SetField(volume.FieldAttachedSandboxID, field.TypeString, value)
+	}
+	if vuo.mutation.AttachedSandboxIDCleared() {
+		_spec.ClearField(volume.FieldAttachedSandboxID, field.TypeString)
+	}
+	if value, ok := vuo.mutation.AttachedAt(); ok {
+		_spec.SetField(volume.FieldAttachedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.AttachedAtCleared() {
+		_spec.ClearField(volume.FieldAttachedAt, field.TypeTime)
+	}
+	if vuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   volume.TeamTable,
+			Columns: []string{volume.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = vuo.schemaConfig.Volume
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   volume.TeamTable,
+			Columns: []string{volume.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = vuo.schemaConfig.Volume
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = vuo.schemaConfig.Volume
+	ctx = internal.NewSchemaConfigContext(ctx, vuo.schemaConfig)
+	_spec.AddModifiers(vuo.modifiers...)
+	_node = &Volume{config: vuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{volume.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vuo.mutation.done = true
+	return _node, nil
+}
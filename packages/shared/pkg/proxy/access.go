@@ -0,0 +1,52 @@
+package proxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// PortAccessTokenHeader is the header carrying the token for the protected sandbox ports.
+	PortAccessTokenHeader = "X-E2B-Port-Token"
+	// PortAccessTokenQueryParam is the query parameter carrying the token for the protected sandbox ports,
+	// it is meant for the browser requests where setting a header is not possible.
+	PortAccessTokenQueryParam = "e2b_port_token"
+)
+
+// PortAccessToken derives the token for the protected sandbox port from the sandbox envd access token.
+func PortAccessToken(envdAccessToken string, port uint64) string {
+	mac := hmac.New(sha256.New, []byte(envdAccessToken))
+	mac.Write([]byte(strconv.FormatUint(port, 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// takePortAccessToken returns the port token from the request and removes it,
+// so it isn't forwarded to the sandbox.
+func takePortAccessToken(r *http.Request) string {
+	token := r.Header.Get(PortAccessTokenHeader)
+	r.Header.Del(PortAccessTokenHeader)
+
+	query := r.URL.Query()
+	if query.Has(PortAccessTokenQueryParam) {
+		if token == "" {
+			token = query.Get(PortAccessTokenQueryParam)
+		}
+
+		query.Del(PortAccessTokenQueryParam)
+		r.URL.RawQuery = query.Encode()
+	}
+
+	return token
+}
+
+func validPortAccessToken(expected, token string) bool {
+	if expected == "" || token == "" {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(token))
+}
@@ -82,6 +82,28 @@ func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Dest
 			return
 		}
 
+		switch d.Exposure {
+		case pool.PortClosed:
+			d.RequestLogger.Debug("sandbox port is not exposed")
+
+			err := template.
+				NewPortClosedError(d.SandboxId, r.Host, d.SandboxPort).
+				HandleError(w, r)
+			if err != nil {
+				zap.L().Error("failed to handle port closed error", zap.Error(err))
+				http.Error(w, "Failed to handle port closed error", http.StatusInternalServerError)
+			}
+
+			return
+		case pool.PortProtected:
+			if !validPortAccessToken(d.AccessToken, takePortAccessToken(r)) {
+				d.RequestLogger.Debug("invalid sandbox port access token")
+				http.Error(w, "Invalid or missing port access token", http.StatusUnauthorized)
+
+				return
+			}
+		}
+
 		d.RequestLogger.Debug("proxying request")
 
 		ctx := context.WithValue(r.Context(), pool.DestinationContextKey{}, d)
@@ -8,6 +8,18 @@ import (
 
 type DestinationContextKey struct{}
 
+// PortExposure controls who can reach the sandbox port through the proxy.
+type PortExposure int
+
+const (
+	// PortPublic ports are reachable by anyone who knows the sandbox host.
+	PortPublic PortExposure = iota
+	// PortProtected ports are reachable only with the port access token.
+	PortProtected
+	// PortClosed ports are not reachable through the proxy.
+	PortClosed
+)
+
 // Destination contains information about where to route the request.
 type Destination struct {
 	Url         *url.URL
@@ -20,4 +32,8 @@ type Destination struct {
 	// This is evaluated before checking for existing connection to the IP:port pair.
 	ConnectionKey                      string
 	IncludeSandboxIdInProxyErrorLogger bool
+	// Exposure of the sandbox port, the zero value lets the request through.
+	Exposure PortExposure
+	// AccessToken is the token required for the protected port.
+	AccessToken string
 }
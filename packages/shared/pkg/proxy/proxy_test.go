@@ -405,3 +405,109 @@ func TestProxyDoesNotReuseConnectionsWhenBackendChanges(t *testing.T) {
 	assert.Equal(t, backend1.RequestCount(), uint64(1), "first backend should have been called once")
 	assert.Equal(t, proxy.TotalPoolConnections(), uint64(2), "proxy should not have reused the connection")
 }
+
+func TestProxyPortExposure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to create listener: %v", err)
+	}
+
+	backend, err := newTestBackend(listener, "backend-1")
+	if err != nil {
+		t.Fatalf("failed to create backend: %v", err)
+	}
+	defer backend.Close()
+
+	token := PortAccessToken("envd-token", 8080)
+
+	getDestination := func(r *http.Request) (*pool.Destination, error) {
+		exposure := pool.PortProtected
+		if r.URL.Path == "/closed" {
+			exposure = pool.PortClosed
+		}
+
+		return &pool.Destination{
+			Url:           backend.url,
+			SandboxId:     "test-sandbox",
+			SandboxPort:   8080,
+			RequestLogger: zap.NewNop(),
+			ConnectionKey: backend.id,
+			Exposure:      exposure,
+			AccessToken:   token,
+		}, nil
+	}
+
+	proxy, port, err := newTestProxy(getDestination)
+	if err != nil {
+		t.Fatalf("failed to create proxy: %v", err)
+	}
+	defer proxy.Close()
+
+	proxyURL := fmt.Sprintf("http://127.0.0.1:%d", port)
+
+	// Closed port
+	resp, err := http.Get(proxyURL + "/closed")
+	if err != nil {
+		t.Fatalf("failed to GET from proxy: %v", err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, resp.StatusCode, http.StatusBadGateway, "closed port should return the port closed error")
+
+	// Protected port without the token
+	resp, err = http.Get(proxyURL + "/hello")
+	if err != nil {
+		t.Fatalf("failed to GET from proxy: %v", err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, resp.StatusCode, http.StatusUnauthorized, "protected port should require the token")
+
+	// Protected port with a wrong token
+	resp, err = http.Get(proxyURL + "/hello?" + PortAccessTokenQueryParam + "=" + PortAccessToken("other-token", 8080))
+	if err != nil {
+		t.Fatalf("failed to GET from proxy: %v", err)
+	}
+	resp.Body.Close()
+
+	assert.Equal(t, resp.StatusCode, http.StatusUnauthorized, "protected port should reject a wrong token")
+	assert.Equal(t, backend.RequestCount(), uint64(0), "backend should not have been called")
+
+	// Protected port with the token in the header
+	req, err := http.NewRequest(http.MethodGet, proxyURL+"/hello", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set(PortAccessTokenHeader, token)
+
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to GET from proxy: %v", err)
+	}
+	defer resp.Body.Close()
+
+	assertBackendOutput(t, backend, resp)
+
+	// Protected port with the token in the query
+	resp, err = http.Get(proxyURL + "/hello?" + PortAccessTokenQueryParam + "=" + token)
+	if err != nil {
+		t.Fatalf("failed to GET from proxy: %v", err)
+	}
+	defer resp.Body.Close()
+
+	assertBackendOutput(t, backend, resp)
+
+	assert.Equal(t, backend.RequestCount(), uint64(2), "backend should have been called twice")
+}
+
+func TestTakePortAccessToken(t *testing.T) {
+	r, err := http.NewRequest(http.MethodGet, "http://example.com/path?a=1&"+PortAccessTokenQueryParam+"=query", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	r.Header.Set(PortAccessTokenHeader, "header")
+
+	assert.Equal(t, takePortAccessToken(r), "header")
+	assert.Equal(t, r.Header.Get(PortAccessTokenHeader), "")
+	assert.Equal(t, r.URL.RawQuery, "a=1")
+}
@@ -31,6 +31,7 @@ func (Env) Fields() []ent.Field {
 		field.Int64("spawn_count").Default(0).Comment("Number of times the env was spawned"),
 		field.Time("last_spawned_at").Optional().Comment("Timestamp of the last time the env was spawned"),
 		field.UUID("cluster_id", uuid.UUID{}).Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "uuid"}),
+		field.Int32("warm_pool_size").Default(0).Comment("Number of sandboxes kept booted on each node to be handed out on create"),
 	}
 }
 
@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 type Snapshot struct {
@@ -28,6 +30,11 @@ func (Snapshot) Fields() []ent.Field {
 		field.JSON("metadata", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 		field.Time("sandbox_started_at"),
 		field.Bool("env_secure").Default(false),
+		field.Int64("vcpu_limit").Optional().Nillable().Comment("Number of vCPUs the sandbox was limited to when paused, NULL means all the vCPUs of the build"),
+		field.Int64("ram_mb_limit").Optional().Nillable().Comment("Memory in MiB the sandbox was limited to when paused, NULL means all the memory of the build"),
+		field.JSON("egress_policy", &types.SandboxEgressPolicy{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Comment("Egress policy of the sandbox, NULL means no restrictions"),
+		field.JSON("port_access", &types.SandboxPortAccess{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Comment("Exposure of the sandbox ports through the proxies, NULL means all the ports are public"),
+		field.JSON("network", &types.SandboxNetwork{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Comment("Team network the sandbox is attached to, NULL means no team network"),
 	}
 }
 
@@ -37,6 +37,7 @@ func (Team) Edges() []ent.Edge {
 		edge.To("team_api_keys", TeamAPIKey.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("team_tier", Tier.Type).Ref("teams").Unique().Field("tier").Required(),
 		edge.To("envs", Env.Type),
+		edge.To("volumes", Volume.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
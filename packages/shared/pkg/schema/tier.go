@@ -20,6 +20,13 @@ func (Tier) Fields() []ent.Field {
 		field.Int64("disk_mb").Annotations(entsql.Check("disk_mb > 0"), entsql.Default("512")),
 		field.Int64("concurrent_instances").Annotations(entsql.Check("concurrent_instances > 0")).Comment("The number of instances the team can run concurrently"),
 		field.Int64("max_length_hours"),
+		field.Int64("max_vcpu").Annotations(entsql.Default("8")),
+		field.Int64("max_ram_mb").Annotations(entsql.Default("8096")),
+		field.Int64("disk_bandwidth_mb_per_sec").Optional().Nillable().Comment("The disk bandwidth limit of a sandbox, unlimited when not set"),
+		field.Int64("disk_iops").Optional().Nillable().Comment("The disk operations per second limit of a sandbox, unlimited when not set"),
+		field.Int64("network_bandwidth_mb_per_sec").Optional().Nillable().Comment("The network bandwidth limit of a sandbox in each direction, unlimited when not set"),
+		field.Int64("network_packets_per_sec").Optional().Nillable().Comment("The network packets per second limit of a sandbox in each direction, unlimited when not set"),
+		field.Int64("max_volume_size_mb").Annotations(entsql.Default("10240")).Comment("The maximum size of a volume created by the team"),
 	}
 }
 
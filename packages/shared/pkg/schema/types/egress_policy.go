@@ -0,0 +1,8 @@
+package types
+
+// SandboxEgressPolicy is the egress policy of a sandbox stored with its snapshot, so it's applied again on resume.
+type SandboxEgressPolicy struct {
+	AllowedCIDRs   []string `json:"allowed_cidrs,omitempty"`
+	DeniedCIDRs    []string `json:"denied_cidrs,omitempty"`
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+}
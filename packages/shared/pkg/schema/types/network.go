@@ -0,0 +1,7 @@
+package types
+
+// SandboxNetwork is the team network of the sandbox stored with its snapshot, so the sandbox is attached to it again on resume.
+type SandboxNetwork struct {
+	Name    string `json:"name"`
+	Address string `json:"address,omitempty"`
+}
@@ -0,0 +1,12 @@
+package types
+
+// SandboxPortAccess is the exposure of the sandbox ports through the proxies stored with its snapshot, so it's applied again on resume.
+type SandboxPortAccess struct {
+	DefaultExposure string                  `json:"default_exposure,omitempty"`
+	Ports           []SandboxPortAccessPort `json:"ports,omitempty"`
+}
+
+type SandboxPortAccessPort struct {
+	Port     uint64 `json:"port"`
+	Exposure string `json:"exposure"`
+}
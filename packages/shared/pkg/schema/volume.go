@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Volume struct {
+	ent.Schema
+}
+
+func (Volume) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).Annotations(
+			entsql.Default("CURRENT_TIMESTAMP"),
+		),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// Size of the volume block device, it can't be changed after the volume is created.
+		field.Int64("size_mb").Immutable(),
+		// The sandbox the volume is attached to, a volume can be attached to only one sandbox at a time.
+		field.String("attached_sandbox_id").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("attached_at").Optional().Nillable(),
+	}
+}
+
+func (Volume) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("volumes").Unique().Field("team_id").Required(),
+	}
+}
+
+func (Volume) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "name").Unique(),
+	}
+}
+
+func (Volume) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
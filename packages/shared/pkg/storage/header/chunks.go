@@ -0,0 +1,137 @@
+package header
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+const chunkIndexVersion = 1
+
+// ChunkHash is the SHA-256 digest of a content-addressed chunk.
+type ChunkHash [sha256.Size]byte
+
+func (h ChunkHash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// ParseChunkHash parses the hex encoded chunk hash returned by ChunkHash.String.
+func ParseChunkHash(s string) (ChunkHash, error) {
+	var hash ChunkHash
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return hash, fmt.Errorf("invalid chunk hash %q: %w", s, err)
+	}
+
+	if len(decoded) != len(hash) {
+		return hash, fmt.Errorf("invalid chunk hash %q: expected %d bytes, got %d", s, len(hash), len(decoded))
+	}
+
+	copy(hash[:], decoded)
+
+	return hash, nil
+}
+
+// ChunkIndex lists the content-addressed chunks that make up a build's data object, in storage order.
+// A BuildMap entry resolves to chunks through the index of the build it references, using its BuildStorageOffset.
+type ChunkIndex struct {
+	Size      uint64
+	ChunkSize uint64
+	Hashes    []ChunkHash
+}
+
+type chunkIndexMetadata struct {
+	Version   uint64
+	Size      uint64
+	ChunkSize uint64
+	Count     uint64
+}
+
+// Lookup returns the chunk containing the storage offset and the offset inside that chunk.
+func (i *ChunkIndex) Lookup(storageOffset int64) (ChunkHash, int64, error) {
+	if storageOffset < 0 || uint64(storageOffset) >= i.Size {
+		return ChunkHash{}, 0, fmt.Errorf("offset %d is outside of the indexed object (size %d)", storageOffset, i.Size)
+	}
+
+	idx := BlockIdx(storageOffset, int64(i.ChunkSize))
+	if idx >= int64(len(i.Hashes)) {
+		return ChunkHash{}, 0, fmt.Errorf("no chunk found for offset %d", storageOffset)
+	}
+
+	return i.Hashes[idx], storageOffset - BlockOffset(idx, int64(i.ChunkSize)), nil
+}
+
+// ChunkLength returns the length of the chunk at the given position, the last chunk may be shorter.
+func (i *ChunkIndex) ChunkLength(idx int) int64 {
+	start := BlockOffset(int64(idx), int64(i.ChunkSize))
+
+	return min(int64(i.ChunkSize), int64(i.Size)-start)
+}
+
+func SerializeChunkIndex(index *ChunkIndex) (io.Reader, error) {
+	var buf bytes.Buffer
+
+	err := binary.Write(&buf, binary.LittleEndian, &chunkIndexMetadata{
+		Version:   chunkIndexVersion,
+		Size:      index.Size,
+		ChunkSize: index.ChunkSize,
+		Count:     uint64(len(index.Hashes)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write chunk index metadata: %w", err)
+	}
+
+	for _, hash := range index.Hashes {
+		buf.Write(hash[:])
+	}
+
+	return &buf, nil
+}
+
+func DeserializeChunkIndex(in io.WriterTo) (*ChunkIndex, error) {
+	var buf bytes.Buffer
+
+	_, err := in.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to buffer: %w", err)
+	}
+
+	reader := bytes.NewReader(buf.Bytes())
+
+	var metadata chunkIndexMetadata
+
+	err = binary.Read(reader, binary.LittleEndian, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chunk index metadata: %w", err)
+	}
+
+	if metadata.Version != chunkIndexVersion {
+		return nil, fmt.Errorf("unsupported chunk index version: %d", metadata.Version)
+	}
+
+	if metadata.ChunkSize == 0 {
+		return nil, fmt.Errorf("invalid chunk size in chunk index")
+	}
+
+	if metadata.Count != uint64(TotalBlocks(int64(metadata.Size), int64(metadata.ChunkSize))) {
+		return nil, fmt.Errorf("chunk index has %d chunks, expected %d for size %d", metadata.Count, TotalBlocks(int64(metadata.Size), int64(metadata.ChunkSize)), metadata.Size)
+	}
+
+	hashes := make([]ChunkHash, metadata.Count)
+	for i := range hashes {
+		_, err := io.ReadFull(reader, hashes[i][:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk hash %d: %w", i, err)
+		}
+	}
+
+	return &ChunkIndex{
+		Size:      metadata.Size,
+		ChunkSize: metadata.ChunkSize,
+		Hashes:    hashes,
+	}, nil
+}
@@ -0,0 +1,246 @@
+package header
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// StorageFrameSize is the amount of uncompressed data stored in one frame.
+// It matches the chunk size used by the orchestrator, so a fetched chunk is usually exactly one frame.
+const StorageFrameSize = 4 * 1024 * 1024 // 4 MB
+
+type Compression uint32
+
+const (
+	CompressionNone Compression = iota
+	CompressionZstd
+	CompressionLz4
+)
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil)
+	})
+)
+
+func ParseCompression(name string) (Compression, error) {
+	switch name {
+	case "none":
+		return CompressionNone, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "lz4":
+		return CompressionLz4, nil
+	default:
+		return 0, fmt.Errorf("unknown compression codec: %s", name)
+	}
+}
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionLz4:
+		return "lz4"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(c))
+	}
+}
+
+// StorageMap maps a range of the build's uncompressed data to the frame that stores it in the data object.
+// Offset and Length are in the same space as BuildMap.BuildStorageOffset.
+type StorageMap struct {
+	Offset           uint64
+	Length           uint64
+	CompressedOffset uint64
+	CompressedLength uint64
+	// Checksum is the CRC-32C of the uncompressed frame data.
+	Checksum uint32
+	Reserved uint32
+}
+
+// StorageMetadata describes how the build's own data object is stored.
+type StorageMetadata struct {
+	Compression Compression
+	Frames      []*StorageMap
+}
+
+// Size returns the uncompressed size of the data object.
+func (s *StorageMetadata) Size() int64 {
+	if len(s.Frames) == 0 {
+		return 0
+	}
+
+	last := s.Frames[len(s.Frames)-1]
+
+	return int64(last.Offset + last.Length)
+}
+
+// Frame returns the frame containing the uncompressed storage offset.
+func (s *StorageMetadata) Frame(offset int64) (*StorageMap, error) {
+	idx := sort.Search(len(s.Frames), func(i int) bool {
+		return int64(s.Frames[i].Offset+s.Frames[i].Length) > offset
+	})
+
+	if offset < 0 || idx >= len(s.Frames) {
+		return nil, fmt.Errorf("no storage frame found for offset %d", offset)
+	}
+
+	return s.Frames[idx], nil
+}
+
+func compress(codec Compression, data []byte) ([]byte, error) {
+	switch codec {
+	case CompressionNone:
+		return data, nil
+	case CompressionZstd:
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd encoder: %w", err)
+		}
+
+		return encoder.EncodeAll(data, nil), nil
+	case CompressionLz4:
+		var buf bytes.Buffer
+
+		w := lz4.NewWriter(&buf)
+		_, err := w.Write(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lz4 compress frame: %w", err)
+		}
+
+		err = w.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to close lz4 writer: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", codec)
+	}
+}
+
+func decompress(codec Compression, data []byte, length uint64) ([]byte, error) {
+	switch codec {
+	case CompressionNone:
+		return data, nil
+	case CompressionZstd:
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd decoder: %w", err)
+		}
+
+		return decoder.DecodeAll(data, make([]byte, 0, length))
+	case CompressionLz4:
+		out := make([]byte, length)
+
+		_, err := io.ReadFull(lz4.NewReader(bytes.NewReader(data)), out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lz4 decompress frame: %w", err)
+		}
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", codec)
+	}
+}
+
+// CompressFrames splits the source into StorageFrameSize frames, compresses them and writes them to the destination.
+// It returns the storage metadata describing the written frames.
+func CompressFrames(codec Compression, src io.Reader, dst io.Writer) (*StorageMetadata, error) {
+	storage := &StorageMetadata{
+		Compression: codec,
+	}
+
+	err := storage.AppendFrames(src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage, nil
+}
+
+// AppendFrames compresses the source into new frames placed right after the existing ones.
+// The destination must continue where the already stored frames end.
+func (s *StorageMetadata) AppendFrames(src io.Reader, dst io.Writer) error {
+	var offset, compressedOffset uint64
+	if len(s.Frames) > 0 {
+		last := s.Frames[len(s.Frames)-1]
+
+		offset = last.Offset + last.Length
+		compressedOffset = last.CompressedOffset + last.CompressedLength
+	}
+
+	buf := make([]byte, StorageFrameSize)
+
+	for {
+		n, readErr := io.ReadFull(src, buf)
+		if n > 0 {
+			data := buf[:n]
+
+			compressed, err := compress(s.Compression, data)
+			if err != nil {
+				return err
+			}
+
+			_, err = dst.Write(compressed)
+			if err != nil {
+				return fmt.Errorf("failed to write frame at offset %d: %w", offset, err)
+			}
+
+			s.Frames = append(s.Frames, &StorageMap{
+				Offset:           offset,
+				Length:           uint64(n),
+				CompressedOffset: compressedOffset,
+				CompressedLength: uint64(len(compressed)),
+				Checksum:         crc32.Checksum(data, crc32c),
+			})
+
+			offset += uint64(n)
+			compressedOffset += uint64(len(compressed))
+		}
+
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if readErr != nil {
+			return fmt.Errorf("failed to read frame at offset %d: %w", offset, readErr)
+		}
+	}
+}
+
+// DecompressFrame decompresses the frame data and verifies its checksum.
+func DecompressFrame(codec Compression, frame *StorageMap, compressed []byte) ([]byte, error) {
+	data, err := decompress(codec, compressed, frame.Length)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress frame at offset %d: %w", frame.Offset, err)
+	}
+
+	if uint64(len(data)) != frame.Length {
+		return nil, fmt.Errorf("frame at offset %d has %d bytes, expected %d", frame.Offset, len(data), frame.Length)
+	}
+
+	if checksum := crc32.Checksum(data, crc32c); checksum != frame.Checksum {
+		return nil, fmt.Errorf("frame at offset %d: %w (got %08x, expected %08x)", frame.Offset, ErrChecksumMismatch, checksum, frame.Checksum)
+	}
+
+	return data, nil
+}
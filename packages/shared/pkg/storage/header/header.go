@@ -13,6 +13,9 @@ type Header struct {
 	startMap    map[int64]*BuildMap
 
 	Mapping []*BuildMap
+
+	// Storage describes the build's own data object, it is only present in VersionFramed headers.
+	Storage *StorageMetadata
 }
 
 func NewHeader(metadata *Metadata, mapping []*BuildMap) *Header {
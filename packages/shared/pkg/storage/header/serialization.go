@@ -9,6 +9,14 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// VersionRaw headers contain the metadata followed by the build mappings, data objects are stored uncompressed.
+	VersionRaw = 1
+	// VersionFramed headers also describe how the build's own data object is stored:
+	// the compression codec and the checksummed storage frames.
+	VersionFramed = 2
+)
+
 type Metadata struct {
 	Version    uint64
 	BlockSize  uint64
@@ -21,7 +29,7 @@ type Metadata struct {
 
 func NewTemplateMetadata(buildId uuid.UUID, blockSize, size uint64) *Metadata {
 	return &Metadata{
-		Version:     1,
+		Version:     VersionRaw,
 		Generation:  0,
 		BlockSize:   blockSize,
 		Size:        size,
@@ -32,7 +40,7 @@ func NewTemplateMetadata(buildId uuid.UUID, blockSize, size uint64) *Metadata {
 
 func (m *Metadata) NextGeneration(buildID uuid.UUID) *Metadata {
 	return &Metadata{
-		Version:     1,
+		Version:     VersionRaw,
 		Generation:  m.Generation + 1,
 		BlockSize:   m.BlockSize,
 		Size:        m.Size,
@@ -41,15 +49,53 @@ func (m *Metadata) NextGeneration(buildID uuid.UUID) *Metadata {
 	}
 }
 
-func Serialize(metadata *Metadata, mappings []*BuildMap) (io.Reader, error) {
+// storageHeader follows the metadata in VersionFramed headers.
+type storageHeader struct {
+	Compression Compression
+	Reserved    uint32
+	Frames      uint64
+}
+
+// Serialize writes the header in the format given by its metadata version.
+func Serialize(h *Header) (io.Reader, error) {
 	var buf bytes.Buffer
 
+	metadata := h.Metadata
+
+	switch metadata.Version {
+	case VersionRaw, VersionFramed:
+	default:
+		return nil, fmt.Errorf("unsupported header version: %d", metadata.Version)
+	}
+
 	err := binary.Write(&buf, binary.LittleEndian, metadata)
 	if err != nil {
 		return nil, fmt.Errorf("failed to write metadata: %w", err)
 	}
 
-	for _, mapping := range mappings {
+	if metadata.Version >= VersionFramed {
+		storage := h.Storage
+		if storage == nil {
+			return nil, fmt.Errorf("missing storage metadata for header version %d", metadata.Version)
+		}
+
+		err = binary.Write(&buf, binary.LittleEndian, &storageHeader{
+			Compression: storage.Compression,
+			Frames:      uint64(len(storage.Frames)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to write storage metadata: %w", err)
+		}
+
+		for _, frame := range storage.Frames {
+			err := binary.Write(&buf, binary.LittleEndian, frame)
+			if err != nil {
+				return nil, fmt.Errorf("failed to write storage frame: %w", err)
+			}
+		}
+	}
+
+	for _, mapping := range h.Mapping {
 		err := binary.Write(&buf, binary.LittleEndian, mapping)
 		if err != nil {
 			return nil, fmt.Errorf("failed to write block mapping: %w", err)
@@ -76,6 +122,19 @@ func Deserialize(in io.WriterTo) (*Header, error) {
 		return nil, fmt.Errorf("failed to read metadata: %w", err)
 	}
 
+	var storage *StorageMetadata
+
+	switch metadata.Version {
+	case VersionRaw:
+	case VersionFramed:
+		storage, err = deserializeStorage(reader)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported header version: %d", metadata.Version)
+	}
+
 	mappings := make([]*BuildMap, 0)
 
 	for {
@@ -92,5 +151,55 @@ func Deserialize(in io.WriterTo) (*Header, error) {
 		mappings = append(mappings, &m)
 	}
 
-	return NewHeader(&metadata, mappings), nil
+	h := NewHeader(&metadata, mappings)
+	h.Storage = storage
+
+	return h, nil
+}
+
+func deserializeStorage(reader io.Reader) (*StorageMetadata, error) {
+	var sh storageHeader
+
+	err := binary.Read(reader, binary.LittleEndian, &sh)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage metadata: %w", err)
+	}
+
+	switch sh.Compression {
+	case CompressionNone, CompressionZstd, CompressionLz4:
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", sh.Compression)
+	}
+
+	frames := make([]*StorageMap, 0, sh.Frames)
+
+	var offset, compressedOffset uint64
+
+	for i := uint64(0); i < sh.Frames; i++ {
+		var frame StorageMap
+
+		err := binary.Read(reader, binary.LittleEndian, &frame)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read storage frame %d: %w", i, err)
+		}
+
+		if frame.Offset != offset || frame.CompressedOffset != compressedOffset {
+			return nil, fmt.Errorf("storage frame %d is not contiguous", i)
+		}
+
+		// Frames are usually StorageFrameSize long, but appending to an existing object can leave shorter frames in between.
+		if frame.Length == 0 || frame.Length > StorageFrameSize {
+			return nil, fmt.Errorf("storage frame %d has invalid length %d", i, frame.Length)
+		}
+
+		offset += frame.Length
+		compressedOffset += frame.CompressedLength
+
+		frames = append(frames, &frame)
+	}
+
+	return &StorageMetadata{
+		Compression: sh.Compression,
+		Frames:      frames,
+	}, nil
 }
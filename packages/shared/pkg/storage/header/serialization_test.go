@@ -0,0 +1,104 @@
+package header
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMappings(buildID uuid.UUID) []*BuildMap {
+	return []*BuildMap{
+		{Offset: 0, Length: 2 * RootfsBlockSize, BuildId: buildID, BuildStorageOffset: 0},
+		{Offset: 2 * RootfsBlockSize, Length: 2 * RootfsBlockSize, BuildId: uuid.Nil, BuildStorageOffset: 0},
+	}
+}
+
+func TestSerialize_RawRoundTrip(t *testing.T) {
+	buildID := uuid.New()
+	h := NewHeader(NewTemplateMetadata(buildID, RootfsBlockSize, 4*RootfsBlockSize), testMappings(buildID))
+
+	serialized, err := Serialize(h)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(serialized)
+	require.NoError(t, err)
+
+	deserialized, err := Deserialize(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, h.Metadata, deserialized.Metadata)
+	assert.Equal(t, h.Mapping, deserialized.Mapping)
+	assert.Nil(t, deserialized.Storage)
+}
+
+func TestSerialize_FramedRoundTrip(t *testing.T) {
+	buildID := uuid.New()
+	data := bytes.Repeat([]byte("framed"), StorageFrameSize/3)
+
+	var compressed bytes.Buffer
+	storage, err := CompressFrames(CompressionZstd, bytes.NewReader(data), &compressed)
+	require.NoError(t, err)
+	require.Len(t, storage.Frames, 2)
+	require.Equal(t, int64(len(data)), storage.Size())
+
+	metadata := NewTemplateMetadata(buildID, RootfsBlockSize, 4*RootfsBlockSize)
+	metadata.Version = VersionFramed
+
+	h := NewHeader(metadata, testMappings(buildID))
+	h.Storage = storage
+
+	serialized, err := Serialize(h)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(serialized)
+	require.NoError(t, err)
+
+	deserialized, err := Deserialize(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, h.Metadata, deserialized.Metadata)
+	assert.Equal(t, h.Mapping, deserialized.Mapping)
+	assert.Equal(t, storage, deserialized.Storage)
+}
+
+func TestSerialize_FramedRequiresStorage(t *testing.T) {
+	buildID := uuid.New()
+
+	metadata := NewTemplateMetadata(buildID, RootfsBlockSize, 4*RootfsBlockSize)
+	metadata.Version = VersionFramed
+
+	_, err := Serialize(NewHeader(metadata, nil))
+	assert.Error(t, err)
+}
+
+func TestDecompressFrame(t *testing.T) {
+	for _, codec := range []Compression{CompressionNone, CompressionZstd, CompressionLz4} {
+		t.Run(codec.String(), func(t *testing.T) {
+			data := bytes.Repeat([]byte{1, 2, 3, 4}, StorageFrameSize/4+10)
+
+			var compressed bytes.Buffer
+			storage, err := CompressFrames(codec, bytes.NewReader(data), &compressed)
+			require.NoError(t, err)
+
+			frame, err := storage.Frame(StorageFrameSize + 1)
+			require.NoError(t, err)
+
+			stored := compressed.Bytes()[frame.CompressedOffset : frame.CompressedOffset+frame.CompressedLength]
+
+			out, err := DecompressFrame(codec, frame, stored)
+			require.NoError(t, err)
+			assert.Equal(t, data[StorageFrameSize:], out)
+
+			corrupted := *frame
+			corrupted.Checksum++
+
+			_, err = DecompressFrame(codec, &corrupted, stored)
+			assert.ErrorIs(t, err, ErrChecksumMismatch)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NetworkStats are the network counters of the paused sandbox, so the counters of the resumed sandbox continue from them.
+// The builds of templates and forks don't have them.
+type NetworkStats struct {
+	RxBytes        uint64 `json:"rx_bytes"`
+	TxBytes        uint64 `json:"tx_bytes"`
+	RxPackets      uint64 `json:"rx_packets"`
+	TxPackets      uint64 `json:"tx_packets"`
+	NewConnections uint64 `json:"new_connections"`
+}
+
+// UploadNetworkStats uploads the network counters of the sandbox paused into the build.
+func UploadNetworkStats(ctx context.Context, persistence StorageProvider, files *TemplateFiles, stats *NetworkStats) error {
+	object, err := persistence.OpenObject(ctx, files.StorageNetworkStatsPath())
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("error when serializing network stats: %w", err)
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error when uploading network stats: %w", err)
+	}
+
+	return nil
+}
+
+// ReadNetworkStats reads the network counters of the build, it returns ErrorObjectNotExist if the build doesn't have them.
+func ReadNetworkStats(ctx context.Context, persistence StorageProvider, files *TemplateFiles) (*NetworkStats, error) {
+	object, err := persistence.OpenObject(ctx, files.StorageNetworkStatsPath())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	_, err = object.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read network stats of build %s: %w", files.BuildId, err)
+	}
+
+	var stats NetworkStats
+
+	err = json.Unmarshal(buf.Bytes(), &stats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse network stats of build %s: %w", files.BuildId, err)
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkStats_UploadRead(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+
+	files := NewTemplateFiles("template", "build", "kernel", "v1.10.1_abc")
+
+	_, err := ReadNetworkStats(ctx, p, files)
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+
+	stats := &NetworkStats{RxBytes: 100, TxBytes: 200, RxPackets: 10, TxPackets: 20, NewConnections: 5}
+
+	err = UploadNetworkStats(ctx, p, files, stats)
+	require.NoError(t, err)
+
+	read, err := ReadNetworkStats(ctx, p, files)
+	require.NoError(t, err)
+	require.Equal(t, stats, read)
+}
@@ -10,8 +10,16 @@ import (
 
 const (
 	sandboxCacheDir = "/orchestrator/sandbox"
+
+	// SandboxVsockSocketName is the name of the vsock unix socket in the sandbox runtime directory.
+	SandboxVsockSocketName = "vsock.sock"
 )
 
+// SandboxCacheDir returns the directory with the local caches of the sandboxes.
+func SandboxCacheDir() string {
+	return sandboxCacheDir
+}
+
 type SandboxFiles struct {
 	*TemplateCacheFiles
 	SandboxID string
@@ -46,3 +54,17 @@ func (s *SandboxFiles) SandboxUffdSocketPath() string {
 func (s *SandboxFiles) SandboxCacheRootfsLinkPath() string {
 	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.link", s.SandboxID, s.randomID))
 }
+
+// SandboxRuntimeDir is the working directory of the sandbox's Firecracker process.
+// Paths relative to it are stored in the snapshot, so they are resolved to a different directory for each resumed sandbox.
+func (s *SandboxFiles) SandboxRuntimeDir() string {
+	return filepath.Join(s.tmpDir, fmt.Sprintf("fc-%s-%s", s.SandboxID, s.randomID))
+}
+
+func (s *SandboxFiles) SandboxVsockSocketPath() string {
+	return filepath.Join(s.SandboxRuntimeDir(), SandboxVsockSocketName)
+}
+
+func (s *SandboxFiles) SandboxCacheVolumePath(volumeID string) string {
+	return filepath.Join(sandboxCacheDir, fmt.Sprintf("volume-%s-%s-%s.cache", s.SandboxID, volumeID, s.randomID))
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SnapshotMetadata describes the Firecracker snapshot of the build, so the snapshot can be resumed by a different Firecracker version.
+// The builds uploaded before the metadata was introduced don't have it.
+type SnapshotMetadata struct {
+	// FirecrackerVersion is the version of the Firecracker that created the snapshot.
+	FirecrackerVersion string `json:"firecracker_version"`
+	// SnapshotVersion is the snapshot data format version of the Firecracker, empty when it could not be determined.
+	SnapshotVersion string `json:"snapshot_version,omitempty"`
+}
+
+func (t *TemplateBuild) uploadSnapshotMetadata(ctx context.Context, metadata *SnapshotMetadata) error {
+	object, err := t.persistence.OpenObject(ctx, t.files.StorageSnapshotMetadataPath())
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("error when serializing snapshot metadata: %w", err)
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error when uploading snapshot metadata: %w", err)
+	}
+
+	return nil
+}
+
+// ReadSnapshotMetadata reads the snapshot metadata of the build, it returns ErrorObjectNotExist if the build doesn't have it.
+func ReadSnapshotMetadata(ctx context.Context, persistence StorageProvider, files *TemplateFiles) (*SnapshotMetadata, error) {
+	object, err := persistence.OpenObject(ctx, files.StorageSnapshotMetadataPath())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	_, err = object.WriteTo(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata of build %s: %w", files.BuildId, err)
+	}
+
+	var metadata SnapshotMetadata
+
+	err = json.Unmarshal(buf.Bytes(), &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot metadata of build %s: %w", files.BuildId, err)
+	}
+
+	return &metadata, nil
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotMetadata_UploadRead(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+
+	snapfilePath := filepath.Join(t.TempDir(), SnapfileName)
+	require.NoError(t, os.WriteFile(snapfilePath, []byte("snapfile"), 0o644))
+
+	files := NewTemplateFiles("template", "build", "kernel", "v1.10.1_abc")
+
+	_, err := ReadSnapshotMetadata(ctx, p, files)
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+
+	metadata := &SnapshotMetadata{FirecrackerVersion: "v1.10.1_abc", SnapshotVersion: "v2.1.0"}
+
+	err = <-NewTemplateBuild(nil, nil, p, files).Upload(ctx, snapfilePath, metadata, nil, nil)
+	require.NoError(t, err)
+
+	read, err := ReadSnapshotMetadata(ctx, p, files)
+	require.NoError(t, err)
+	require.Equal(t, metadata, read)
+}
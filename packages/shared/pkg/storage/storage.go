@@ -5,12 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
-var ErrorObjectNotExist = errors.New("object does not exist")
+var (
+	ErrorObjectNotExist     = errors.New("object does not exist")
+	ErrorObjectAlreadyExist = errors.New("object already exists")
+)
 
 type Provider string
 
@@ -18,15 +24,23 @@ const (
 	GCPStorageProvider   Provider = "GCPBucket"
 	AWSStorageProvider   Provider = "AWSBucket"
 	OCIStorageProvider   Provider = "OCIBucket"
+	S3StorageProvider    Provider = "S3Bucket"
 	LocalStorageProvider Provider = "Local"
 
 	DefaultStorageProvider Provider = GCPStorageProvider
 
-	storageProviderEnv = "STORAGE_PROVIDER"
+	storageProviderEnv   = "STORAGE_PROVIDER"
+	storageReplicasEnv   = "TEMPLATE_STORAGE_REPLICAS"
+	storageHedgeDelayEnv = "TEMPLATE_STORAGE_HEDGE_DELAY_MS"
+	storageStagingEnv    = "TEMPLATE_STORAGE_STAGING_PATH"
+
+	// DefaultStagingPath is on the orchestrator's cache disk, the replicated objects can be as large as the memfiles.
+	DefaultStagingPath = "/orchestrator/replication"
 )
 
 type StorageProvider interface {
 	DeleteObjectsWithPrefix(ctx context.Context, prefix string) error
+	ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error)
 	OpenObject(ctx context.Context, path string) (StorageObjectProvider, error)
 	GetDetails() string
 }
@@ -36,6 +50,9 @@ type StorageObjectProvider interface {
 	WriteFromFileSystem(path string) error
 
 	ReadFrom(src io.Reader) (int64, error)
+	// ReadFromIfNotExist writes the object only if it does not exist yet, otherwise it returns ErrorObjectAlreadyExist.
+	// Only one of the concurrent writers succeeds, so the object can be used as a lock.
+	ReadFromIfNotExist(src io.Reader) (int64, error)
 	ReadAt(buff []byte, off int64) (n int, err error)
 
 	Size() (int64, error)
@@ -43,6 +60,30 @@ type StorageObjectProvider interface {
 }
 
 func GetTemplateStorageProvider(ctx context.Context) (StorageProvider, error) {
+	if storageCompressionErr != nil {
+		return nil, storageCompressionErr
+	}
+
+	provider, err := getTemplateStorageProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if replicas := env.GetEnv(storageReplicasEnv, ""); replicas != "" {
+		provider, err = getReplicatedStorageProvider(ctx, provider, replicas)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if env.GetEnv(storageDedupEnv, "false") == "true" {
+		return NewDedupStorageProvider(provider), nil
+	}
+
+	return provider, nil
+}
+
+func getTemplateStorageProvider(ctx context.Context) (StorageProvider, error) {
 	provider := Provider(env.GetEnv(storageProviderEnv, string(DefaultStorageProvider)))
 
 	if provider == LocalStorageProvider {
@@ -52,15 +93,93 @@ func GetTemplateStorageProvider(ctx context.Context) (StorageProvider, error) {
 
 	bucketName := utils.RequiredEnv("TEMPLATE_BUCKET_NAME", "Bucket for storing template files")
 
-	// cloud bucket-based storage
+	return newBucketStorageProvider(ctx, provider, bucketName, "")
+}
+
+// newBucketStorageProvider creates the provider for the bucket, an empty region uses the region configured for the provider.
+func newBucketStorageProvider(ctx context.Context, provider Provider, bucketName, region string) (StorageProvider, error) {
+	if region != "" && provider != OCIStorageProvider && provider != S3StorageProvider {
+		return nil, fmt.Errorf("region cannot be set for storage provider %s", provider)
+	}
+
 	switch provider {
+	case LocalStorageProvider:
+		return NewFileSystemStorageProvider(bucketName)
+	// cloud bucket-based storage
 	case AWSStorageProvider:
 		return NewAWSBucketStorageProvider(ctx, bucketName)
 	case GCPStorageProvider:
 		return NewGCPBucketStorageProvider(ctx, bucketName)
 	case OCIStorageProvider:
-		return NewOCIBucketStorageProvider(ctx, bucketName)
+		return NewOCIBucketStorageProviderWithAuth(ctx, bucketName, region, OCIAuthConfigFromEnv())
+	case S3StorageProvider:
+		s3Config := S3ConfigFromEnv()
+		if region != "" {
+			s3Config.Region = region
+		}
+
+		return NewS3BucketStorageProvider(ctx, bucketName, s3Config)
 	}
 
 	return nil, fmt.Errorf("unknown storage provider: %s", provider)
 }
+
+// getReplicatedStorageProvider wraps the primary provider with the replicas listed as "<provider>:<bucket>[@<region>]",
+// separated by commas. The bucket of a Local replica is its base path.
+func getReplicatedStorageProvider(ctx context.Context, primary StorageProvider, replicas string) (StorageProvider, error) {
+	hedgeDelayMs, err := env.GetEnvAsInt(storageHedgeDelayEnv, int(DefaultHedgeDelay/time.Millisecond))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", storageHedgeDelayEnv, err)
+	}
+
+	stagingPath := env.GetEnv(storageStagingEnv, DefaultStagingPath)
+
+	err = os.MkdirAll(stagingPath, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replication staging directory: %w", err)
+	}
+
+	var providers []StorageProvider
+	for _, replica := range strings.Split(replicas, ",") {
+		provider, location, ok := strings.Cut(strings.TrimSpace(replica), ":")
+		if !ok || location == "" {
+			return nil, fmt.Errorf("invalid storage replica %q, expected <provider>:<bucket>[@<region>]", replica)
+		}
+
+		bucketName, region, _ := strings.Cut(location, "@")
+
+		p, err := newBucketStorageProvider(ctx, Provider(provider), bucketName, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create storage replica %q: %w", replica, err)
+		}
+
+		providers = append(providers, p)
+	}
+
+	return NewReplicatedStorageProvider(primary, time.Duration(hedgeDelayMs)*time.Millisecond, stagingPath, providers...), nil
+}
+
+// WaitForPendingWrites blocks until the writes the provider finishes in the background are done,
+// like the replication of the uploaded objects to the other regions.
+func WaitForPendingWrites(ctx context.Context, provider StorageProvider) error {
+	switch p := provider.(type) {
+	case *DedupStorageProvider:
+		return WaitForPendingWrites(ctx, p.base)
+	case *ReplicatedStorageProvider:
+		done := make(chan struct{})
+
+		go func() {
+			p.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for replication: %w", ctx.Err())
+		}
+	}
+
+	return nil
+}
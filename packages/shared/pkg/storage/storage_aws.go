@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -71,6 +73,27 @@ func (a *AWSBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return err
 }
 
+func (a *AWSBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, awsOperationTimeout)
+	defer cancel()
+
+	var objects []string
+
+	paginator := s3.NewListObjectsV2Paginator(a.client, &s3.ListObjectsV2Input{Bucket: &a.bucketName, Prefix: &prefix})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range page.Contents {
+			objects = append(objects, *obj.Key)
+		}
+	}
+
+	return objects, nil
+}
+
 func (a *AWSBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[AWS Storage, bucket set to %s]", a.bucketName)
 }
@@ -152,6 +175,33 @@ func (a *AWSBucketStorageObjectProvider) ReadFrom(src io.Reader) (int64, error)
 	return 0, nil
 }
 
+func (a *AWSBucketStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, awsWriteTimeout)
+	defer cancel()
+
+	_, err := a.client.PutObject(
+		ctx,
+		&s3.PutObjectInput{
+			Bucket:      &a.bucketName,
+			Key:         &a.path,
+			Body:        src,
+			IfNoneMatch: aws.String("*"),
+		},
+	)
+
+	var respErr *awshttp.ResponseError
+	// A conflict is returned when a concurrent conditional write of the same object is in progress.
+	if errors.As(err, &respErr) && (respErr.HTTPStatusCode() == http.StatusPreconditionFailed || respErr.HTTPStatusCode() == http.StatusConflict) {
+		return 0, ErrorObjectAlreadyExist
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
+
 func (a *AWSBucketStorageObjectProvider) ReadAt(buff []byte, off int64) (n int, err error) {
 	ctx, cancel := context.WithTimeout(a.ctx, awsReadTimeout)
 	defer cancel()
@@ -184,6 +234,11 @@ func (a *AWSBucketStorageObjectProvider) Size() (int64, error) {
 
 	resp, err := a.client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: &a.bucketName, Key: &a.path})
 	if err != nil {
+		var nf *types.NotFound
+		if errors.As(err, &nf) {
+			return 0, ErrorObjectNotExist
+		}
+
 		return 0, err
 	}
 
@@ -0,0 +1,647 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	// DedupChunkSize matches the chunk size used by the orchestrator when fetching data objects,
+	// so each fetch maps to exactly one content-addressed chunk.
+	DedupChunkSize = 4 * 1024 * 1024 // 4 MB
+
+	ChunkIndexSuffix = ".chunks"
+
+	// pendingIndexSuffix marks the chunk index of an upload in progress, it keeps the reused chunks referenced
+	// until the final index is written.
+	pendingIndexSuffix = ".pending"
+
+	chunksDir       = "chunks"
+	sweepsDir       = "chunk-sweeps"
+	storageDedupEnv = "TEMPLATE_STORAGE_DEDUP"
+
+	// sweepMarkerTTL bounds how long a sweep may delete chunks after publishing its marker.
+	// Markers older than that are left by crashed sweeps and are ignored by the uploads.
+	sweepMarkerTTL = time.Hour
+	// sweepWaitInterval is how often an upload checks whether the sweeps deleting its chunks have finished.
+	sweepWaitInterval = 5 * time.Second
+)
+
+// DedupStorageProvider stores data objects as content-addressed chunks that are shared between builds.
+//
+// Objects uploaded with WriteFromFileSystem are split into DedupChunkSize chunks stored under chunks/<sha256>,
+// and a chunk index is written next to the object path. The chunk indexes are the only references to the chunks,
+// deleting an object removes just its index and the chunks no longer referenced by any index are removed by CollectChunks.
+// Objects written with ReadFrom (headers, snapfiles) and objects uploaded before deduplication was enabled
+// are passed through to the underlying provider unchanged.
+//
+// The provider does not need any locking between the instances sharing the bucket:
+//   - an upload writes a pending index with all its chunks before it checks which chunks are already stored,
+//   - a sweep publishes the chunks it is going to delete in a marker object before it reads the indexes for the second time,
+//   - an upload waits for the sweeps whose markers contain its chunks, so it never relies on a chunk that is being deleted.
+type DedupStorageProvider struct {
+	base StorageProvider
+}
+
+type DedupStorageObjectProvider struct {
+	storage *DedupStorageProvider
+	path    string
+	ctx     context.Context
+
+	index     *header.ChunkIndex
+	indexErr  error
+	indexOnce sync.Once
+}
+
+func NewDedupStorageProvider(base StorageProvider) *DedupStorageProvider {
+	return &DedupStorageProvider{
+		base: base,
+	}
+}
+
+func chunkPath(hash header.ChunkHash) string {
+	return fmt.Sprintf("%s/%s", chunksDir, hash)
+}
+
+func chunkIndexPath(path string) string {
+	return path + ChunkIndexSuffix
+}
+
+func pendingIndexPath(path string) string {
+	return chunkIndexPath(path) + pendingIndexSuffix
+}
+
+func isChunkIndex(object string) bool {
+	return strings.HasSuffix(object, ChunkIndexSuffix) || strings.HasSuffix(object, ChunkIndexSuffix+pendingIndexSuffix)
+}
+
+// DeleteObjectsWithPrefix removes all objects under the prefix, including their chunk indexes.
+// The chunks that are no longer referenced are removed by the next CollectChunks.
+func (d *DedupStorageProvider) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	return d.base.DeleteObjectsWithPrefix(ctx, prefix)
+}
+
+func (d *DedupStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	return d.base.ListObjectsWithPrefix(ctx, prefix)
+}
+
+func (d *DedupStorageProvider) GetDetails() string {
+	return fmt.Sprintf("[Deduplicated storage, chunk size %d B, backed by %s]", DedupChunkSize, d.base.GetDetails())
+}
+
+func (d *DedupStorageProvider) OpenObject(ctx context.Context, path string) (StorageObjectProvider, error) {
+	return &DedupStorageObjectProvider{
+		storage: d,
+		path:    path,
+		ctx:     ctx,
+	}, nil
+}
+
+// ChunkIndex returns the chunk index of the object, or ErrorObjectNotExist if the object is not deduplicated.
+func (d *DedupStorageProvider) ChunkIndex(ctx context.Context, path string) (*header.ChunkIndex, error) {
+	return d.readIndex(ctx, chunkIndexPath(path))
+}
+
+func (d *DedupStorageProvider) readIndex(ctx context.Context, indexPath string) (*header.ChunkIndex, error) {
+	object, err := d.base.OpenObject(ctx, indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.DeserializeChunkIndex(object)
+}
+
+func (d *DedupStorageProvider) writeIndex(ctx context.Context, indexPath string, index *header.ChunkIndex) error {
+	serialized, err := header.SerializeChunkIndex(index)
+	if err != nil {
+		return fmt.Errorf("failed to serialize chunk index: %w", err)
+	}
+
+	object, err := d.base.OpenObject(ctx, indexPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = object.ReadFrom(serialized)
+	if err != nil {
+		return fmt.Errorf("failed to upload chunk index: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DedupStorageProvider) writeChunk(ctx context.Context, hash header.ChunkHash, data []byte) error {
+	object, err := d.base.OpenObject(ctx, chunkPath(hash))
+	if err != nil {
+		return err
+	}
+
+	_, err = object.Size()
+	if err == nil {
+		// The chunk is already stored by another build.
+		return nil
+	}
+
+	_, err = object.ReadFrom(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to upload chunk %s: %w", hash, err)
+	}
+
+	return nil
+}
+
+// referencedChunks returns the chunks referenced by all chunk indexes in the storage, including the pending ones.
+func (d *DedupStorageProvider) referencedChunks(ctx context.Context) (map[header.ChunkHash]struct{}, error) {
+	objects, err := d.base.ListObjectsWithPrefix(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	referenced := make(map[header.ChunkHash]struct{})
+
+	for _, object := range objects {
+		if !isChunkIndex(object) {
+			continue
+		}
+
+		index, err := d.readIndex(ctx, object)
+		if errors.Is(err, ErrorObjectNotExist) {
+			// The index was removed after listing, pending indexes are removed once the upload finishes.
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk index %s: %w", object, err)
+		}
+
+		for _, hash := range index.Hashes {
+			referenced[hash] = struct{}{}
+		}
+	}
+
+	return referenced, nil
+}
+
+// storedChunks returns the chunks present in the storage.
+func (d *DedupStorageProvider) storedChunks(ctx context.Context) ([]header.ChunkHash, error) {
+	objects, err := d.base.ListObjectsWithPrefix(ctx, chunksDir+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunks: %w", err)
+	}
+
+	hashes := make([]header.ChunkHash, 0, len(objects))
+
+	for _, object := range objects {
+		hash, err := header.ParseChunkHash(strings.TrimPrefix(object, chunksDir+"/"))
+		if err != nil {
+			zap.L().Warn("skipping unexpected object in the chunks directory", zap.String("object", object), zap.Error(err))
+
+			continue
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+func unreferencedChunks(hashes []header.ChunkHash, referenced map[header.ChunkHash]struct{}) []header.ChunkHash {
+	var unreferenced []header.ChunkHash
+
+	for _, hash := range hashes {
+		if _, ok := referenced[hash]; !ok {
+			unreferenced = append(unreferenced, hash)
+		}
+	}
+
+	return unreferenced
+}
+
+// CollectChunks deletes the chunks that are not referenced by any chunk index and returns their count.
+// It is safe to run concurrently with uploads and other collections, also from other instances sharing the bucket.
+func (d *DedupStorageProvider) CollectChunks(ctx context.Context) (int, error) {
+	stored, err := d.storedChunks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	referenced, err := d.referencedChunks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	candidates := unreferencedChunks(stored, referenced)
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	started := time.Now()
+
+	marker, err := d.base.OpenObject(ctx, fmt.Sprintf("%s/%s", sweepsDir, uuid.New()))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = marker.ReadFrom(serializeSweepMarker(started, candidates))
+	if err != nil {
+		return 0, fmt.Errorf("failed to write sweep marker: %w", err)
+	}
+
+	defer func() {
+		err := marker.Delete()
+		if err != nil && !errors.Is(err, ErrorObjectNotExist) {
+			zap.L().Error("failed to delete sweep marker", zap.Error(err))
+		}
+	}()
+
+	// The uploads that started before the marker was written have their pending indexes stored by now,
+	// the uploads that start later wait for the marker to be removed.
+	referenced, err = d.referencedChunks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int
+
+	for _, hash := range unreferencedChunks(candidates, referenced) {
+		if time.Since(started) > sweepMarkerTTL/2 {
+			// The uploads ignore the markers older than sweepMarkerTTL, the remaining chunks are collected by the next run.
+			return deleted, nil
+		}
+
+		chunk, err := d.base.OpenObject(ctx, chunkPath(hash))
+		if err != nil {
+			return deleted, err
+		}
+
+		err = chunk.Delete()
+		if err != nil && !errors.Is(err, ErrorObjectNotExist) {
+			return deleted, fmt.Errorf("failed to delete chunk %s: %w", hash, err)
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func serializeSweepMarker(started time.Time, hashes []header.ChunkHash) io.Reader {
+	var buf bytes.Buffer
+
+	buf.WriteString(started.UTC().Format(time.RFC3339Nano))
+	for _, hash := range hashes {
+		buf.WriteByte('\n')
+		buf.WriteString(hash.String())
+	}
+
+	return &buf
+}
+
+func deserializeSweepMarker(data []byte) (time.Time, []header.ChunkHash, error) {
+	lines := strings.Split(string(data), "\n")
+
+	started, err := time.Parse(time.RFC3339Nano, lines[0])
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to parse sweep start: %w", err)
+	}
+
+	hashes := make([]header.ChunkHash, 0, len(lines)-1)
+
+	for _, line := range lines[1:] {
+		hash, err := header.ParseChunkHash(line)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return started, hashes, nil
+}
+
+// sweepsDeleting returns true if a running sweep may delete any of the chunks.
+func (d *DedupStorageProvider) sweepsDeleting(ctx context.Context, chunks map[header.ChunkHash]struct{}) (bool, error) {
+	markers, err := d.base.ListObjectsWithPrefix(ctx, sweepsDir+"/")
+	if err != nil {
+		return false, fmt.Errorf("failed to list sweep markers: %w", err)
+	}
+
+	for _, marker := range markers {
+		object, err := d.base.OpenObject(ctx, marker)
+		if err != nil {
+			return false, err
+		}
+
+		var buf bytes.Buffer
+
+		_, err = object.WriteTo(&buf)
+		if errors.Is(err, ErrorObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return false, fmt.Errorf("failed to read sweep marker %s: %w", marker, err)
+		}
+
+		started, hashes, err := deserializeSweepMarker(buf.Bytes())
+		if err != nil {
+			return false, fmt.Errorf("failed to parse sweep marker %s: %w", marker, err)
+		}
+
+		if time.Since(started) > sweepMarkerTTL {
+			continue
+		}
+
+		for _, hash := range hashes {
+			if _, ok := chunks[hash]; ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// waitForSweeps blocks until no running sweep may delete any of the chunks.
+func (d *DedupStorageProvider) waitForSweeps(ctx context.Context, chunks map[header.ChunkHash]struct{}) error {
+	for {
+		deleting, err := d.sweepsDeleting(ctx, chunks)
+		if err != nil {
+			return err
+		}
+
+		if !deleting {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sweepWaitInterval):
+		}
+	}
+}
+
+func (o *DedupStorageObjectProvider) getIndex() (*header.ChunkIndex, error) {
+	o.indexOnce.Do(func() {
+		o.index, o.indexErr = o.storage.ChunkIndex(o.ctx, o.path)
+	})
+
+	return o.index, o.indexErr
+}
+
+// baseObject returns the underlying object when the object has no chunk index.
+func (o *DedupStorageObjectProvider) baseObject() (StorageObjectProvider, error) {
+	return o.storage.base.OpenObject(o.ctx, o.path)
+}
+
+func (o *DedupStorageObjectProvider) WriteTo(dst io.Writer) (int64, error) {
+	index, err := o.getIndex()
+	if errors.Is(err, ErrorObjectNotExist) {
+		object, err := o.baseObject()
+		if err != nil {
+			return 0, err
+		}
+
+		return object.WriteTo(dst)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chunk index: %w", err)
+	}
+
+	var n int64
+
+	for _, hash := range index.Hashes {
+		chunk, err := o.storage.base.OpenObject(o.ctx, chunkPath(hash))
+		if err != nil {
+			return n, err
+		}
+
+		written, err := chunk.WriteTo(dst)
+		n += written
+		if err != nil {
+			return n, fmt.Errorf("failed to read chunk %s: %w", hash, err)
+		}
+	}
+
+	return n, nil
+}
+
+// WriteFromFileSystem uploads the file as content-addressed chunks, skipping chunks that are already stored.
+func (o *DedupStorageObjectProvider) WriteFromFileSystem(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	index, err := hashChunks(file)
+	if err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	// The pending index keeps the chunks referenced while they are uploaded, the chunks already stored
+	// by other builds can't be collected before the final index is written.
+	pendingPath := pendingIndexPath(o.path)
+
+	err = o.storage.writeIndex(o.ctx, pendingPath, index)
+	if err != nil {
+		return fmt.Errorf("failed to write pending chunk index: %w", err)
+	}
+
+	chunks := make(map[header.ChunkHash]struct{}, len(index.Hashes))
+	for _, hash := range index.Hashes {
+		chunks[hash] = struct{}{}
+	}
+
+	err = o.storage.waitForSweeps(o.ctx, chunks)
+	if err != nil {
+		return fmt.Errorf("failed to wait for chunk collection: %w", err)
+	}
+
+	buf := make([]byte, DedupChunkSize)
+
+	for i, hash := range index.Hashes {
+		n, err := file.ReadAt(buf[:index.ChunkLength(i)], header.BlockOffset(int64(i), DedupChunkSize))
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		err = o.storage.writeChunk(o.ctx, hash, buf[:n])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = o.storage.writeIndex(o.ctx, chunkIndexPath(o.path), index)
+	if err != nil {
+		return err
+	}
+
+	o.indexOnce = sync.Once{}
+
+	pending, err := o.storage.base.OpenObject(o.ctx, pendingPath)
+	if err != nil {
+		return err
+	}
+
+	err = pending.Delete()
+	if err != nil && !errors.Is(err, ErrorObjectNotExist) {
+		return fmt.Errorf("failed to delete pending chunk index: %w", err)
+	}
+
+	return nil
+}
+
+// hashChunks returns the chunk index of the file.
+func hashChunks(file io.Reader) (*header.ChunkIndex, error) {
+	index := &header.ChunkIndex{
+		ChunkSize: DedupChunkSize,
+	}
+
+	buf := make([]byte, DedupChunkSize)
+
+	for {
+		n, err := io.ReadFull(file, buf)
+		if n > 0 {
+			index.Hashes = append(index.Hashes, header.ChunkHash(sha256.Sum256(buf[:n])))
+			index.Size += uint64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return index, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (o *DedupStorageObjectProvider) ReadFrom(src io.Reader) (int64, error) {
+	object, err := o.baseObject()
+	if err != nil {
+		return 0, err
+	}
+
+	return object.ReadFrom(src)
+}
+
+func (o *DedupStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
+	object, err := o.baseObject()
+	if err != nil {
+		return 0, err
+	}
+
+	return object.ReadFromIfNotExist(src)
+}
+
+func (o *DedupStorageObjectProvider) ReadAt(buff []byte, off int64) (n int, err error) {
+	index, err := o.getIndex()
+	if errors.Is(err, ErrorObjectNotExist) {
+		object, err := o.baseObject()
+		if err != nil {
+			return 0, err
+		}
+
+		return object.ReadAt(buff, off)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chunk index: %w", err)
+	}
+
+	for n < len(buff) {
+		if uint64(off)+uint64(n) >= index.Size {
+			return n, io.EOF
+		}
+
+		hash, shift, err := index.Lookup(off + int64(n))
+		if err != nil {
+			return n, err
+		}
+
+		chunk, err := o.storage.base.OpenObject(o.ctx, chunkPath(hash))
+		if err != nil {
+			return n, err
+		}
+
+		chunkIdx := header.BlockIdx(off+int64(n), int64(index.ChunkSize))
+		readLength := min(int64(len(buff)-n), index.ChunkLength(int(chunkIdx))-shift)
+
+		read, err := chunk.ReadAt(buff[n:int64(n)+readLength], shift)
+		n += read
+		if err != nil && !errors.Is(err, io.EOF) {
+			return n, fmt.Errorf("failed to read chunk %s: %w", hash, err)
+		}
+
+		if int64(read) < readLength {
+			return n, fmt.Errorf("chunk %s is shorter than expected: %w", hash, io.ErrUnexpectedEOF)
+		}
+	}
+
+	return n, nil
+}
+
+func (o *DedupStorageObjectProvider) Size() (int64, error) {
+	index, err := o.getIndex()
+	if errors.Is(err, ErrorObjectNotExist) {
+		object, err := o.baseObject()
+		if err != nil {
+			return 0, err
+		}
+
+		return object.Size()
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chunk index: %w", err)
+	}
+
+	return int64(index.Size), nil
+}
+
+// Delete removes the object and its chunk index, the chunks are removed by the next CollectChunks.
+func (o *DedupStorageObjectProvider) Delete() error {
+	existed := false
+
+	for _, path := range []string{o.path, chunkIndexPath(o.path), pendingIndexPath(o.path)} {
+		object, err := o.storage.base.OpenObject(o.ctx, path)
+		if err != nil {
+			return err
+		}
+
+		err = object.Delete()
+		if errors.Is(err, ErrorObjectNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to delete %s: %w", path, err)
+		}
+
+		existed = true
+	}
+
+	o.indexOnce = sync.Once{}
+
+	if !existed {
+		return ErrorObjectNotExist
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func writeTempFile(t *testing.T, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "data")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+// patterned returns data where each chunk is filled with the byte from the pattern.
+func patterned(pattern []byte, tail int) []byte {
+	data := make([]byte, 0, len(pattern)*DedupChunkSize+tail)
+	for _, b := range pattern {
+		data = append(data, bytes.Repeat([]byte{b}, DedupChunkSize)...)
+	}
+
+	return append(data, bytes.Repeat([]byte{0xff}, tail)...)
+}
+
+func countChunks(t *testing.T, p *FileSystemStorageProvider) int {
+	t.Helper()
+
+	objects, err := p.ListObjectsWithPrefix(context.Background(), chunksDir)
+	require.NoError(t, err)
+
+	return len(objects)
+}
+
+func TestDedup_SharedChunksAreStoredOnce(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	first := patterned([]byte{1, 2, 3}, 100)
+	second := patterned([]byte{1, 2, 4}, 100)
+
+	obj, err := p.OpenObject(ctx, "build-a/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, first)))
+
+	obj, err = p.OpenObject(ctx, "build-b/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, second)))
+
+	// 1, 2, 3, 4 and the shared tail
+	require.Equal(t, 5, countChunks(t, base))
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(second)), size)
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, second, buf.Bytes())
+
+	// Read across the chunk boundary.
+	part := make([]byte, 20)
+	n, err := obj.ReadAt(part, 2*DedupChunkSize-10)
+	require.NoError(t, err)
+	require.Equal(t, 20, n)
+	require.Equal(t, second[2*DedupChunkSize-10:2*DedupChunkSize+10], part)
+}
+
+func TestDedup_CollectChunksAfterDeleteWithPrefix(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "build-a/rootfs.ext4")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, patterned([]byte{1, 2}, 0))))
+
+	obj, err = p.OpenObject(ctx, "build-b/rootfs.ext4")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, patterned([]byte{2, 3}, 0))))
+
+	require.Equal(t, 3, countChunks(t, base))
+
+	require.NoError(t, p.DeleteObjectsWithPrefix(ctx, "build-a"))
+
+	deleted, err := p.CollectChunks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	require.Equal(t, 2, countChunks(t, base))
+
+	// The remaining build still reads the shared chunk.
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, patterned([]byte{2, 3}, 0), buf.Bytes())
+
+	require.NoError(t, p.DeleteObjectsWithPrefix(ctx, "build-b"))
+
+	_, err = p.CollectChunks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, countChunks(t, base))
+
+	// No sweep markers are left behind.
+	markers, err := base.ListObjectsWithPrefix(ctx, sweepsDir)
+	require.NoError(t, err)
+	require.Empty(t, markers)
+}
+
+func TestDedup_PassThroughForUnchunkedObjects(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	legacy, err := base.OpenObject(ctx, "old-build/memfile")
+	require.NoError(t, err)
+	_, err = legacy.ReadFrom(strings.NewReader("legacy data"))
+	require.NoError(t, err)
+
+	obj, err := p.OpenObject(ctx, "old-build/memfile")
+	require.NoError(t, err)
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len("legacy data")), size)
+
+	part := make([]byte, 4)
+	_, err = obj.ReadAt(part, 7)
+	require.NoError(t, err)
+	require.Equal(t, "data", string(part))
+
+	missing, err := p.OpenObject(ctx, "missing/memfile")
+	require.NoError(t, err)
+
+	_, err = missing.WriteTo(&bytes.Buffer{})
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+}
+
+func TestDedup_RewriteKeepsSharedChunks(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "build-a/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, patterned([]byte{1, 2}, 0))))
+
+	// Rewriting the object with appended data keeps the prefix chunks and releases only the replaced ones.
+	rewritten := patterned([]byte{1, 3, 4}, 0)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, rewritten)))
+
+	deleted, err := p.CollectChunks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	require.Equal(t, 3, countChunks(t, base))
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, rewritten, buf.Bytes())
+}
+
+func TestDedup_PendingIndexKeepsChunks(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "build-a/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, patterned([]byte{1, 2}, 0))))
+
+	index, err := p.ChunkIndex(ctx, "build-a/memfile")
+	require.NoError(t, err)
+
+	// An upload to another build in progress that reuses the chunks of the deleted build.
+	require.NoError(t, p.writeIndex(ctx, pendingIndexPath("build-b/memfile"), index))
+	require.NoError(t, obj.Delete())
+
+	deleted, err := p.CollectChunks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+	require.Equal(t, 2, countChunks(t, base))
+}
+
+func TestDedup_UploadWaitsForRunningSweep(t *testing.T) {
+	base := newTempProvider(t)
+	p := NewDedupStorageProvider(base)
+	ctx := context.Background()
+
+	data := patterned([]byte{1}, 0)
+	hash := header.ChunkHash(sha256.Sum256(data))
+	chunks := map[header.ChunkHash]struct{}{hash: {}}
+
+	marker, err := base.OpenObject(ctx, sweepsDir+"/running")
+	require.NoError(t, err)
+	_, err = marker.ReadFrom(serializeSweepMarker(time.Now(), []header.ChunkHash{hash}))
+	require.NoError(t, err)
+
+	deleting, err := p.sweepsDeleting(ctx, chunks)
+	require.NoError(t, err)
+	require.True(t, deleting)
+
+	// The markers of crashed sweeps expire.
+	_, err = marker.ReadFrom(serializeSweepMarker(time.Now().Add(-2*sweepMarkerTTL), []header.ChunkHash{hash}))
+	require.NoError(t, err)
+
+	deleting, err = p.sweepsDeleting(ctx, chunks)
+	require.NoError(t, err)
+	require.False(t, deleting)
+
+	obj, err := p.OpenObject(ctx, "build-a/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, data)))
+
+	// The pending index is removed once the upload finishes.
+	_, err = p.readIndex(ctx, pendingIndexPath("build-a/memfile"))
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+}
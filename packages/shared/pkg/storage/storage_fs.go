@@ -32,6 +32,38 @@ func (fs *FileSystemStorageProvider) DeleteObjectsWithPrefix(_ context.Context,
 	return os.RemoveAll(filePath)
 }
 
+func (fs *FileSystemStorageProvider) ListObjectsWithPrefix(_ context.Context, prefix string) ([]string, error) {
+	var objects []string
+
+	err := filepath.WalkDir(fs.getPath(prefix), func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(fs.basePath, path)
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, filepath.ToSlash(rel))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
 func (fs *FileSystemStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[Local file storage, base path set to %s]", fs.basePath)
 }
@@ -94,6 +126,33 @@ func (f *FileSystemStorageObjectProvider) ReadFrom(src io.Reader) (int64, error)
 	return io.Copy(handle, src)
 }
 
+func (f *FileSystemStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
+	// The content is written to a temporary file first and linked to the object path, which fails if the path exists,
+	// so the object is never visible partially written.
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	n, err := io.Copy(tmp, src)
+	if err != nil {
+		return n, err
+	}
+
+	err = os.Link(tmp.Name(), f.path)
+	if os.IsExist(err) {
+		return 0, ErrorObjectAlreadyExist
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
 func (f *FileSystemStorageObjectProvider) ReadAt(buff []byte, off int64) (n int, err error) {
 	handle, err := f.getHandle(true)
 	if err != nil {
@@ -120,7 +179,12 @@ func (f *FileSystemStorageObjectProvider) Size() (int64, error) {
 }
 
 func (f *FileSystemStorageObjectProvider) Delete() error {
-	return os.Remove(f.path)
+	err := os.Remove(f.path)
+	if os.IsNotExist(err) {
+		return ErrorObjectNotExist
+	}
+
+	return err
 }
 
 func (f *FileSystemStorageObjectProvider) getHandle(checkExistence bool) (*os.File, error) {
@@ -140,7 +204,13 @@ func (f *FileSystemStorageObjectProvider) getHandle(checkExistence bool) (*os.Fi
 
 	}
 
-	handle, err := os.OpenFile(f.path, os.O_RDWR|os.O_CREATE, 0o644)
+	flags := os.O_RDWR | os.O_CREATE
+	if !checkExistence {
+		// Writing to an object replaces its content.
+		flags |= os.O_TRUNC
+	}
+
+	handle, err := os.OpenFile(f.path, flags, 0o644)
 	if err != nil {
 		return nil, err
 	}
@@ -126,3 +126,27 @@ func TestWriteToNonExistentObject(t *testing.T) {
 	_, err = obj.WriteTo(&sink)
 	require.ErrorIs(t, err, ErrorObjectNotExist)
 }
+
+func TestReadFromIfNotExist(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "lock")
+	require.NoError(t, err)
+
+	_, err = obj.ReadFromIfNotExist(strings.NewReader("first"))
+	require.NoError(t, err)
+
+	_, err = obj.ReadFromIfNotExist(strings.NewReader("second"))
+	require.ErrorIs(t, err, ErrorObjectAlreadyExist)
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, "first", buf.String())
+
+	// No temporary files are left next to the object.
+	objects, err := p.ListObjectsWithPrefix(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"lock"}, objects)
+}
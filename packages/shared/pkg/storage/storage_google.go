@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os/exec"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 )
 
@@ -69,6 +71,27 @@ func (g *GCPBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return nil
 }
 
+func (g *GCPBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	objects := g.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+
+	var names []string
+
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over objects: %w", err)
+		}
+
+		names = append(names, object.Name)
+	}
+
+	return names, nil
+}
+
 func (g *GCPBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[GCP Storage, bucket set to %s]", g.bucket.BucketName())
 }
@@ -159,6 +182,29 @@ func (g *GCPBucketStorageObjectProvider) ReadFrom(src io.Reader) (int64, error)
 	return n, nil
 }
 
+func (g *GCPBucketStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
+	w := g.handle.If(storage.Conditions{DoesNotExist: true}).NewWriter(g.ctx)
+
+	n, err := io.Copy(w, src)
+	if err != nil && !errors.Is(err, io.EOF) {
+		w.CloseWithError(err)
+
+		return n, fmt.Errorf("failed to copy buffer to persistence: %w", err)
+	}
+
+	err = w.Close()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed {
+		return 0, ErrorObjectAlreadyExist
+	}
+
+	if err != nil {
+		return n, fmt.Errorf("failed to close GCS writer: %w", err)
+	}
+
+	return n, nil
+}
+
 func (g *GCPBucketStorageObjectProvider) WriteTo(dst io.Writer) (int64, error) {
 	ctx, cancel := context.WithTimeout(g.ctx, googleReadTimeout)
 	defer cancel()
@@ -5,20 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
+	"net/http"
 	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
-	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 	"github.com/oracle/oci-go-sdk/v65/common"
-	"github.com/oracle/oci-go-sdk/v65/common/auth"
 	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage/transfer"
 )
 
 const (
 	ociOperationTimeout = 5 * time.Second
 	ociWriteTimeout     = 120 * time.Second
 	ociReadTimeout      = 15 * time.Second
+
+	// ociUploadTimeout bounds the whole multipart upload of a file, the parts are retried separately.
+	ociUploadTimeout     = 30 * time.Minute
+	ociUploadPartSize    = 64 * 1024 * 1024 // 64 MB
+	ociUploadConcurrency = 8
+	// ociUploadResumeAttempts is how many times are the failed parts of a multipart upload uploaded again.
+	ociUploadResumeAttempts = 3
 )
 
 // OCIBucketStorageProvider implements Object Storage-backed template storage.
@@ -38,14 +44,34 @@ type OCIBucketStorageObjectProvider struct {
 	ctx        context.Context
 }
 
-// NewOCIBucketStorageProvider initializes the Object Storage client using instance principals.
+// NewOCIBucketStorageProvider initializes the Object Storage client, authenticating as configured by the OCI_AUTH_TYPE env.
 func NewOCIBucketStorageProvider(ctx context.Context, bucketName string) (*OCIBucketStorageProvider, error) {
-	region := utils.RequiredEnv("OCI_REGION", "OCI region for Object Storage (e.g., us-ashburn-1)")
+	return NewOCIBucketStorageProviderWithAuth(ctx, bucketName, "", OCIAuthConfigFromEnv())
+}
+
+// NewOCIBucketStorageProviderWithAuth initializes the Object Storage client for the bucket in the region, an empty region uses the OCI_REGION env.
+func NewOCIBucketStorageProviderWithAuth(ctx context.Context, bucketName, region string, authConfig OCIAuthConfig) (*OCIBucketStorageProvider, error) {
 	namespace := env.GetEnv("OCI_NAMESPACE", "")
 
-	provider, err := auth.InstancePrincipalConfigurationProvider()
+	provider, err := authConfig.ConfigurationProvider()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create OCI Instance Principal provider: %w", err)
+		return nil, err
+	}
+
+	// The requested region and the region from the env take precedence, the config file profiles and principals carry their own region.
+	if region == "" {
+		region = env.GetEnv("OCI_REGION", "")
+	}
+
+	if region == "" {
+		region, err = provider.Region()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get OCI region, set OCI_REGION (e.g., us-ashburn-1): %w", err)
+		}
+
+		if region == "" {
+			return nil, errors.New("OCI region for Object Storage is not set, set OCI_REGION (e.g., us-ashburn-1)")
+		}
 	}
 
 	client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
@@ -114,6 +140,40 @@ func (o *OCIBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return nil
 }
 
+func (o *OCIBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	var objects []string
+	start := ""
+
+	for {
+		listCtx, listCancel := context.WithTimeout(ctx, ociOperationTimeout)
+		req := objectstorage.ListObjectsRequest{
+			NamespaceName: &o.namespace,
+			BucketName:    &o.bucketName,
+			Prefix:        &prefix,
+		}
+		if start != "" {
+			req.Start = &start
+		}
+
+		resp, err := o.client.ListObjects(listCtx, req)
+		listCancel()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range resp.Objects {
+			objects = append(objects, *obj.Name)
+		}
+
+		if resp.NextStartWith == nil || *resp.NextStartWith == "" {
+			break
+		}
+		start = *resp.NextStartWith
+	}
+
+	return objects, nil
+}
+
 func (o *OCIBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[OCI Object Storage, bucket %s, namespace %s, region %s]", o.bucketName, o.namespace, o.region)
 }
@@ -149,26 +209,56 @@ func (o *OCIBucketStorageObjectProvider) WriteTo(dst io.Writer) (int64, error) {
 }
 
 func (o *OCIBucketStorageObjectProvider) WriteFromFileSystem(path string) error {
-	ctx, cancel := context.WithTimeout(o.ctx, ociWriteTimeout)
+	ctx, cancel := context.WithTimeout(o.ctx, ociUploadTimeout)
 	defer cancel()
 
-	file, err := os.Open(path)
+	// The manager keeps the state of the parts, so the failed ones can be resumed.
+	manager := transfer.NewUploadManager()
+
+	resp, err := manager.UploadFile(ctx, transfer.UploadFileRequest{
+		UploadRequest: transfer.UploadRequest{
+			NamespaceName:         &o.namespace,
+			BucketName:            &o.bucketName,
+			ObjectName:            &o.path,
+			PartSize:              common.Int64(ociUploadPartSize),
+			AllowMultipartUploads: common.Bool(true),
+			AllowParrallelUploads: common.Bool(true),
+			NumberOfGoroutines:    common.Int(ociUploadConcurrency),
+			ObjectStorageClient:   o.client,
+		},
+		FilePath: path,
+	})
+
+	for attempt := 0; err != nil && isOCIUploadResumable(resp) && attempt < ociUploadResumeAttempts; attempt++ {
+		if ctx.Err() != nil {
+			break
+		}
+
+		resp, err = manager.ResumeUploadFile(ctx, *resp.UploadID)
+	}
+
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to upload %s: %w", o.path, err)
 	}
-	defer file.Close()
 
-	_, err = o.client.PutObject(ctx, objectstorage.PutObjectRequest{
+	return nil
+}
+
+func (o *OCIBucketStorageObjectProvider) ReadFrom(src io.Reader) (int64, error) {
+	ctx, cancel := context.WithTimeout(o.ctx, ociWriteTimeout)
+	defer cancel()
+
+	_, err := o.client.PutObject(ctx, objectstorage.PutObjectRequest{
 		NamespaceName: &o.namespace,
 		BucketName:    &o.bucketName,
 		ObjectName:    &o.path,
-		PutObjectBody: file,
+		PutObjectBody: io.NopCloser(src),
 	})
 
-	return err
+	return 0, err
 }
 
-func (o *OCIBucketStorageObjectProvider) ReadFrom(src io.Reader) (int64, error) {
+func (o *OCIBucketStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
 	ctx, cancel := context.WithTimeout(o.ctx, ociWriteTimeout)
 	defer cancel()
 
@@ -177,8 +267,14 @@ func (o *OCIBucketStorageObjectProvider) ReadFrom(src io.Reader) (int64, error)
 		BucketName:    &o.bucketName,
 		ObjectName:    &o.path,
 		PutObjectBody: io.NopCloser(src),
+		IfNoneMatch:   common.String("*"),
 	})
 
+	var serviceErr common.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.GetHTTPStatusCode() == http.StatusPreconditionFailed {
+		return 0, ErrorObjectAlreadyExist
+	}
+
 	return 0, err
 }
 
@@ -246,6 +342,13 @@ func (o *OCIBucketStorageObjectProvider) Delete() error {
 	return err
 }
 
+func isOCIUploadResumable(resp transfer.UploadResponse) bool {
+	return resp.Type == transfer.MultipartUpload &&
+		resp.MultipartUploadResponse != nil &&
+		resp.UploadID != nil &&
+		resp.IsResumable()
+}
+
 func isOCIObjectNotFound(err error) bool {
 	var serviceErr common.ServiceError
 	if errors.As(err, &serviceErr) {
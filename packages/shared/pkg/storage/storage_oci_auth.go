@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+type OCIAuthType string
+
+const (
+	OCIAuthInstancePrincipal OCIAuthType = "instance_principal"
+	OCIAuthResourcePrincipal OCIAuthType = "resource_principal"
+	// OCIAuthConfigFile uses the API key from a profile of the OCI config file.
+	OCIAuthConfigFile OCIAuthType = "config_file"
+	// OCIAuthSessionToken uses the session token created by `oci session authenticate` for a profile of the OCI config file.
+	OCIAuthSessionToken OCIAuthType = "session_token"
+
+	defaultOCIConfigPath    = "~/.oci/config"
+	defaultOCIConfigProfile = "DEFAULT"
+)
+
+// OCIAuthConfig selects how the OCI clients authenticate.
+type OCIAuthConfig struct {
+	Type OCIAuthType
+	// ConfigPath, Profile and PrivateKeyPassphrase are used only by the config file and session token auth.
+	ConfigPath           string
+	Profile              string
+	PrivateKeyPassphrase string
+}
+
+func OCIAuthConfigFromEnv() OCIAuthConfig {
+	return OCIAuthConfig{
+		Type:                 OCIAuthType(env.GetEnv("OCI_AUTH_TYPE", string(OCIAuthInstancePrincipal))),
+		ConfigPath:           env.GetEnv("OCI_CONFIG_FILE", defaultOCIConfigPath),
+		Profile:              env.GetEnv("OCI_CONFIG_PROFILE", defaultOCIConfigProfile),
+		PrivateKeyPassphrase: env.GetEnv("OCI_PRIVATE_KEY_PASSPHRASE", ""),
+	}
+}
+
+// ConfigurationProvider returns the OCI configuration provider for the auth type.
+func (c OCIAuthConfig) ConfigurationProvider() (common.ConfigurationProvider, error) {
+	switch c.Type {
+	case OCIAuthInstancePrincipal:
+		provider, err := auth.InstancePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OCI Instance Principal provider: %w", err)
+		}
+
+		return provider, nil
+	case OCIAuthResourcePrincipal:
+		provider, err := auth.ResourcePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OCI Resource Principal provider: %w", err)
+		}
+
+		return provider, nil
+	case OCIAuthConfigFile, OCIAuthSessionToken:
+		configPath, err := expandHome(c.ConfigPath)
+		if err != nil {
+			return nil, err
+		}
+
+		var provider common.ConfigurationProvider
+		if c.Type == OCIAuthSessionToken {
+			provider, err = common.ConfigurationProviderForSessionTokenWithProfile(configPath, c.Profile, c.PrivateKeyPassphrase)
+		} else {
+			provider, err = common.ConfigurationProviderFromFileWithProfile(configPath, c.Profile, c.PrivateKeyPassphrase)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OCI provider from profile %s in %s: %w", c.Profile, configPath, err)
+		}
+
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unknown OCI auth type: %s", c.Type)
+	}
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
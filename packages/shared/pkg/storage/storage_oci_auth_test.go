@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeOCIConfig(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(dir, "key.pem")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, os.WriteFile(keyPath, keyPEM, 0o600))
+
+	config := fmt.Sprintf(`[DEFAULT]
+user=ocid1.user.oc1..default
+fingerprint=aa:bb
+key_file=%[1]s
+tenancy=ocid1.tenancy.oc1..default
+region=us-ashburn-1
+
+[CI]
+user=ocid1.user.oc1..ci
+fingerprint=cc:dd
+key_file=%[1]s
+tenancy=ocid1.tenancy.oc1..ci
+region=ap-sydney-1
+`, keyPath)
+
+	configPath := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(configPath, []byte(config), 0o600))
+
+	return configPath
+}
+
+func TestOCIAuth_ConfigFileProfile(t *testing.T) {
+	configPath := writeOCIConfig(t)
+
+	provider, err := OCIAuthConfig{
+		Type:       OCIAuthConfigFile,
+		ConfigPath: configPath,
+		Profile:    "CI",
+	}.ConfigurationProvider()
+	require.NoError(t, err)
+
+	region, err := provider.Region()
+	require.NoError(t, err)
+	assert.Equal(t, "ap-sydney-1", region)
+
+	user, err := provider.UserOCID()
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.user.oc1..ci", user)
+
+	_, err = provider.PrivateRSAKey()
+	require.NoError(t, err)
+}
+
+func TestOCIAuth_UnknownType(t *testing.T) {
+	_, err := OCIAuthConfig{Type: "api_key"}.ConfigurationProvider()
+	assert.Error(t, err)
+}
+
+func TestExpandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	path, err := expandHome("~/.oci/config")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".oci", "config"), path)
+
+	path, err = expandHome("/etc/oci/config")
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/oci/config", path)
+}
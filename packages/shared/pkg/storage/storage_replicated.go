@@ -0,0 +1,371 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// replicationTimeout bounds copying one object from the primary to a replica.
+	replicationTimeout = 30 * time.Minute
+	// DefaultHedgeDelay is how long a read waits for the primary before asking the next replica as well.
+	DefaultHedgeDelay = 200 * time.Millisecond
+
+	// CompactionQueuePrefix is the prefix of the markers of the builds waiting for compaction.
+	CompactionQueuePrefix = "compaction/"
+)
+
+// ReplicatedStorageProvider writes to the primary provider and asynchronously copies the written objects to the replicas.
+//
+// Reads are served by the primary and fall back to the replicas in order when the primary fails,
+// so an object written in another region can be read before it is replicated to the local primary.
+// ReadAt is hedged: when the primary does not answer within the hedge delay, the next replica is asked too
+// and the first successful response wins.
+type ReplicatedStorageProvider struct {
+	primary    StorageProvider
+	replicas   []StorageProvider
+	hedgeDelay time.Duration
+	// stagingPath is the directory where the replicated objects are staged before the upload.
+	stagingPath string
+
+	// pathLocks serializes the replication of the same path, so a replica ends with the latest content of the primary.
+	pathLocks sync.Map
+	wg        sync.WaitGroup
+}
+
+type ReplicatedStorageObjectProvider struct {
+	storage *ReplicatedStorageProvider
+	path    string
+	ctx     context.Context
+}
+
+func NewReplicatedStorageProvider(primary StorageProvider, hedgeDelay time.Duration, stagingPath string, replicas ...StorageProvider) *ReplicatedStorageProvider {
+	return &ReplicatedStorageProvider{
+		primary:     primary,
+		replicas:    replicas,
+		hedgeDelay:  hedgeDelay,
+		stagingPath: stagingPath,
+	}
+}
+
+// providers returns the primary followed by the replicas, in the order they are used for reads.
+func (r *ReplicatedStorageProvider) providers() []StorageProvider {
+	return append([]StorageProvider{r.primary}, r.replicas...)
+}
+
+// DeleteObjectsWithPrefix deletes the objects from the primary and all the replicas.
+// Only the failure of the primary is returned, the replicas are cleaned up on a best-effort basis.
+func (r *ReplicatedStorageProvider) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	err := r.primary.DeleteObjectsWithPrefix(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, replica := range r.replicas {
+		replicaErr := replica.DeleteObjectsWithPrefix(ctx, prefix)
+		if replicaErr != nil {
+			zap.L().Warn("failed to delete objects from replica", zap.String("prefix", prefix), zap.String("replica", replica.GetDetails()), zap.Error(replicaErr))
+		}
+	}
+
+	return nil
+}
+
+func (r *ReplicatedStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	var errs []error
+
+	for _, provider := range r.providers() {
+		objects, err := provider.ListObjectsWithPrefix(ctx, prefix)
+		if err == nil {
+			return objects, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (r *ReplicatedStorageProvider) GetDetails() string {
+	replicas := make([]string, 0, len(r.replicas))
+	for _, replica := range r.replicas {
+		replicas = append(replicas, replica.GetDetails())
+	}
+
+	return fmt.Sprintf("[Replicated storage, primary %s, replicas %s]", r.primary.GetDetails(), strings.Join(replicas, ", "))
+}
+
+func (r *ReplicatedStorageProvider) OpenObject(ctx context.Context, path string) (StorageObjectProvider, error) {
+	return &ReplicatedStorageObjectProvider{
+		storage: r,
+		path:    path,
+		ctx:     ctx,
+	}, nil
+}
+
+// Wait blocks until all the started replications finish.
+func (r *ReplicatedStorageProvider) Wait() {
+	r.wg.Wait()
+}
+
+// isCoordinationObject reports if the object coordinates the instances using the same primary,
+// e.g. the sweep markers, the pending chunk indexes and the compaction queue markers.
+// They are not replicated, Delete removes objects only from the primary and the copies would be left behind in the replicas.
+func isCoordinationObject(path string) bool {
+	return strings.HasPrefix(path, sweepsDir+"/") ||
+		strings.HasSuffix(path, pendingIndexSuffix) ||
+		strings.HasPrefix(path, CompactionQueuePrefix)
+}
+
+// replicate copies the object from the primary to all the replicas in the background, the coordination objects are skipped.
+func (r *ReplicatedStorageProvider) replicate(path string) {
+	if isCoordinationObject(path) {
+		return
+	}
+
+	for _, replica := range r.replicas {
+		r.wg.Add(1)
+
+		go func() {
+			defer r.wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+			defer cancel()
+
+			err := r.replicateTo(ctx, replica, path)
+			if err != nil {
+				zap.L().Error("failed to replicate object", zap.String("path", path), zap.String("replica", replica.GetDetails()), zap.Error(err))
+			}
+		}()
+	}
+}
+
+func (r *ReplicatedStorageProvider) replicateTo(ctx context.Context, replica StorageProvider, path string) error {
+	lock, _ := r.pathLocks.LoadOrStore(path, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	src, err := r.primary.OpenObject(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to open primary object: %w", err)
+	}
+
+	// The object is staged in a temporary file, so the replica can use its multipart upload.
+	tmp, err := os.CreateTemp(r.stagingPath, "replication-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	_, err = src.WriteTo(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to read primary object: %w", err)
+	}
+
+	dst, err := replica.OpenObject(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to open replica object: %w", err)
+	}
+
+	err = dst.WriteFromFileSystem(tmp.Name())
+	if err != nil {
+		return fmt.Errorf("failed to write replica object: %w", err)
+	}
+
+	return nil
+}
+
+func (o *ReplicatedStorageObjectProvider) open(provider StorageProvider) (StorageObjectProvider, error) {
+	return provider.OpenObject(o.ctx, o.path)
+}
+
+func (o *ReplicatedStorageObjectProvider) WriteTo(dst io.Writer) (int64, error) {
+	var errs []error
+
+	for _, provider := range o.storage.providers() {
+		obj, err := o.open(provider)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		n, err := obj.WriteTo(dst)
+		if err == nil {
+			return n, nil
+		}
+
+		// The destination already received a part of the object, it cannot be restarted from a replica.
+		if n > 0 {
+			return n, err
+		}
+
+		errs = append(errs, err)
+	}
+
+	return 0, joinReadErrors(errs)
+}
+
+func (o *ReplicatedStorageObjectProvider) WriteFromFileSystem(path string) error {
+	obj, err := o.open(o.storage.primary)
+	if err != nil {
+		return err
+	}
+
+	err = obj.WriteFromFileSystem(path)
+	if err != nil {
+		return err
+	}
+
+	o.storage.replicate(o.path)
+
+	return nil
+}
+
+func (o *ReplicatedStorageObjectProvider) ReadFrom(src io.Reader) (int64, error) {
+	obj, err := o.open(o.storage.primary)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := obj.ReadFrom(src)
+	if err != nil {
+		return n, err
+	}
+
+	o.storage.replicate(o.path)
+
+	return n, nil
+}
+
+// ReadFromIfNotExist writes only to the primary, the condition can't be checked atomically across the replicas.
+// The object is not replicated, it is meant for coordination between the instances using the same primary.
+func (o *ReplicatedStorageObjectProvider) ReadFromIfNotExist(src io.Reader) (int64, error) {
+	obj, err := o.open(o.storage.primary)
+	if err != nil {
+		return 0, err
+	}
+
+	return obj.ReadFromIfNotExist(src)
+}
+
+type readAtResult struct {
+	buff []byte
+	n    int
+	err  error
+}
+
+// ReadAt reads from the primary and hedges the read to the next replica whenever the previous one does not answer within the hedge delay.
+// Each attempt reads into its own buffer, so a late response cannot overwrite the returned data.
+func (o *ReplicatedStorageObjectProvider) ReadAt(buff []byte, off int64) (int, error) {
+	providers := o.storage.providers()
+	results := make(chan readAtResult, len(providers))
+
+	start := func(provider StorageProvider) {
+		go func() {
+			obj, err := o.open(provider)
+			if err != nil {
+				results <- readAtResult{err: err}
+
+				return
+			}
+
+			attempt := make([]byte, len(buff))
+			n, err := obj.ReadAt(attempt, off)
+			results <- readAtResult{buff: attempt, n: n, err: err}
+		}()
+	}
+
+	hedge := time.NewTimer(o.storage.hedgeDelay)
+	defer hedge.Stop()
+
+	start(providers[0])
+	started, pending := 1, 1
+
+	var errs []error
+
+	for pending > 0 {
+		select {
+		case result := <-results:
+			pending--
+
+			// Reading at the end of the object returns io.EOF together with the data.
+			if result.err == nil || (errors.Is(result.err, io.EOF) && result.n > 0) {
+				return copy(buff, result.buff[:result.n]), result.err
+			}
+
+			errs = append(errs, result.err)
+		case <-hedge.C:
+		}
+
+		if started < len(providers) {
+			start(providers[started])
+			started++
+			pending++
+
+			hedge.Reset(o.storage.hedgeDelay)
+		}
+	}
+
+	return 0, joinReadErrors(errs)
+}
+
+func (o *ReplicatedStorageObjectProvider) Size() (int64, error) {
+	var errs []error
+
+	for _, provider := range o.storage.providers() {
+		obj, err := o.open(provider)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		size, err := obj.Size()
+		if err == nil {
+			return size, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return 0, joinReadErrors(errs)
+}
+
+// Delete removes the object only from the primary.
+// The replicas are the primaries of other regions, the objects there are managed by the instances of those regions,
+// e.g. a deduplicated chunk unused in this region can still be referenced by a build in the replica.
+func (o *ReplicatedStorageObjectProvider) Delete() error {
+	obj, err := o.open(o.storage.primary)
+	if err != nil {
+		return err
+	}
+
+	return obj.Delete()
+}
+
+// joinReadErrors returns ErrorObjectNotExist only when the object is missing everywhere,
+// otherwise a failed provider could make the callers treat an existing object as missing.
+func joinReadErrors(errs []error) error {
+	var failures []error
+	for _, err := range errs {
+		if !errors.Is(err, ErrorObjectNotExist) {
+			failures = append(failures, err)
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.Join(failures...)
+	}
+
+	return ErrorObjectNotExist
+}
@@ -0,0 +1,212 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowStorageProvider delays the reads of the wrapped provider.
+type slowStorageProvider struct {
+	StorageProvider
+
+	delay time.Duration
+}
+
+type slowStorageObjectProvider struct {
+	StorageObjectProvider
+
+	delay time.Duration
+}
+
+func (s *slowStorageProvider) OpenObject(ctx context.Context, path string) (StorageObjectProvider, error) {
+	obj, err := s.StorageProvider.OpenObject(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &slowStorageObjectProvider{StorageObjectProvider: obj, delay: s.delay}, nil
+}
+
+func (s *slowStorageObjectProvider) ReadAt(buff []byte, off int64) (int, error) {
+	time.Sleep(s.delay)
+
+	return s.StorageObjectProvider.ReadAt(buff, off)
+}
+
+func readObject(t *testing.T, p StorageProvider, path string) string {
+	t.Helper()
+
+	obj, err := p.OpenObject(context.Background(), path)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+
+	return buf.String()
+}
+
+func TestReplicated_WritesAreReplicated(t *testing.T) {
+	primary := newTempProvider(t)
+	replica := newTempProvider(t)
+	staging := t.TempDir()
+	p := NewReplicatedStorageProvider(primary, DefaultHedgeDelay, staging, replica)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "build/memfile")
+	require.NoError(t, err)
+	require.NoError(t, obj.WriteFromFileSystem(writeTempFile(t, []byte("memfile data"))))
+
+	obj, err = p.OpenObject(ctx, "build/memfile.header")
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(strings.NewReader("header"))
+	require.NoError(t, err)
+
+	p.Wait()
+
+	assert.Equal(t, "memfile data", readObject(t, replica, "build/memfile"))
+	assert.Equal(t, "header", readObject(t, replica, "build/memfile.header"))
+
+	// The staged copies are removed after the replication.
+	staged, err := os.ReadDir(staging)
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+
+	require.NoError(t, p.DeleteObjectsWithPrefix(ctx, "build"))
+
+	objects, err := replica.ListObjectsWithPrefix(ctx, "build")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestReplicated_DeleteOnlyFromPrimary(t *testing.T) {
+	primary := newTempProvider(t)
+	replica := newTempProvider(t)
+	p := NewReplicatedStorageProvider(primary, DefaultHedgeDelay, t.TempDir(), replica)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "chunks/chunk")
+	require.NoError(t, err)
+	_, err = obj.ReadFrom(strings.NewReader("chunk"))
+	require.NoError(t, err)
+
+	require.NoError(t, WaitForPendingWrites(ctx, NewDedupStorageProvider(p)))
+	require.NoError(t, obj.Delete())
+
+	primaryObj, err := primary.OpenObject(ctx, "chunks/chunk")
+	require.NoError(t, err)
+	_, err = primaryObj.Size()
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+
+	// The chunk can still be used by the region where the replica is the primary.
+	assert.Equal(t, "chunk", readObject(t, replica, "chunks/chunk"))
+}
+
+func TestReplicated_CoordinationObjectsAreNotReplicated(t *testing.T) {
+	primary := newTempProvider(t)
+	replica := newTempProvider(t)
+	p := NewReplicatedStorageProvider(primary, DefaultHedgeDelay, t.TempDir(), replica)
+	ctx := context.Background()
+
+	for _, path := range []string{sweepsDir + "/sweep", "build/memfile" + ChunkIndexSuffix + pendingIndexSuffix, CompactionQueuePrefix + "build"} {
+		obj, err := p.OpenObject(ctx, path)
+		require.NoError(t, err)
+		_, err = obj.ReadFrom(strings.NewReader("marker"))
+		require.NoError(t, err)
+	}
+
+	p.Wait()
+
+	objects, err := primary.ListObjectsWithPrefix(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, objects, 3)
+
+	objects, err = replica.ListObjectsWithPrefix(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestReplicated_ReadsFallBackToReplica(t *testing.T) {
+	primary := newTempProvider(t)
+	replica := newTempProvider(t)
+	p := NewReplicatedStorageProvider(primary, time.Hour, t.TempDir(), replica)
+	ctx := context.Background()
+
+	// The object was built in the other region and is not replicated to the primary yet.
+	remote, err := replica.OpenObject(ctx, "build/rootfs.ext4")
+	require.NoError(t, err)
+	_, err = remote.ReadFrom(strings.NewReader("0123456789"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "0123456789", readObject(t, p, "build/rootfs.ext4"))
+
+	obj, err := p.OpenObject(ctx, "build/rootfs.ext4")
+	require.NoError(t, err)
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), size)
+
+	part := make([]byte, 4)
+	n, err := obj.ReadAt(part, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "2345", string(part[:n]))
+
+	missing, err := p.OpenObject(ctx, "build/missing")
+	require.NoError(t, err)
+
+	_, err = missing.ReadAt(part, 0)
+	assert.ErrorIs(t, err, ErrorObjectNotExist)
+
+	_, err = missing.WriteTo(&bytes.Buffer{})
+	assert.ErrorIs(t, err, ErrorObjectNotExist)
+}
+
+func TestReplicated_HedgedReadAt(t *testing.T) {
+	primary := newTempProvider(t)
+	replica := newTempProvider(t)
+	ctx := context.Background()
+
+	for _, provider := range []StorageProvider{primary, replica} {
+		obj, err := provider.OpenObject(ctx, "build/memfile")
+		require.NoError(t, err)
+		_, err = obj.ReadFrom(strings.NewReader("0123456789"))
+		require.NoError(t, err)
+	}
+
+	p := NewReplicatedStorageProvider(&slowStorageProvider{StorageProvider: primary, delay: 5 * time.Second}, 10*time.Millisecond, t.TempDir(), replica)
+
+	obj, err := p.OpenObject(ctx, "build/memfile")
+	require.NoError(t, err)
+
+	start := time.Now()
+
+	part := make([]byte, 20)
+	n, err := obj.ReadAt(part, 4)
+	require.True(t, err == nil || errors.Is(err, io.EOF), "unexpected error: %v", err)
+	assert.Equal(t, "456789", string(part[:n]))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestGetReplicatedStorageProvider(t *testing.T) {
+	primary := newTempProvider(t)
+
+	p, err := getReplicatedStorageProvider(context.Background(), primary, "Local:"+t.TempDir()+", Local:"+t.TempDir())
+	require.NoError(t, err)
+	assert.Len(t, p.(*ReplicatedStorageProvider).replicas, 2)
+
+	_, err = getReplicatedStorageProvider(context.Background(), primary, "fc-template")
+	assert.Error(t, err)
+
+	_, err = getReplicatedStorageProvider(context.Background(), primary, "Local:/tmp/replica@us-ashburn-1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// S3Config configures a bucket on a generic S3-compatible endpoint (MinIO, Ceph, OCI S3 compatibility API, ...).
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses the bucket as <endpoint>/<bucket>/<key> instead of <bucket>.<endpoint>/<key>.
+	UsePathStyle bool
+}
+
+// S3BucketStorageProvider stores the templates on an S3-compatible endpoint.
+// It uses the same object operations as the AWS provider, only the client is configured differently.
+type S3BucketStorageProvider struct {
+	*AWSBucketStorageProvider
+
+	endpoint string
+}
+
+func S3ConfigFromEnv() S3Config {
+	return S3Config{
+		Endpoint:        utils.RequiredEnv("S3_ENDPOINT", "Endpoint of the S3-compatible storage (e.g., http://minio:9000)"),
+		Region:          env.GetEnv("S3_REGION", "us-east-1"),
+		AccessKeyID:     utils.RequiredEnv("S3_ACCESS_KEY_ID", "Access key for the S3-compatible storage"),
+		SecretAccessKey: utils.RequiredEnv("S3_SECRET_ACCESS_KEY", "Secret key for the S3-compatible storage"),
+		UsePathStyle:    env.GetEnv("S3_USE_PATH_STYLE", "true") == "true",
+	}
+}
+
+func NewS3BucketStorageProvider(ctx context.Context, bucketName string, s3Config S3Config) (*S3BucketStorageProvider, error) {
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		config.WithRegion(s3Config.Region),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(s3Config.AccessKeyID, s3Config.SecretAccessKey, "")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load S3 config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.BaseEndpoint = aws.String(s3Config.Endpoint)
+		o.UsePathStyle = s3Config.UsePathStyle
+		// Most S3-compatible endpoints do not support the newer flexible checksums, only send them when required.
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	})
+
+	return &S3BucketStorageProvider{
+		AWSBucketStorageProvider: &AWSBucketStorageProvider{
+			client:     client,
+			bucketName: bucketName,
+		},
+		endpoint: s3Config.Endpoint,
+	}, nil
+}
+
+func (s *S3BucketStorageProvider) GetDetails() string {
+	return fmt.Sprintf("[S3 Storage, endpoint set to %s, bucket set to %s]", s.endpoint, s.bucketName)
+}
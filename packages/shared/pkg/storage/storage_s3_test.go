@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 serves the objects from memory and records the requested paths.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	paths   []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.paths = append(f.paths, r.URL.Path)
+
+	data, ok := f.objects[r.URL.Path]
+
+	switch {
+	case r.Method == http.MethodPut:
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r.Body)
+		f.objects[r.URL.Path] = buf.Bytes()
+	case !ok && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusNotFound)
+	case !ok:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code></Error>`)
+	case r.Header.Get("Range") != "":
+		var start, end int
+		_, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		end = min(end, len(data)-1)
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(data[start : end+1])
+	default:
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	}
+}
+
+func newFakeS3Provider(t *testing.T) (*S3BucketStorageProvider, *fakeS3) {
+	t.Helper()
+
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	p, err := NewS3BucketStorageProvider(context.Background(), "templates", S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, err)
+
+	return p, fake
+}
+
+func TestS3_PathStyleObjectOperations(t *testing.T) {
+	p, fake := newFakeS3Provider(t)
+	ctx := context.Background()
+
+	obj, err := p.OpenObject(ctx, "build/memfile")
+	require.NoError(t, err)
+
+	_, err = obj.ReadFrom(strings.NewReader("0123456789"))
+	require.NoError(t, err)
+	require.Contains(t, fake.objects, "/templates/build/memfile")
+
+	size, err := obj.Size()
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), size)
+
+	part := make([]byte, 4)
+	n, err := obj.ReadAt(part, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "3456", string(part))
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", buf.String())
+
+	for _, path := range fake.paths {
+		assert.True(t, strings.HasPrefix(path, "/templates/"), "request %s is not path-style", path)
+	}
+}
+
+func TestS3_MissingObject(t *testing.T) {
+	p, _ := newFakeS3Provider(t)
+
+	obj, err := p.OpenObject(context.Background(), "missing/memfile")
+	require.NoError(t, err)
+
+	_, err = obj.WriteTo(&bytes.Buffer{})
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+
+	_, err = obj.Size()
+	require.ErrorIs(t, err, ErrorObjectNotExist)
+}
@@ -24,7 +24,11 @@ const (
 	RootfsName   = "rootfs.ext4"
 	SnapfileName = "snapfile"
 
+	SnapshotMetadataName = "snapshot.json"
+	NetworkStatsName     = "network.json"
+
 	HeaderSuffix = ".header"
+	TraceSuffix  = ".trace"
 )
 
 type TemplateFiles struct {
@@ -85,6 +89,11 @@ func (t *TemplateFiles) StorageMemfileHeaderPath() string {
 	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, HeaderSuffix)
 }
 
+// StorageMemfileTracePath is the path of the memfile page faults recorded after resuming the build.
+func (t *TemplateFiles) StorageMemfileTracePath() string {
+	return fmt.Sprintf("%s/%s%s", t.StorageDir(), MemfileName, TraceSuffix)
+}
+
 func (t *TemplateFiles) StorageRootfsPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), RootfsName)
 }
@@ -97,6 +106,16 @@ func (t *TemplateFiles) StorageSnapfilePath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), SnapfileName)
 }
 
+// StorageSnapshotMetadataPath is the path of the metadata describing the format of the snapfile.
+func (t *TemplateFiles) StorageSnapshotMetadataPath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), SnapshotMetadataName)
+}
+
+// StorageNetworkStatsPath is the path of the network counters the sandbox was paused with.
+func (t *TemplateFiles) StorageNetworkStatsPath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), NetworkStatsName)
+}
+
 func (t *TemplateFiles) SandboxBuildDir() string {
 	return filepath.Join(EnvsDisk, t.TemplateId, buildDirName, t.BuildId)
 }
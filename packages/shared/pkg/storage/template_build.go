@@ -2,21 +2,45 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 )
 
+const storageCompressionEnv = "TEMPLATE_STORAGE_COMPRESSION"
+
+// storageCompression is the codec used for uploaded data objects.
+// When it is not set, data objects are uploaded raw with VersionRaw headers, so older orchestrators can still read them.
+// An invalid value is reported by GetTemplateStorageProvider, so the service fails at startup.
+var storageCompression, storageCompressionErr = parseStorageCompression(env.GetEnv(storageCompressionEnv, ""))
+
+func parseStorageCompression(value string) (*headers.Compression, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	codec, err := headers.ParseCompression(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value %q: %w", storageCompressionEnv, value, err)
+	}
+
+	return &codec, nil
+}
+
 type TemplateBuild struct {
 	files       *TemplateFiles
 	persistence StorageProvider
 
 	memfileHeader *headers.Header
 	rootfsHeader  *headers.Header
+
+	compression *headers.Compression
 }
 
 func NewTemplateBuild(memfileHeader *headers.Header, rootfsHeader *headers.Header, persistence StorageProvider, files *TemplateFiles) *TemplateBuild {
@@ -26,6 +50,8 @@ func NewTemplateBuild(memfileHeader *headers.Header, rootfsHeader *headers.Heade
 
 		memfileHeader: memfileHeader,
 		rootfsHeader:  rootfsHeader,
+
+		compression: storageCompression,
 	}
 }
 
@@ -44,7 +70,7 @@ func (t *TemplateBuild) uploadMemfileHeader(ctx context.Context, h *headers.Head
 		return err
 	}
 
-	serialized, err := headers.Serialize(h.Metadata, h.Mapping)
+	serialized, err := headers.Serialize(h)
 	if err != nil {
 		return fmt.Errorf("error when serializing memfile header: %w", err)
 	}
@@ -77,7 +103,7 @@ func (t *TemplateBuild) uploadRootfsHeader(ctx context.Context, h *headers.Heade
 		return err
 	}
 
-	serialized, err := headers.Serialize(h.Metadata, h.Mapping)
+	serialized, err := headers.Serialize(h)
 	if err != nil {
 		return fmt.Errorf("error when serializing memfile header: %w", err)
 	}
@@ -119,59 +145,104 @@ func (t *TemplateBuild) uploadSnapfile(ctx context.Context, snapfile io.Reader)
 	return nil
 }
 
-func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, memfilePath *string, rootfsPath *string) chan error {
-	eg, ctx := errgroup.WithContext(ctx)
+// uploadData uploads the data object and its header.
+// With compression enabled, the data is uploaded as compressed frames and the header is upgraded to VersionFramed,
+// so the header can only be uploaded once the frames are known.
+func (t *TemplateBuild) uploadData(
+	ctx context.Context,
+	h *headers.Header,
+	dataPath *string,
+	uploadHeader func(context.Context, *headers.Header) error,
+	uploadData func(context.Context, string) error,
+) error {
+	if t.compression == nil || h == nil {
+		eg, ctx := errgroup.WithContext(ctx)
+
+		eg.Go(func() error {
+			if h == nil {
+				return nil
+			}
+
+			return uploadHeader(ctx, h)
+		})
+
+		eg.Go(func() error {
+			if dataPath == nil {
+				return nil
+			}
+
+			return uploadData(ctx, *dataPath)
+		})
+
+		return eg.Wait()
+	}
 
-	eg.Go(func() error {
-		if t.rootfsHeader == nil {
-			return nil
+	storage := &headers.StorageMetadata{
+		Compression: *t.compression,
+	}
+
+	if dataPath != nil {
+		compressedPath := *dataPath + ".frames"
+
+		framed, err := compressFile(*t.compression, *dataPath, compressedPath)
+		defer os.Remove(compressedPath)
+		if err != nil {
+			return fmt.Errorf("error when compressing %s: %w", *dataPath, err)
 		}
 
-		err := t.uploadRootfsHeader(ctx, t.rootfsHeader)
+		err = uploadData(ctx, compressedPath)
 		if err != nil {
 			return err
 		}
 
-		return nil
-	})
+		storage = framed
+	}
 
-	eg.Go(func() error {
-		if rootfsPath == nil {
-			return nil
-		}
+	metadata := *h.Metadata
+	metadata.Version = headers.VersionFramed
 
-		err := t.uploadRootfs(ctx, *rootfsPath)
-		if err != nil {
-			return err
-		}
+	framedHeader := headers.NewHeader(&metadata, h.Mapping)
+	framedHeader.Storage = storage
 
-		return nil
-	})
+	return uploadHeader(ctx, framedHeader)
+}
 
-	eg.Go(func() error {
-		if t.memfileHeader == nil {
-			return nil
-		}
+func compressFile(codec headers.Compression, path string, compressedPath string) (s *headers.StorageMetadata, e error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
 
-		err := t.uploadMemfileHeader(ctx, t.memfileHeader)
-		if err != nil {
-			return err
-		}
+	dst, err := os.Create(compressedPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		e = errors.Join(e, dst.Close())
+	}()
 
-		return nil
-	})
+	return headers.CompressFrames(codec, src, dst)
+}
+
+// Upload uploads the build files, the snapshot metadata is skipped when it is nil.
+func (t *TemplateBuild) Upload(ctx context.Context, snapfilePath string, metadata *SnapshotMetadata, memfilePath *string, rootfsPath *string) chan error {
+	eg, ctx := errgroup.WithContext(ctx)
 
 	eg.Go(func() error {
-		if memfilePath == nil {
+		if metadata == nil {
 			return nil
 		}
 
-		err := t.uploadMemfile(ctx, *memfilePath)
-		if err != nil {
-			return err
-		}
+		return t.uploadSnapshotMetadata(ctx, metadata)
+	})
 
-		return nil
+	eg.Go(func() error {
+		return t.uploadData(ctx, t.rootfsHeader, rootfsPath, t.uploadRootfsHeader, t.uploadRootfs)
+	})
+
+	eg.Go(func() error {
+		return t.uploadData(ctx, t.memfileHeader, memfilePath, t.uploadMemfileHeader, t.uploadMemfile)
 	})
 
 	eg.Go(func() error {
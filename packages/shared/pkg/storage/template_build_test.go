@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	headers "github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+func TestParseStorageCompression(t *testing.T) {
+	compression, err := parseStorageCompression("")
+	require.NoError(t, err)
+	require.Nil(t, compression)
+
+	compression, err = parseStorageCompression("zstd")
+	require.NoError(t, err)
+	require.Equal(t, headers.CompressionZstd, *compression)
+
+	_, err = parseStorageCompression("gzip")
+	require.ErrorContains(t, err, `invalid TEMPLATE_STORAGE_COMPRESSION value "gzip"`)
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const volumesDir = "volumes"
+
+// VolumePrefix is the prefix of all objects of the volume in the volume storage.
+func VolumePrefix(volumeID string) string {
+	return volumesDir + "/" + volumeID + "/"
+}
+
+// VolumeChunkPath is the path of the volume's chunk object, chunks that were never written don't have an object.
+func VolumeChunkPath(volumeID string, chunkIdx int64) string {
+	return filepath.Join(volumesDir, volumeID, fmt.Sprintf("%d", chunkIdx))
+}
+
+// GetVolumeStorageProvider returns the provider for the persistent sandbox volumes.
+// The volumes are stored in the template bucket if a separate bucket is not configured.
+func GetVolumeStorageProvider(ctx context.Context) (StorageProvider, error) {
+	provider := Provider(env.GetEnv(storageProviderEnv, string(DefaultStorageProvider)))
+
+	if provider == LocalStorageProvider {
+		basePath := env.GetEnv("LOCAL_VOLUME_STORAGE_BASE_PATH", "/tmp/volumes")
+		return NewFileSystemStorageProvider(basePath)
+	}
+
+	bucketName := env.GetEnv("VOLUME_BUCKET_NAME", "")
+	if bucketName == "" {
+		bucketName = utils.RequiredEnv("TEMPLATE_BUCKET_NAME", "Bucket for storing template files")
+	}
+
+	return newBucketStorageProvider(ctx, provider, bucketName, "")
+}
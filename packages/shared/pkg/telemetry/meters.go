@@ -12,6 +12,10 @@ type (
 
 const (
 	SandboxCreateMeterName CounterType = "api.env.instance.started"
+
+	ChunkCacheHitsCounterName      CounterType = "orchestrator.chunk_cache.hits"
+	ChunkCacheMissesCounterName    CounterType = "orchestrator.chunk_cache.misses"
+	ChunkCacheEvictionsCounterName CounterType = "orchestrator.chunk_cache.evictions"
 )
 
 const (
@@ -45,14 +49,26 @@ const (
 	SandboxRamUsedGaugeName  GaugeIntType = "e2b.sandbox.ram.used"
 	SandboxRamTotalGaugeName GaugeIntType = "e2b.sandbox.ram.total"
 	SandboxCpuTotalGaugeName GaugeIntType = "e2b.sandbox.cpu.total"
+
+	SandboxNetworkRxBytesGaugeName        GaugeIntType = "e2b.sandbox.network.rx.bytes"
+	SandboxNetworkTxBytesGaugeName        GaugeIntType = "e2b.sandbox.network.tx.bytes"
+	SandboxNetworkRxPacketsGaugeName      GaugeIntType = "e2b.sandbox.network.rx.packets"
+	SandboxNetworkTxPacketsGaugeName      GaugeIntType = "e2b.sandbox.network.tx.packets"
+	SandboxNetworkNewConnectionsGaugeName GaugeIntType = "e2b.sandbox.network.connections.new"
 )
 
 var counterDesc = map[CounterType]string{
-	SandboxCreateMeterName: "Number of currently waiting requests to create a new sandbox",
+	SandboxCreateMeterName:         "Number of currently waiting requests to create a new sandbox",
+	ChunkCacheHitsCounterName:      "Number of template chunks served from the local chunk cache.",
+	ChunkCacheMissesCounterName:    "Number of template chunks fetched from the storage.",
+	ChunkCacheEvictionsCounterName: "Number of template chunks evicted from the local chunk cache.",
 }
 
 var counterUnits = map[CounterType]string{
-	SandboxCreateMeterName: "{sandbox}",
+	SandboxCreateMeterName:         "{sandbox}",
+	ChunkCacheHitsCounterName:      "{chunk}",
+	ChunkCacheMissesCounterName:    "{chunk}",
+	ChunkCacheEvictionsCounterName: "{chunk}",
 }
 
 var upDownCounterDesc = map[UpDownCounterType]string{
@@ -104,6 +120,12 @@ var gaugeIntDesc = map[GaugeIntType]string{
 	SandboxRamUsedGaugeName:       "Amount of RAM used by the sandbox.",
 	SandboxRamTotalGaugeName:      "Amount of RAM available to the sandbox.",
 	SandboxCpuTotalGaugeName:      "Amount of CPU available to the sandbox.",
+
+	SandboxNetworkRxBytesGaugeName:        "Number of bytes received by the sandbox.",
+	SandboxNetworkTxBytesGaugeName:        "Number of bytes sent by the sandbox.",
+	SandboxNetworkRxPacketsGaugeName:      "Number of packets received by the sandbox.",
+	SandboxNetworkTxPacketsGaugeName:      "Number of packets sent by the sandbox.",
+	SandboxNetworkNewConnectionsGaugeName: "Number of connections opened by the sandbox.",
 }
 
 var gaugeIntUnits = map[GaugeIntType]string{
@@ -111,6 +133,12 @@ var gaugeIntUnits = map[GaugeIntType]string{
 	SandboxRamUsedGaugeName:       "{By}",
 	SandboxRamTotalGaugeName:      "{By}",
 	SandboxCpuTotalGaugeName:      "{count}",
+
+	SandboxNetworkRxBytesGaugeName:        "{By}",
+	SandboxNetworkTxBytesGaugeName:        "{By}",
+	SandboxNetworkRxPacketsGaugeName:      "{packet}",
+	SandboxNetworkTxPacketsGaugeName:      "{packet}",
+	SandboxNetworkNewConnectionsGaugeName: "{connection}",
 }
 
 func GetCounter(meter metric.Meter, name CounterType) (metric.Int64Counter, error) {
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"errors"
+	"syscall"
+)
+
+// ProcessExists returns true if a process with the pid is running.
+func ProcessExists(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	// Signal 0 only checks that the process exists, EPERM means it exists but belongs to another user.
+	err := syscall.Kill(pid, 0)
+
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -7,6 +7,10 @@ func IsGTEVersion(curVersion, minVersion string) bool {
 		curVersion = "v" + curVersion
 	}
 
+	if len(minVersion) > 0 && minVersion[0] != 'v' {
+		minVersion = "v" + minVersion
+	}
+
 	if !semver.IsValid(curVersion) {
 		return false
 	}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGTEVersion(t *testing.T) {
+	assert.True(t, IsGTEVersion("0.2.1", "0.2.1"))
+	assert.True(t, IsGTEVersion("v0.3.0", "0.2.1"))
+	assert.False(t, IsGTEVersion("0.2.0", "0.2.1"))
+	assert.False(t, IsGTEVersion("0.2.0", "v0.2.1"))
+	assert.False(t, IsGTEVersion("invalid", "0.2.1"))
+}